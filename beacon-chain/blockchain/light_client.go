@@ -0,0 +1,275 @@
+package blockchain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+)
+
+// Number of imported blocks which can wait for their light client updates to be built.
+const lightClientQueueSize = 64
+
+// lightClientBlock is an imported block for which light client updates are built. The attested
+// block and state are those of its parent, and are looked up when they are not at hand.
+type lightClientBlock struct {
+	signed        block.SignedBeaconBlock
+	attestedBlock block.SignedBeaconBlock
+	attestedState state.BeaconState
+}
+
+// queueLightClientBlock queues a block imported from gossip for its light client updates to be
+// built in the background. Blocks are dropped while the queue is full, as the updates of the
+// next blocks supersede theirs.
+func (s *Service) queueLightClientBlock(blk *lightClientBlock) {
+	select {
+	case s.lightClientBlocks <- blk:
+	default:
+		log.WithField("slot", blk.signed.Block().Slot()).Debug("Light client queue is full, dropping block")
+	}
+}
+
+// queueLightClientBatch queues the blocks of a batch imported during initial sync for their light
+// client updates to be built in the background, waiting for room in the queue so that the best
+// update of every sync committee period is found.
+func (s *Service) queueLightClientBatch(blks []*lightClientBlock) {
+	for _, blk := range blks {
+		select {
+		case s.lightClientBlocks <- blk:
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// processLightClientUpdatesRoutine builds the light client updates of the queued blocks, one at a
+// time, until the service is stopped.
+func (s *Service) processLightClientUpdatesRoutine() {
+	for {
+		select {
+		case blk := <-s.lightClientBlocks:
+			if err := s.processLightClientUpdates(s.ctx, blk); err != nil {
+				log.WithError(err).Debug("Could not process light client updates")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// processLightClientUpdates builds the light client update of a processed block, sends the
+// optimistic and finality updates derived from it over the state feed, and saves it in the
+// DB if it is the best update known for its sync committee period.
+func (s *Service) processLightClientUpdates(ctx context.Context, blk *lightClientBlock) error {
+	ctx, span := trace.StartSpan(ctx, "blockChain.processLightClientUpdates")
+	defer span.End()
+
+	update, err := s.lightClientUpdate(ctx, blk)
+	if err != nil {
+		return err
+	}
+	if update == nil {
+		return nil
+	}
+
+	s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+		Type: statefeed.LightClientOptimisticUpdate,
+		Data: &ethpbv2.LightClientOptimisticUpdate{
+			AttestedHeader: update.AttestedHeader,
+			SyncAggregate:  update.SyncAggregate,
+			SignatureSlot:  update.SignatureSlot,
+		},
+	})
+	if update.FinalizedHeader != nil {
+		s.cfg.StateNotifier.StateFeed().Send(&feed.Event{
+			Type: statefeed.LightClientFinalityUpdate,
+			Data: &ethpbv2.LightClientFinalityUpdate{
+				AttestedHeader:  update.AttestedHeader,
+				FinalizedHeader: update.FinalizedHeader,
+				FinalityBranch:  update.FinalityBranch,
+				SyncAggregate:   update.SyncAggregate,
+				SignatureSlot:   update.SignatureSlot,
+			},
+		})
+	}
+
+	// Updates of an attested phase 0 state cannot prove the next sync committee.
+	if update.NextSyncCommittee == nil {
+		return nil
+	}
+	period := helpers.SyncCommitteePeriod(helpers.SlotToEpoch(update.AttestedHeader.Slot))
+	best, err := s.cfg.BeaconDB.LightClientUpdate(ctx, period)
+	if err != nil {
+		return errors.Wrap(err, "could not get best light client update")
+	}
+	if best != nil && !isBetterLightClientUpdate(update, best) {
+		return nil
+	}
+	return s.cfg.BeaconDB.SaveLightClientUpdate(ctx, period, update)
+}
+
+// lightClientUpdate builds the light client update from the sync aggregate of a block. The sync
+// aggregate of a block signs over the parent block, whose header is the attested header of the
+// update, and whose state proves the next sync committee and the finalized header. It returns nil
+// if the block carries no sync committee signature.
+func (s *Service) lightClientUpdate(ctx context.Context, blk *lightClientBlock) (*ethpbv2.LightClientUpdate, error) {
+	signed := blk.signed
+	if signed.Version() == version.Phase0 {
+		return nil, nil
+	}
+	syncAggregate, err := signed.Block().Body().SyncAggregate()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get sync aggregate")
+	}
+	// Updates without any sync committee participant are useless to light clients.
+	if syncAggregate.SyncCommitteeBits.Count() == 0 {
+		return nil, nil
+	}
+
+	attestedRoot := bytesutil.ToBytes32(signed.Block().ParentRoot())
+	attestedBlock := blk.attestedBlock
+	if attestedBlock == nil {
+		attestedBlock, err = s.lightClientBlockByRoot(ctx, attestedRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get attested block")
+		}
+	}
+	if attestedBlock == nil || attestedBlock.IsNil() {
+		return nil, errors.Errorf("nil attested block %#x", attestedRoot)
+	}
+	attestedHeader, err := migration.BlockIfaceToV1BlockHeader(attestedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get attested header")
+	}
+	update := &ethpbv2.LightClientUpdate{
+		AttestedHeader: attestedHeader.Message,
+		SyncAggregate:  migration.V1Alpha1SyncAggregateToV1(syncAggregate),
+		SignatureSlot:  signed.Block().Slot(),
+	}
+
+	// The Merkle branches are proven against the state of the attested block, which
+	// only has sync committees from the Altair fork onwards.
+	attestedState := blk.attestedState
+	if attestedState == nil {
+		attestedState, err = s.cfg.StateGen.StateByRoot(ctx, attestedRoot)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get attested state")
+		}
+	}
+	if attestedState == nil || attestedState.IsNil() {
+		return nil, errors.Errorf("nil attested state %#x", attestedRoot)
+	}
+	if attestedState.Version() == version.Phase0 {
+		return update, nil
+	}
+	nextSyncCommittee, err := attestedState.NextSyncCommittee()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next sync committee")
+	}
+	update.NextSyncCommittee = &ethpbv2.SyncCommittee{
+		Pubkeys:         nextSyncCommittee.Pubkeys,
+		AggregatePubkey: nextSyncCommittee.AggregatePubkey,
+	}
+	update.NextSyncCommitteeBranch, err = attestedState.NextSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get next sync committee proof")
+	}
+
+	finalizedRoot := bytesutil.ToBytes32(attestedState.FinalizedCheckpoint().Root)
+	if finalizedRoot == params.BeaconConfig().ZeroHash {
+		return update, nil
+	}
+	finalizedBlock, err := s.lightClientBlockByRoot(ctx, finalizedRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized block")
+	}
+	if finalizedBlock == nil || finalizedBlock.IsNil() {
+		return nil, errors.Errorf("nil finalized block %#x", finalizedRoot)
+	}
+	finalizedHeader, err := migration.BlockIfaceToV1BlockHeader(finalizedBlock)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized header")
+	}
+	update.FinalizedHeader = finalizedHeader.Message
+	update.FinalityBranch, err = attestedState.FinalizedRootProof(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized root proof")
+	}
+	return update, nil
+}
+
+// lightClientBlockByRoot returns a block from the initial sync cache, where the blocks imported in
+// batches are kept until they are saved, or from the DB.
+func (s *Service) lightClientBlockByRoot(ctx context.Context, root [32]byte) (block.SignedBeaconBlock, error) {
+	if blk := s.getInitSyncBlock(root); blk != nil {
+		return blk, nil
+	}
+	return s.cfg.BeaconDB.Block(ctx, root)
+}
+
+// isBetterLightClientUpdate returns true if the new update is better than the old one for a
+// light client, following the ranking of the Altair light client sync protocol: a supermajority
+// of participants first, then a relevant sync committee, then finality, then the number of
+// participants, and finally the oldest update.
+func isBetterLightClientUpdate(newUpdate, oldUpdate *ethpbv2.LightClientUpdate) bool {
+	maxActive := params.BeaconConfig().SyncCommitteeSize
+	newActive := newUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	oldActive := oldUpdate.SyncAggregate.SyncCommitteeBits.Count()
+	newSupermajority := newActive*3 >= maxActive*2
+	oldSupermajority := oldActive*3 >= maxActive*2
+	if newSupermajority != oldSupermajority {
+		return newSupermajority
+	}
+	if !newSupermajority && newActive != oldActive {
+		return newActive > oldActive
+	}
+
+	// Prefer updates signed by the sync committee of the attested header's period.
+	newRelevant := isRelevantSyncCommitteeUpdate(newUpdate)
+	oldRelevant := isRelevantSyncCommitteeUpdate(oldUpdate)
+	if newRelevant != oldRelevant {
+		return newRelevant
+	}
+
+	newFinality := newUpdate.FinalizedHeader != nil
+	oldFinality := oldUpdate.FinalizedHeader != nil
+	if newFinality != oldFinality {
+		return newFinality
+	}
+	// Prefer updates finalizing a header of the attested header's period.
+	if newFinality {
+		newSyncCommitteeFinality := slotPeriod(newUpdate.FinalizedHeader.Slot) == slotPeriod(newUpdate.AttestedHeader.Slot)
+		oldSyncCommitteeFinality := slotPeriod(oldUpdate.FinalizedHeader.Slot) == slotPeriod(oldUpdate.AttestedHeader.Slot)
+		if newSyncCommitteeFinality != oldSyncCommitteeFinality {
+			return newSyncCommitteeFinality
+		}
+	}
+
+	if newActive != oldActive {
+		return newActive > oldActive
+	}
+	if newUpdate.AttestedHeader.Slot != oldUpdate.AttestedHeader.Slot {
+		return newUpdate.AttestedHeader.Slot < oldUpdate.AttestedHeader.Slot
+	}
+	return newUpdate.SignatureSlot < oldUpdate.SignatureSlot
+}
+
+func isRelevantSyncCommitteeUpdate(update *ethpbv2.LightClientUpdate) bool {
+	return update.NextSyncCommittee != nil &&
+		slotPeriod(update.AttestedHeader.Slot) == slotPeriod(update.SignatureSlot)
+}
+
+func slotPeriod(slot types.Slot) uint64 {
+	return helpers.SyncCommitteePeriod(helpers.SlotToEpoch(slot))
+}
@@ -0,0 +1,279 @@
+package blockchain
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_processLightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	notifier := &mock.MockStateNotifier{}
+	stateChannel := make(chan *feed.Event, 2)
+	stateSub := notifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	service, err := NewService(ctx, &Config{
+		BeaconDB:      beaconDB,
+		StateGen:      stategen.New(beaconDB),
+		StateNotifier: notifier,
+	})
+	require.NoError(t, err)
+
+	finalizedBlock := testutil.NewBeaconBlockAltair()
+	finalizedBlock.Block.Slot = 32
+	wsb, err := wrapper.WrappedAltairSignedBeaconBlock(finalizedBlock)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	finalizedRoot, err := finalizedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+
+	attestedState, _ := testutil.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, attestedState.SetSlot(65))
+	require.NoError(t, attestedState.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: finalizedRoot[:]}))
+	attestedStateRoot, err := attestedState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	attestedBlock := testutil.NewBeaconBlockAltair()
+	attestedBlock.Block.Slot = 65
+	attestedBlock.Block.StateRoot = attestedStateRoot[:]
+	wsb, err = wrapper.WrappedAltairSignedBeaconBlock(attestedBlock)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	attestedRoot, err := attestedBlock.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, attestedState, attestedRoot))
+
+	t.Run("no sync committee participant", func(t *testing.T) {
+		blk := testutil.NewBeaconBlockAltair()
+		blk.Block.Slot = 66
+		blk.Block.ParentRoot = attestedRoot[:]
+		wsb, err := wrapper.WrappedAltairSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		require.NoError(t, service.processLightClientUpdates(ctx, &lightClientBlock{signed: wsb}))
+		assert.Equal(t, 0, len(stateChannel))
+	})
+
+	t.Run("optimistic and finality updates", func(t *testing.T) {
+		blk := testutil.NewBeaconBlockAltair()
+		blk.Block.Slot = 66
+		blk.Block.ParentRoot = attestedRoot[:]
+		bits := bitfield.NewBitvector512()
+		bits.SetBitAt(1, true)
+		blk.Block.Body.SyncAggregate.SyncCommitteeBits = bits
+		wsb, err := wrapper.WrappedAltairSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		require.NoError(t, service.processLightClientUpdates(ctx, &lightClientBlock{signed: wsb}))
+
+		require.Equal(t, 2, len(stateChannel))
+		events := []*feed.Event{<-stateChannel, <-stateChannel}
+		assert.Equal(t, statefeed.LightClientOptimisticUpdate, int(events[0].Type))
+		optimistic, ok := events[0].Data.(*ethpbv2.LightClientOptimisticUpdate)
+		require.Equal(t, true, ok)
+		assert.Equal(t, blk.Block.Slot, optimistic.SignatureSlot)
+		assert.Equal(t, attestedBlock.Block.Slot, optimistic.AttestedHeader.Slot)
+		assert.DeepEqual(t, bits, optimistic.SyncAggregate.SyncCommitteeBits)
+
+		assert.Equal(t, statefeed.LightClientFinalityUpdate, int(events[1].Type))
+		finality, ok := events[1].Data.(*ethpbv2.LightClientFinalityUpdate)
+		require.Equal(t, true, ok)
+		assert.Equal(t, finalizedBlock.Block.Slot, finality.FinalizedHeader.Slot)
+		assert.DeepEqual(t, attestedStateRoot[:], finality.AttestedHeader.StateRoot)
+
+		// The finality branch proves the finalized root against the attested state root.
+		gIndex := 105
+		node := finalizedRoot[:]
+		for i, sibling := range finality.FinalityBranch {
+			var h [32]byte
+			if (gIndex>>i)%2 == 1 {
+				h = hashutil.Hash(append(append([]byte{}, sibling...), node...))
+			} else {
+				h = hashutil.Hash(append(append([]byte{}, node...), sibling...))
+			}
+			node = h[:]
+		}
+		assert.DeepEqual(t, attestedStateRoot[:], node)
+
+		// The update is the best known one for the period of the attested header.
+		saved, err := beaconDB.LightClientUpdate(ctx, 0)
+		require.NoError(t, err)
+		require.NotNil(t, saved)
+		assert.Equal(t, attestedBlock.Block.Slot, saved.AttestedHeader.Slot)
+		assert.Equal(t, finalizedBlock.Block.Slot, saved.FinalizedHeader.Slot)
+		nextSyncCommittee, err := attestedState.NextSyncCommittee()
+		require.NoError(t, err)
+		assert.DeepEqual(t, nextSyncCommittee.Pubkeys, saved.NextSyncCommittee.Pubkeys)
+		committeeRoot, err := nextSyncCommittee.HashTreeRoot()
+		require.NoError(t, err)
+		gIndex = 55
+		node = committeeRoot[:]
+		for i, sibling := range saved.NextSyncCommitteeBranch {
+			var h [32]byte
+			if (gIndex>>i)%2 == 1 {
+				h = hashutil.Hash(append(append([]byte{}, sibling...), node...))
+			} else {
+				h = hashutil.Hash(append(append([]byte{}, node...), sibling...))
+			}
+			node = h[:]
+		}
+		assert.DeepEqual(t, attestedStateRoot[:], node)
+	})
+
+	t.Run("attested block and state at hand", func(t *testing.T) {
+		// Blocks imported in a batch come with the attested block and state, which are not in the DB yet.
+		batchState := attestedState.Copy()
+		require.NoError(t, batchState.SetSlot(70))
+		batchBlock := testutil.NewBeaconBlockAltair()
+		batchBlock.Block.Slot = 70
+		wsbBatch, err := wrapper.WrappedAltairSignedBeaconBlock(batchBlock)
+		require.NoError(t, err)
+		batchRoot, err := batchBlock.Block.HashTreeRoot()
+		require.NoError(t, err)
+
+		blk := testutil.NewBeaconBlockAltair()
+		blk.Block.Slot = 71
+		blk.Block.ParentRoot = batchRoot[:]
+		bits := bitfield.NewBitvector512()
+		bits.SetBitAt(1, true)
+		blk.Block.Body.SyncAggregate.SyncCommitteeBits = bits
+		wsb, err := wrapper.WrappedAltairSignedBeaconBlock(blk)
+		require.NoError(t, err)
+		require.NoError(t, service.processLightClientUpdates(ctx, &lightClientBlock{
+			signed:        wsb,
+			attestedBlock: wsbBatch,
+			attestedState: batchState,
+		}))
+		require.Equal(t, 2, len(stateChannel))
+		optimistic, ok := (<-stateChannel).Data.(*ethpbv2.LightClientOptimisticUpdate)
+		require.Equal(t, true, ok)
+		assert.Equal(t, batchBlock.Block.Slot, optimistic.AttestedHeader.Slot)
+		finality, ok := (<-stateChannel).Data.(*ethpbv2.LightClientFinalityUpdate)
+		require.Equal(t, true, ok)
+		assert.Equal(t, finalizedBlock.Block.Slot, finality.FinalizedHeader.Slot)
+	})
+
+	t.Run("keeps the best update of the period", func(t *testing.T) {
+		process := func(participants uint64) {
+			blk := testutil.NewBeaconBlockAltair()
+			blk.Block.Slot = 66
+			blk.Block.ParentRoot = attestedRoot[:]
+			bits := bitfield.NewBitvector512()
+			for i := uint64(0); i < participants; i++ {
+				bits.SetBitAt(i, true)
+			}
+			blk.Block.Body.SyncAggregate.SyncCommitteeBits = bits
+			wsb, err := wrapper.WrappedAltairSignedBeaconBlock(blk)
+			require.NoError(t, err)
+			require.NoError(t, service.processLightClientUpdates(ctx, &lightClientBlock{signed: wsb}))
+			for len(stateChannel) > 0 {
+				<-stateChannel
+			}
+		}
+
+		process(3)
+		saved, err := beaconDB.LightClientUpdate(ctx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), saved.SyncAggregate.SyncCommitteeBits.Count())
+
+		process(2)
+		saved, err = beaconDB.LightClientUpdate(ctx, 0)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), saved.SyncAggregate.SyncCommitteeBits.Count())
+	})
+}
+
+func TestService_queueLightClientBlock(t *testing.T) {
+	service := &Service{lightClientBlocks: make(chan *lightClientBlock, 1)}
+	blk := testutil.NewBeaconBlockAltair()
+	wsb, err := wrapper.WrappedAltairSignedBeaconBlock(blk)
+	require.NoError(t, err)
+
+	// Blocks imported from gossip are dropped rather than delaying block processing while the queue is full.
+	service.queueLightClientBlock(&lightClientBlock{signed: wsb})
+	service.queueLightClientBlock(&lightClientBlock{signed: wsb})
+	assert.Equal(t, 1, len(service.lightClientBlocks))
+}
+
+func TestIsBetterLightClientUpdate(t *testing.T) {
+	committeeSize := params.BeaconConfig().SyncCommitteeSize
+	newUpdate := func(participants uint64, attestedSlot, signatureSlot types.Slot, finalized bool) *ethpbv2.LightClientUpdate {
+		bits := bitfield.NewBitvector512()
+		for i := uint64(0); i < participants; i++ {
+			bits.SetBitAt(i, true)
+		}
+		update := &ethpbv2.LightClientUpdate{
+			AttestedHeader:    &ethpbv1.BeaconBlockHeader{Slot: attestedSlot},
+			NextSyncCommittee: &ethpbv2.SyncCommittee{},
+			SyncAggregate:     &ethpbv1.SyncAggregate{SyncCommitteeBits: bits},
+			SignatureSlot:     signatureSlot,
+		}
+		if finalized {
+			update.FinalizedHeader = &ethpbv1.BeaconBlockHeader{Slot: attestedSlot - 64}
+		}
+		return update
+	}
+	supermajority := (committeeSize*2 + 2) / 3
+
+	tests := []struct {
+		name     string
+		new, old *ethpbv2.LightClientUpdate
+		want     bool
+	}{
+		{
+			name: "supermajority beats finality",
+			new:  newUpdate(supermajority, 100, 101, false),
+			old:  newUpdate(supermajority-1, 100, 101, true),
+			want: true,
+		},
+		{
+			name: "more participants without supermajority",
+			new:  newUpdate(2, 100, 101, false),
+			old:  newUpdate(1, 100, 101, true),
+			want: true,
+		},
+		{
+			name: "finality with supermajority",
+			new:  newUpdate(supermajority, 100, 101, true),
+			old:  newUpdate(committeeSize, 100, 101, false),
+			want: true,
+		},
+		{
+			name: "more participants with supermajority",
+			new:  newUpdate(supermajority, 100, 101, true),
+			old:  newUpdate(committeeSize, 100, 101, true),
+			want: false,
+		},
+		{
+			name: "older attested header",
+			new:  newUpdate(committeeSize, 99, 101, true),
+			old:  newUpdate(committeeSize, 100, 101, true),
+			want: true,
+		},
+		{
+			name: "same update",
+			new:  newUpdate(committeeSize, 100, 101, true),
+			old:  newUpdate(committeeSize, 100, 101, true),
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isBetterLightClientUpdate(tt.new, tt.old))
+		})
+	}
+}
@@ -193,6 +193,10 @@ func (s *Service) onBlock(ctx context.Context, signed block.SignedBeaconBlock, b
 
 	}
 
+	if featureconfig.Get().EnableLightClientServer {
+		s.queueLightClientBlock(&lightClientBlock{signed: signed})
+	}
+
 	defer reportAttestationInclusion(b)
 
 	return s.handleEpochBoundary(ctx, postState)
@@ -232,7 +236,16 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []block.SignedBeaconBlo
 	}
 	var set *bls.SignatureSet
 	boundaries := make(map[[32]byte]state.BeaconState)
+	var lightClientBlks []*lightClientBlock
 	for i, b := range blks {
+		// The pre state of a block is the attested state of its light client update.
+		if featureconfig.Get().EnableLightClientServer {
+			lightClientBlk := &lightClientBlock{signed: b, attestedState: preState.Copy()}
+			if i > 0 {
+				lightClientBlk.attestedBlock = blks[i-1]
+			}
+			lightClientBlks = append(lightClientBlks, lightClientBlk)
+		}
 		set, preState, err = core.ExecuteStateTransitionNoVerifyAnySig(ctx, preState, b)
 		if err != nil {
 			return nil, nil, err
@@ -269,6 +282,7 @@ func (s *Service) onBlockBatch(ctx context.Context, blks []block.SignedBeaconBlo
 	if err := s.saveHeadNoDB(ctx, lastB, lastBR, preState); err != nil {
 		return nil, nil, err
 	}
+	s.queueLightClientBatch(lightClientBlks)
 	return fCheckpoints, jCheckpoints, nil
 }
 
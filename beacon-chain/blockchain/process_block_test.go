@@ -178,8 +178,20 @@ func TestStore_OnBlockBatch(t *testing.T) {
 	rBlock.Block.ParentRoot = gRoot[:]
 	require.NoError(t, beaconDB.SaveBlock(context.Background(), blks[0]))
 	require.NoError(t, service.cfg.StateGen.SaveState(ctx, blkRoots[0], firstState))
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableLightClientServer: true})
+	defer resetCfg()
 	_, _, err = service.onBlockBatch(ctx, blks[1:], blkRoots[1:])
 	require.NoError(t, err)
+
+	// With the light client server, the blocks of the batch are queued with their attested blocks and states.
+	require.Equal(t, len(blks)-1, len(service.lightClientBlocks))
+	first := <-service.lightClientBlocks
+	assert.Equal(t, blks[1], first.signed)
+	assert.Equal(t, nil, first.attestedBlock)
+	assert.Equal(t, blks[0].Block().Slot(), first.attestedState.Slot())
+	second := <-service.lightClientBlocks
+	assert.Equal(t, blks[1], second.attestedBlock)
+	assert.Equal(t, blks[1].Block().Slot(), second.attestedState.Slot())
 }
 
 func TestRemoveStateSinceLastFinalized_EmptyStartSlot(t *testing.T) {
@@ -28,8 +28,9 @@ type BlockReceiver interface {
 // ReceiveBlock is a function that defines the the operations (minus pubsub)
 // that are performed on blocks that is received from regular sync service. The operations consists of:
 //   1. Validate block, apply state transition and update check points
-//   2. Apply fork choice to the processed block
-//   3. Save latest head info
+//   2. Boost the processed block in fork choice if it was received timely
+//   3. Apply fork choice to the processed block
+//   4. Save latest head info
 func (s *Service) ReceiveBlock(ctx context.Context, block block.SignedBeaconBlock, blockRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "blockChain.ReceiveBlock")
 	defer span.End()
@@ -43,6 +44,9 @@ func (s *Service) ReceiveBlock(ctx context.Context, block block.SignedBeaconBloc
 		return err
 	}
 
+	// Boost the block in fork choice if it arrived before the attestation deadline of its slot.
+	s.cfg.ForkChoiceStore.BoostProposerRoot(ctx, blockCopy.Block().Slot(), blockRoot, s.genesisTime, receivedTime)
+
 	// Update and save head block after fork choice.
 	if !featureconfig.Get().UpdateHeadTimely {
 		if err := s.updateHead(ctx, s.getJustifiedBalances()); err != nil {
@@ -32,6 +32,7 @@ import (
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/copyutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
 	"github.com/sirupsen/logrus"
@@ -62,6 +63,7 @@ type Service struct {
 	checkpointStateCache  *cache.CheckpointStateCache
 	initSyncBlocks        map[[32]byte]block.SignedBeaconBlock
 	initSyncBlocksLock    sync.RWMutex
+	lightClientBlocks     chan *lightClientBlock
 	justifiedBalances     []uint64
 	justifiedBalancesLock sync.RWMutex
 	wsVerified            bool
@@ -96,6 +98,7 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		boundaryRoots:        [][32]byte{},
 		checkpointStateCache: cache.NewCheckpointStateCache(),
 		initSyncBlocks:       make(map[[32]byte]block.SignedBeaconBlock),
+		lightClientBlocks:    make(chan *lightClientBlock, lightClientQueueSize),
 		justifiedBalances:    make([]uint64, 0),
 	}, nil
 }
@@ -237,6 +240,9 @@ func (s *Service) Start() {
 	}
 
 	go s.processAttestationsRoutine(attestationProcessorSubscribed)
+	if featureconfig.Get().EnableLightClientServer {
+		go s.processLightClientUpdatesRoutine()
+	}
 }
 
 // processChainStartTime initializes a series of deposits from the ChainStart deposits in the eth1
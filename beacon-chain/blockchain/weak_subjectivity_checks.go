@@ -4,7 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
@@ -33,15 +36,25 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 	if err := s.cfg.BeaconDB.SaveBlocks(ctx, s.getInitSyncBlocks()); err != nil {
 		return err
 	}
-	// A node should have the weak subjectivity block in the DB.
-	if !s.cfg.BeaconDB.HasBlock(ctx, r) {
-		return fmt.Errorf("node does not have root in DB: %#x", r)
-	}
-
 	startSlot, err := helpers.StartSlot(s.cfg.WeakSubjectivityCheckpt.Epoch)
 	if err != nil {
 		return err
 	}
+	// A node should have the weak subjectivity block in the DB.
+	if !s.cfg.BeaconDB.HasBlock(ctx, r) {
+		// A node started from a checkpoint sync origin does not have the blocks before its origin.
+		beforeOrigin, err := s.isBeforeOrigin(ctx, startSlot)
+		if err != nil {
+			return err
+		}
+		if beforeOrigin {
+			log.Warnf("Weak subjectivity checkpoint in epoch %d is before the checkpoint sync origin, skipping check",
+				s.cfg.WeakSubjectivityCheckpt.Epoch)
+			s.wsVerified = true
+			return nil
+		}
+		return fmt.Errorf("node does not have root in DB: %#x", r)
+	}
 	// A node should have the weak subjectivity block corresponds to the correct epoch in the DB.
 	filter := filters.NewFilter().SetStartSlot(startSlot).SetEndSlot(startSlot + params.BeaconConfig().SlotsPerEpoch)
 	roots, err := s.cfg.BeaconDB.BlockRoots(ctx, filter)
@@ -58,3 +71,23 @@ func (s *Service) VerifyWeakSubjectivityRoot(ctx context.Context) error {
 
 	return fmt.Errorf("node does not have root in db corresponding to epoch: %#x %d", r, s.cfg.WeakSubjectivityCheckpt.Epoch)
 }
+
+// isBeforeOrigin returns true if the node was started from a checkpoint sync origin
+// which is at a slot higher than the input slot.
+func (s *Service) isBeforeOrigin(ctx context.Context, slot types.Slot) (bool, error) {
+	originRoot, err := s.cfg.BeaconDB.OriginCheckpointBlockRoot(ctx)
+	if errors.Is(err, db.ErrNoOriginCheckpointBlockRoot) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	originBlock, err := s.cfg.BeaconDB.Block(ctx, originRoot)
+	if err != nil {
+		return false, err
+	}
+	if originBlock == nil || originBlock.IsNil() {
+		return false, fmt.Errorf("origin block not found in DB: %#x", originRoot)
+	}
+	return slot < originBlock.Block().Slot(), nil
+}
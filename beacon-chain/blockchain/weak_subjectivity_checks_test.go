@@ -80,3 +80,29 @@ func TestService_VerifyWeakSubjectivityRoot(t *testing.T) {
 		})
 	}
 }
+
+func TestService_VerifyWeakSubjectivityRoot_BeforeOrigin(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 96
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveOriginCheckpointBlockRoot(ctx, r))
+
+	s := &Service{
+		cfg: &Config{
+			BeaconDB:                beaconDB,
+			WeakSubjectivityCheckpt: &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'a'}, 32), Epoch: 1},
+		},
+		finalizedCheckpt: &ethpb.Checkpoint{Epoch: 3},
+	}
+	require.NoError(t, s.VerifyWeakSubjectivityRoot(ctx))
+	require.Equal(t, true, s.wsVerified)
+
+	s.wsVerified = false
+	s.cfg.WeakSubjectivityCheckpt = &ethpb.Checkpoint{Root: bytesutil.PadTo([]byte{'a'}, 32), Epoch: 3}
+	require.ErrorContains(t, "node does not have root in DB", s.VerifyWeakSubjectivityRoot(ctx))
+}
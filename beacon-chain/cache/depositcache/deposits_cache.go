@@ -35,6 +35,7 @@ var (
 type DepositFetcher interface {
 	AllDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit
 	DepositByPubkey(ctx context.Context, pubKey []byte) (*ethpb.Deposit, *big.Int)
+	DepositSnapshot(ctx context.Context) *dbpb.DepositSnapshot
 	DepositsNumberAndRootAtHeight(ctx context.Context, blockHeight *big.Int) (uint64, [32]byte)
 	FinalizedDeposits(ctx context.Context) *FinalizedDeposits
 	NonFinalizedDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit
@@ -54,7 +55,10 @@ type DepositCache struct {
 	pendingDeposits   []*dbpb.DepositContainer
 	deposits          []*dbpb.DepositContainer
 	finalizedDeposits *FinalizedDeposits
-	depositsLock      sync.RWMutex
+	// Deposit snapshot the cache was initialized from, if any. The deposits of
+	// the snapshot are finalized and not kept in the cache.
+	snapshot     *dbpb.DepositSnapshot
+	depositsLock sync.RWMutex
 }
 
 // New instantiates a new deposit cache
@@ -90,8 +94,8 @@ func (dc *DepositCache) InsertDeposit(ctx context.Context, d *ethpb.Deposit, blo
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
-	if int(index) != len(dc.deposits) {
-		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", len(dc.deposits), index)
+	if wantedIndex := dc.snapshotCount() + int64(len(dc.deposits)); index != wantedIndex {
+		return errors.Errorf("wanted deposit with index %d to be inserted but received %d", wantedIndex, index)
 	}
 	// Keep the slice sorted on insertion in order to avoid costly sorting on retrieval.
 	heightIdx := sort.Search(len(dc.deposits), func(i int) bool { return dc.deposits[i].Index >= index })
@@ -115,6 +119,52 @@ func (dc *DepositCache) InsertDepositContainers(ctx context.Context, ctrs []*dbp
 	historicalDepositsCount.Add(float64(len(ctrs)))
 }
 
+// InsertDepositSnapshot initializes the cache from a deposit snapshot, whose deposits become the
+// finalized deposits of the cache. The cache must not contain any deposits yet.
+func (dc *DepositCache) InsertDepositSnapshot(ctx context.Context, snapshot *dbpb.DepositSnapshot) error {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertDepositSnapshot")
+	defer span.End()
+	depositTrie, err := trieutil.NewTrieFromFinalizedBranch(
+		snapshot.Finalized, snapshot.DepositCount, params.BeaconConfig().DepositContractTreeDepth,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not restore deposit trie from snapshot")
+	}
+	if root := depositTrie.HashTreeRoot(); !bytes.Equal(root[:], snapshot.DepositRoot) {
+		return errors.Errorf("deposit snapshot root %#x does not match the root %#x of its finalized branch", snapshot.DepositRoot, root)
+	}
+	dc.depositsLock.Lock()
+	defer dc.depositsLock.Unlock()
+
+	if len(dc.deposits) > 0 || dc.finalizedDeposits.MerkleTrieIndex >= 0 {
+		return errors.New("cannot insert deposit snapshot into a non-empty deposit cache")
+	}
+	dc.snapshot = snapshot
+	dc.finalizedDeposits = &FinalizedDeposits{
+		Deposits:        depositTrie,
+		MerkleTrieIndex: int64(snapshot.DepositCount) - 1,
+	}
+	return nil
+}
+
+// DepositSnapshot returns the deposit snapshot the cache was initialized from, if any.
+func (dc *DepositCache) DepositSnapshot(ctx context.Context) *dbpb.DepositSnapshot {
+	ctx, span := trace.StartSpan(ctx, "DepositsCache.DepositSnapshot")
+	defer span.End()
+	dc.depositsLock.RLock()
+	defer dc.depositsLock.RUnlock()
+
+	return dc.snapshot
+}
+
+// snapshotCount returns the number of deposits of the deposit snapshot, which are not kept in the cache.
+func (dc *DepositCache) snapshotCount() int64 {
+	if dc.snapshot == nil {
+		return 0
+	}
+	return int64(dc.snapshot.DepositCount)
+}
+
 // InsertFinalizedDeposits inserts deposits up to eth1DepositIndex (inclusive) into the finalized deposits cache.
 func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1DepositIndex int64) {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.InsertFinalizedDeposits")
@@ -122,8 +172,12 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
+	// The finalized deposits only move forward. In particular, the deposits of a deposit snapshot
+	// are finalized even though the finalized state may not include all of them yet.
+	if eth1DepositIndex <= dc.finalizedDeposits.MerkleTrieIndex {
+		return
+	}
 	depositTrie := dc.finalizedDeposits.Deposits
-	insertIndex := int(dc.finalizedDeposits.MerkleTrieIndex + 1)
 	for _, d := range dc.deposits {
 		if d.Index <= dc.finalizedDeposits.MerkleTrieIndex {
 			continue
@@ -136,8 +190,7 @@ func (dc *DepositCache) InsertFinalizedDeposits(ctx context.Context, eth1Deposit
 			log.WithError(err).Error("Could not hash deposit data. Finalized deposit cache not updated.")
 			return
 		}
-		depositTrie.Insert(depHash[:], insertIndex)
-		insertIndex++
+		depositTrie.Insert(depHash[:], int(d.Index))
 	}
 
 	dc.finalizedDeposits = &FinalizedDeposits{
@@ -158,6 +211,7 @@ func (dc *DepositCache) AllDepositContainers(ctx context.Context) []*dbpb.Deposi
 
 // AllDeposits returns a list of historical deposits until the given block number
 // (inclusive). If no block is specified then this method returns all historical deposits.
+// The deposits of the deposit snapshot the cache was initialized from are not returned.
 func (dc *DepositCache) AllDeposits(ctx context.Context, untilBlk *big.Int) []*ethpb.Deposit {
 	ctx, span := trace.StartSpan(ctx, "DepositsCache.AllDeposits")
 	defer span.End()
@@ -184,9 +238,12 @@ func (dc *DepositCache) DepositsNumberAndRootAtHeight(ctx context.Context, block
 	// send the deposit root of the empty trie, if eth1follow distance is greater than the time of the earliest
 	// deposit.
 	if heightIdx == 0 {
+		if dc.snapshot != nil && blockHeight.Uint64() >= dc.snapshot.ExecutionBlockHeight {
+			return dc.snapshot.DepositCount, bytesutil.ToBytes32(dc.snapshot.DepositRoot)
+		}
 		return 0, [32]byte{}
 	}
-	return uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
+	return uint64(dc.snapshotCount()) + uint64(heightIdx), bytesutil.ToBytes32(dc.deposits[heightIdx-1].DepositRoot)
 }
 
 // DepositByPubkey looks through historical deposits and finds one which contains
@@ -252,6 +309,8 @@ func (dc *DepositCache) PruneProofs(ctx context.Context, untilDepositIndex int64
 	dc.depositsLock.Lock()
 	defer dc.depositsLock.Unlock()
 
+	// Deposits are kept in the cache from the end of the deposit snapshot onwards.
+	untilDepositIndex -= dc.snapshotCount()
 	if untilDepositIndex >= int64(len(dc.deposits)) {
 		untilDepositIndex = int64(len(dc.deposits) - 1)
 	}
@@ -792,3 +792,118 @@ func makeDepositProof() [][]byte {
 	}
 	return proof
 }
+
+func TestInsertDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	deposits := make([]*ethpb.Deposit, 5)
+	roots := make([][]byte, len(deposits))
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{
+			Proof: [][]byte{{'p'}},
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+		root, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = root[:]
+	}
+	snapshotTrie, err := trieutil.GenerateTrieFromItems(roots[:3], depth)
+	require.NoError(t, err)
+	finalized, err := snapshotTrie.FinalizedBranch(3)
+	require.NoError(t, err)
+	snapshotRoot := snapshotTrie.HashTreeRoot()
+	snapshot := &dbpb.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          snapshotRoot[:],
+		DepositCount:         3,
+		ExecutionBlockHash:   make([]byte, 32),
+		ExecutionBlockHeight: 10,
+	}
+
+	dc, err := New()
+	require.NoError(t, err)
+	require.ErrorContains(t, "does not match", dc.InsertDepositSnapshot(ctx, &dbpb.DepositSnapshot{
+		Finalized:    finalized,
+		DepositRoot:  make([]byte, 32),
+		DepositCount: 3,
+	}))
+	require.NoError(t, dc.InsertDepositSnapshot(ctx, snapshot))
+	assert.Equal(t, snapshot, dc.DepositSnapshot(ctx))
+	require.ErrorContains(t, "non-empty", dc.InsertDepositSnapshot(ctx, snapshot))
+
+	require.ErrorContains(t, "wanted deposit with index 3", dc.InsertDeposit(ctx, deposits[0], 11, 0, [32]byte{}))
+	fullTrie, err := trieutil.GenerateTrieFromItems(roots, depth)
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[3], 11, 3, [32]byte{'a'}))
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[4], 12, 4, fullTrie.HashTreeRoot()))
+
+	count, root := dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(10))
+	assert.Equal(t, uint64(3), count)
+	assert.Equal(t, snapshotRoot, root)
+	count, root = dc.DepositsNumberAndRootAtHeight(ctx, big.NewInt(12))
+	assert.Equal(t, uint64(5), count)
+	assert.Equal(t, fullTrie.HashTreeRoot(), root)
+	assert.Equal(t, 2, len(dc.NonFinalizedDeposits(ctx, nil)))
+
+	dc.InsertFinalizedDeposits(ctx, 4)
+	finalizedDeposits := dc.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(4), finalizedDeposits.MerkleTrieIndex)
+	assert.Equal(t, fullTrie.HashTreeRoot(), finalizedDeposits.Deposits.HashTreeRoot())
+
+	require.NoError(t, dc.PruneProofs(ctx, 3))
+	assert.Equal(t, 0, len(deposits[3].Proof))
+	assert.Equal(t, 1, len(deposits[4].Proof))
+}
+
+func TestInsertFinalizedDeposits_AfterDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	deposits := make([]*ethpb.Deposit, 6)
+	roots := make([][]byte, len(deposits))
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{
+			Proof: [][]byte{{'p'}},
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+		root, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = root[:]
+	}
+	snapshotTrie, err := trieutil.GenerateTrieFromItems(roots[:4], depth)
+	require.NoError(t, err)
+	finalized, err := snapshotTrie.FinalizedBranch(4)
+	require.NoError(t, err)
+	snapshotRoot := snapshotTrie.HashTreeRoot()
+	dc, err := New()
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertDepositSnapshot(ctx, &dbpb.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          snapshotRoot[:],
+		DepositCount:         4,
+		ExecutionBlockHash:   make([]byte, 32),
+		ExecutionBlockHeight: 10,
+	}))
+	fullTrie, err := trieutil.GenerateTrieFromItems(roots, depth)
+	require.NoError(t, err)
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[4], 11, 4, [32]byte{'a'}))
+	require.NoError(t, dc.InsertDeposit(ctx, deposits[5], 12, 5, fullTrie.HashTreeRoot()))
+
+	// The finalized state does not include all the deposits of the snapshot yet.
+	dc.InsertFinalizedDeposits(ctx, 1)
+	finalizedDeposits := dc.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(3), finalizedDeposits.MerkleTrieIndex)
+	assert.Equal(t, snapshotRoot, finalizedDeposits.Deposits.HashTreeRoot())
+
+	dc.InsertFinalizedDeposits(ctx, 5)
+	finalizedDeposits = dc.FinalizedDeposits(ctx)
+	assert.Equal(t, int64(5), finalizedDeposits.MerkleTrieIndex)
+	assert.Equal(t, fullTrie.HashTreeRoot(), finalizedDeposits.Deposits.HashTreeRoot())
+}
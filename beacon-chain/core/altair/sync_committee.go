@@ -219,3 +219,45 @@ func ValidateSyncMessageTime(slot types.Slot, genesisTime time.Time, clockDispar
 	}
 	return nil
 }
+
+// SyncCommitteeIndicesFetcher returns the positions of a validator in the current and next sync
+// committees of the head state.
+type SyncCommitteeIndicesFetcher interface {
+	HeadCurrentSyncCommitteeIndices(ctx context.Context, index types.ValidatorIndex, slot types.Slot) ([]types.CommitteeIndex, error)
+	HeadNextSyncCommitteeIndices(ctx context.Context, index types.ValidatorIndex, slot types.Slot) ([]types.CommitteeIndex, error)
+}
+
+// SyncSubcommitteeIndices returns the positions of the validator in the sync committee which signs
+// at the given slot. Messages at the last slot of a sync committee period are signed by the sync
+// committee of the next period.
+func SyncSubcommitteeIndices(
+	ctx context.Context, fetcher SyncCommitteeIndicesFetcher, index types.ValidatorIndex, slot types.Slot,
+) ([]types.CommitteeIndex, error) {
+	currentPeriod := helpers.SyncCommitteePeriod(helpers.SlotToEpoch(slot))
+	nextSlotPeriod := helpers.SyncCommitteePeriod(helpers.SlotToEpoch(slot + 1))
+	switch nextSlotPeriod {
+	case currentPeriod:
+		return fetcher.HeadCurrentSyncCommitteeIndices(ctx, index, slot)
+	case currentPeriod + 1:
+		return fetcher.HeadNextSyncCommitteeIndices(ctx, index, slot)
+	default:
+		return nil, errors.Errorf("could not determine sync committee period of slot %d", slot)
+	}
+}
+
+// SyncSubnets returns the distinct subnets of the given positions in the sync committee.
+func SyncSubnets(indices []types.CommitteeIndex) []uint64 {
+	cfg := params.BeaconConfig()
+	subCommSize := cfg.SyncCommitteeSize / cfg.SyncCommitteeSubnetCount
+	seen := make(map[uint64]bool)
+	subnets := make([]uint64, 0, len(indices))
+	for _, index := range indices {
+		subnet := uint64(index) / subCommSize
+		if seen[subnet] {
+			continue
+		}
+		seen[subnet] = true
+		subnets = append(subnets, subnet)
+	}
+	return subnets
+}
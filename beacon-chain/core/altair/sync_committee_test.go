@@ -400,3 +400,45 @@ func getState(t *testing.T, count uint64) *stateAltair.BeaconState {
 	require.NoError(t, err)
 	return state
 }
+
+type mockSyncCommitteeIndicesFetcher struct {
+	current []types.CommitteeIndex
+	next    []types.CommitteeIndex
+}
+
+func (m *mockSyncCommitteeIndicesFetcher) HeadCurrentSyncCommitteeIndices(_ context.Context, _ types.ValidatorIndex, _ types.Slot) ([]types.CommitteeIndex, error) {
+	return m.current, nil
+}
+
+func (m *mockSyncCommitteeIndicesFetcher) HeadNextSyncCommitteeIndices(_ context.Context, _ types.ValidatorIndex, _ types.Slot) ([]types.CommitteeIndex, error) {
+	return m.next, nil
+}
+
+func TestSyncSubcommitteeIndices(t *testing.T) {
+	fetcher := &mockSyncCommitteeIndicesFetcher{
+		current: []types.CommitteeIndex{1},
+		next:    []types.CommitteeIndex{2},
+	}
+	periodSlots := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+
+	indices, err := altair.SyncSubcommitteeIndices(context.Background(), fetcher, 0, periodSlots-2)
+	require.NoError(t, err)
+	assert.DeepEqual(t, fetcher.current, indices)
+
+	// The last slot of the period is signed by the next sync committee.
+	indices, err = altair.SyncSubcommitteeIndices(context.Background(), fetcher, 0, periodSlots-1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, fetcher.next, indices)
+}
+
+func TestSyncSubnets(t *testing.T) {
+	subCommSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	indices := []types.CommitteeIndex{
+		0,
+		types.CommitteeIndex(subCommSize - 1),
+		types.CommitteeIndex(3*subCommSize + 1),
+		1,
+	}
+	assert.DeepEqual(t, []uint64{0, 3}, altair.SyncSubnets(indices))
+	assert.DeepEqual(t, []uint64{}, altair.SyncSubnets(nil))
+}
@@ -1,6 +1,6 @@
 // Package operation contains types for block operation-specific events fired
 // during the runtime of a beacon node such as attestations, voluntary
-// exits, and slashings.
+// exits, slashings, and sync committee messages.
 package operation
 
 import (
@@ -18,6 +18,14 @@ const (
 
 	// ExitReceived is sent after an voluntary exit object has been received from the outside world (eg in RPC or sync)
 	ExitReceived
+
+	// SyncCommitteeContributionReceived is sent after a sync committee contribution object has been received
+	// from the outside world. (eg. in RPC or sync)
+	SyncCommitteeContributionReceived
+
+	// SyncCommitteeMessageReceived is sent after a sync committee message object has been received
+	// from the outside world. (eg. in RPC or sync)
+	SyncCommitteeMessageReceived
 )
 
 // UnAggregatedAttReceivedData is the data sent with UnaggregatedAttReceived events.
@@ -37,3 +45,15 @@ type ExitReceivedData struct {
 	// Exit is the voluntary exit object.
 	Exit *ethpb.SignedVoluntaryExit
 }
+
+// SyncCommitteeContributionReceivedData is the data sent with SyncCommitteeContributionReceived events.
+type SyncCommitteeContributionReceivedData struct {
+	// Contribution is the signed sync committee contribution and proof object.
+	Contribution *ethpb.SignedContributionAndProof
+}
+
+// SyncCommitteeMessageReceivedData is the data sent with SyncCommitteeMessageReceived events.
+type SyncCommitteeMessageReceivedData struct {
+	// Message is the sync committee message object.
+	Message *ethpb.SyncCommitteeMessage
+}
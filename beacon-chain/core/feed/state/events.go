@@ -26,6 +26,12 @@ const (
 	FinalizedCheckpoint
 	// NewHead of the chain event.
 	NewHead
+	// LightClientFinalityUpdate is sent when a processed block carries a sync aggregate
+	// over a header whose state has a finalized checkpoint.
+	LightClientFinalityUpdate
+	// LightClientOptimisticUpdate is sent when a processed block carries a sync aggregate
+	// over its parent header.
+	LightClientOptimisticUpdate
 )
 
 // BlockProcessedData is the data sent with BlockProcessed events.
@@ -29,3 +29,6 @@ type SlasherDatabase = iface.SlasherDatabase
 // ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 // when one already exists in a database.
 var ErrExistingGenesisState = iface.ErrExistingGenesisState
+
+// ErrNoOriginCheckpointBlockRoot is an error when no checkpoint sync origin exists in a database.
+var ErrNoOriginCheckpointBlockRoot = iface.ErrNoOriginCheckpointBlockRoot
@@ -0,0 +1,18 @@
+package backup
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	incrementalBackupDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "db_incremental_backup_duration_seconds",
+		Help:    "The time it takes to write an incremental backup of the beacon database.",
+		Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300},
+	})
+	incrementalBackupFailures = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "db_incremental_backup_failures_total",
+		Help: "The number of incremental backups of the beacon database which failed.",
+	})
+)
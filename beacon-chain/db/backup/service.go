@@ -0,0 +1,85 @@
+// Package backup periodically writes incremental backups of the beacon database while the node
+// is running. Each backup only holds the keys which changed since the previous one, and the
+// backups can be restored up to a slot with the `db restore` command.
+package backup
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// Config to set up the backup service.
+type Config struct {
+	DB db.Database
+	// Interval between two incremental backups.
+	Interval time.Duration
+	// OutputDir is the directory in which the backups are written. The backups directory of the
+	// database is used if empty.
+	OutputDir string
+}
+
+// Service writes incremental backups of the beacon database on a schedule.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+// NewService initializes the backup service.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	if cfg.Interval <= 0 {
+		return nil, errors.New("incremental backup interval must be greater than 0")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}, nil
+}
+
+// Start the backup service.
+func (s *Service) Start() {
+	log.WithField("interval", s.cfg.Interval).Info("Writing incremental database backups")
+	go s.run()
+}
+
+// Stop the backup service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backup service. Failing to back up does not affect the health of the node.
+func (s *Service) Status() error {
+	return nil
+}
+
+func (s *Service) run() {
+	ticker := time.NewTicker(s.cfg.Interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			s.backup(s.ctx)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+func (s *Service) backup(ctx context.Context) {
+	start := time.Now()
+	if err := s.cfg.DB.IncrementalBackup(ctx, s.cfg.OutputDir, false); err != nil {
+		incrementalBackupFailures.Inc()
+		log.WithError(err).Error("Could not write incremental database backup")
+		return
+	}
+	incrementalBackupDuration.Observe(time.Since(start).Seconds())
+}
@@ -0,0 +1,59 @@
+package backup
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNewService_InvalidInterval(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{DB: testDB.SetupDB(t)})
+	assert.ErrorContains(t, "interval must be greater than 0", err)
+}
+
+func TestService_BacksUpOnSchedule(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 10
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, r))
+	require.NoError(t, beaconDB.SaveHeadBlockRoot(ctx, r))
+
+	outputDir := t.TempDir()
+	s, err := NewService(ctx, &Config{DB: beaconDB, Interval: 10 * time.Millisecond, OutputDir: outputDir})
+	require.NoError(t, err)
+	s.Start()
+	defer func() {
+		require.NoError(t, s.Stop())
+	}()
+
+	require.NoError(t, waitFor(func() bool {
+		files, err := ioutil.ReadDir(outputDir)
+		return err == nil && len(files) > 0
+	}))
+}
+
+func waitFor(done func() bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	for !done() {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+package db
+
+import (
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/urfave/cli/v2"
+)
+
+// Convert the beacon chain database in the data directory to the storage engine given by the
+// database backend flag.
+func Convert(cliCtx *cli.Context) error {
+	if !cliCtx.IsSet(cmd.DBBackendFlag.Name) {
+		return errors.New("the --" + cmd.DBBackendFlag.Name + " to convert the database to is required")
+	}
+	backend, err := engine.ParseKind(cliCtx.String(cmd.DBBackendFlag.Name))
+	if err != nil {
+		return err
+	}
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if err := kv.Convert(cliCtx.Context, path.Join(dataDir, kv.BeaconNodeDbDirName), backend); err != nil {
+		return err
+	}
+	log.Info("Conversion completed successfully")
+	return nil
+}
@@ -0,0 +1,135 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	bolt "go.etcd.io/bbolt"
+)
+
+const boltAllocSize = 8 * 1024 * 1024
+
+var _ DB = (*boltDB)(nil)
+
+// boltDB is the bbolt storage engine.
+type boltDB struct {
+	db *bolt.DB
+}
+
+// OpenBolt opens the bbolt database file at the given path, creating it if needed.
+func OpenBolt(filePath string, opts *Options) (DB, error) {
+	db, err := bolt.Open(
+		filePath,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{
+			Timeout:         1 * time.Second,
+			InitialMmapSize: opts.InitialMMapSize,
+		},
+	)
+	if err != nil {
+		if errors.Is(err, bolt.ErrTimeout) {
+			return nil, errLocked
+		}
+		return nil, err
+	}
+	db.AllocSize = boltAllocSize
+	return &boltDB{db: db}, nil
+}
+
+// WrapBolt returns the storage engine of an open bbolt database.
+func WrapBolt(db *bolt.DB) DB {
+	return &boltDB{db: db}
+}
+
+// UnwrapBolt returns the bbolt database of a bolt storage engine.
+func UnwrapBolt(db DB) (*bolt.DB, bool) {
+	b, ok := db.(*boltDB)
+	if !ok {
+		return nil, false
+	}
+	return b.db, true
+}
+
+// View runs the function in a read-only transaction.
+func (b *boltDB) View(fn func(tx Tx) error) error {
+	return b.db.View(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// Update runs the function in a read-write transaction.
+func (b *boltDB) Update(fn func(tx Tx) error) error {
+	return b.db.Update(func(tx *bolt.Tx) error {
+		return fn(&boltTx{tx: tx})
+	})
+}
+
+// Kind of the storage engine.
+func (*boltDB) Kind() Kind {
+	return Bolt
+}
+
+// Path of the database file.
+func (b *boltDB) Path() string {
+	return b.db.Path()
+}
+
+// Close the database.
+func (b *boltDB) Close() error {
+	return b.db.Close()
+}
+
+type boltTx struct {
+	tx *bolt.Tx
+}
+
+func (t *boltTx) Bucket(name []byte) Bucket {
+	bkt := t.tx.Bucket(name)
+	if bkt == nil {
+		return nil
+	}
+	return &boltBucket{bkt: bkt}
+}
+
+func (t *boltTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	bkt, err := t.tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return &boltBucket{bkt: bkt}, nil
+}
+
+func (t *boltTx) DeleteBucket(name []byte) error {
+	return t.tx.DeleteBucket(name)
+}
+
+func (t *boltTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	return t.tx.ForEach(func(name []byte, bkt *bolt.Bucket) error {
+		return fn(name, &boltBucket{bkt: bkt})
+	})
+}
+
+type boltBucket struct {
+	bkt *bolt.Bucket
+}
+
+func (b *boltBucket) Get(key []byte) []byte {
+	return b.bkt.Get(key)
+}
+
+func (b *boltBucket) Put(key, value []byte) error {
+	return b.bkt.Put(key, value)
+}
+
+func (b *boltBucket) Delete(key []byte) error {
+	return b.bkt.Delete(key)
+}
+
+func (b *boltBucket) ForEach(fn func(k, v []byte) error) error {
+	return b.bkt.ForEach(fn)
+}
+
+func (b *boltBucket) Cursor() Cursor {
+	return b.bkt.Cursor()
+}
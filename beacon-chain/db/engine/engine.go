@@ -0,0 +1,113 @@
+// Package engine defines the ordered key-value storage engines on which the beacon database
+// is built. An engine stores keys in named buckets and gives transactional access to them,
+// with any number of concurrent read-only transactions and a single read-write transaction
+// at a time. Two engines are available: bbolt, a B+tree stored in a single memory-mapped
+// file, and LevelDB, a log-structured merge tree which is better suited to write-heavy
+// workloads such as initial sync on archive nodes.
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var errLocked = errors.New("cannot obtain database lock, database may be in use by another process")
+
+// Kind of a storage engine.
+type Kind string
+
+const (
+	// Bolt is the bbolt storage engine.
+	Bolt Kind = "bolt"
+	// LevelDB is the LevelDB storage engine.
+	LevelDB Kind = "leveldb"
+)
+
+// Kinds lists the available storage engines.
+var Kinds = []Kind{Bolt, LevelDB}
+
+// ParseKind returns the storage engine with the given name.
+func ParseKind(name string) (Kind, error) {
+	for _, k := range Kinds {
+		if strings.EqualFold(name, string(k)) {
+			return k, nil
+		}
+	}
+	return "", fmt.Errorf("unknown database backend %q, expected one of %v", name, Kinds)
+}
+
+// Options to open a storage engine.
+type Options struct {
+	// InitialMMapSize is the initial size in bytes of the memory map of a bolt database.
+	InitialMMapSize int
+}
+
+// Open the storage engine of the given kind at the path, creating it if needed.
+func Open(kind Kind, path string, opts *Options) (DB, error) {
+	switch kind {
+	case Bolt:
+		return OpenBolt(path, opts)
+	case LevelDB:
+		return OpenLevelDB(path, opts)
+	default:
+		return nil, fmt.Errorf("unknown database backend %q", kind)
+	}
+}
+
+// DB is an open storage engine.
+type DB interface {
+	// View runs the function in a read-only transaction.
+	View(fn func(tx Tx) error) error
+	// Update runs the function in a read-write transaction, which is committed if the function
+	// returns no error and rolled back otherwise.
+	Update(fn func(tx Tx) error) error
+	// Kind of the storage engine.
+	Kind() Kind
+	// Path of the files of the storage engine.
+	Path() string
+	// Close the storage engine.
+	Close() error
+}
+
+// Tx is a transaction on a storage engine. The keys and values it returns are only valid
+// for the life of the transaction and must not be modified.
+type Tx interface {
+	// Bucket returns the bucket with the given name, or nil if it does not exist.
+	Bucket(name []byte) Bucket
+	// CreateBucketIfNotExists creates the bucket with the given name if needed and returns it.
+	CreateBucketIfNotExists(name []byte) (Bucket, error)
+	// DeleteBucket deletes the bucket with the given name and all of its keys.
+	DeleteBucket(name []byte) error
+	// ForEach calls the function for each bucket, in order of name.
+	ForEach(fn func(name []byte, b Bucket) error) error
+}
+
+// Bucket is a collection of keys, stored in order.
+type Bucket interface {
+	// Get the value of a key, or nil if the key does not exist.
+	Get(key []byte) []byte
+	// Put sets the value of a key.
+	Put(key, value []byte) error
+	// Delete a key. Deleting a key which does not exist is not an error.
+	Delete(key []byte) error
+	// ForEach calls the function for each key and value of the bucket, in order of key.
+	ForEach(fn func(k, v []byte) error) error
+	// Cursor returns a cursor to iterate over the keys of the bucket.
+	Cursor() Cursor
+}
+
+// Cursor iterates over the keys of a bucket in order. A nil key is returned once the cursor
+// moves past the first or last key of the bucket.
+type Cursor interface {
+	// First moves the cursor to the first key of the bucket.
+	First() (key, value []byte)
+	// Last moves the cursor to the last key of the bucket.
+	Last() (key, value []byte)
+	// Next moves the cursor to the next key of the bucket.
+	Next() (key, value []byte)
+	// Prev moves the cursor to the previous key of the bucket.
+	Prev() (key, value []byte)
+	// Seek moves the cursor to the given key, or to the next key if it does not exist.
+	Seek(seek []byte) (key, value []byte)
+}
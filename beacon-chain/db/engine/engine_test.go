@@ -0,0 +1,173 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func openEngines(t *testing.T) map[Kind]DB {
+	engines := make(map[Kind]DB)
+	for _, kind := range Kinds {
+		db, err := Open(kind, filepath.Join(t.TempDir(), "db"), &Options{})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, db.Close())
+		})
+		engines[kind] = db
+	}
+	return engines
+}
+
+func TestParseKind(t *testing.T) {
+	kind, err := ParseKind("LevelDB")
+	require.NoError(t, err)
+	assert.Equal(t, LevelDB, kind)
+	_, err = ParseKind("rocksdb")
+	assert.ErrorContains(t, "unknown database backend", err)
+}
+
+func TestEngine_Buckets(t *testing.T) {
+	for kind, db := range openEngines(t) {
+		t.Run(string(kind), func(t *testing.T) {
+			require.NoError(t, db.Update(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket([]byte("a")))
+				for _, name := range []string{"b", "a", "ab"} {
+					bkt, err := tx.CreateBucketIfNotExists([]byte(name))
+					require.NoError(t, err)
+					require.NoError(t, bkt.Put([]byte("key"), []byte(name)))
+				}
+				return nil
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				var names []string
+				require.NoError(t, tx.ForEach(func(name []byte, b Bucket) error {
+					names = append(names, string(name))
+					assert.DeepEqual(t, name, b.Get([]byte("key")))
+					return nil
+				}))
+				assert.DeepEqual(t, []string{"a", "ab", "b"}, names)
+				assert.ErrorContains(t, "not writable", tx.Bucket([]byte("a")).Put([]byte("key"), []byte("value")))
+				return nil
+			}))
+			require.NoError(t, db.Update(func(tx Tx) error {
+				return tx.DeleteBucket([]byte("a"))
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket([]byte("a")))
+				assert.DeepEqual(t, []byte("ab"), tx.Bucket([]byte("ab")).Get([]byte("key")))
+				return nil
+			}))
+		})
+	}
+}
+
+func TestEngine_UpdateRollsBackOnError(t *testing.T) {
+	for kind, db := range openEngines(t) {
+		t.Run(string(kind), func(t *testing.T) {
+			errRollback := errors.New("rollback")
+			err := db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucketIfNotExists([]byte("bucket"))
+				require.NoError(t, err)
+				require.NoError(t, bkt.Put([]byte("key"), []byte("value")))
+				assert.DeepEqual(t, []byte("value"), bkt.Get([]byte("key")))
+				return errRollback
+			})
+			assert.Equal(t, errRollback, err)
+			require.NoError(t, db.View(func(tx Tx) error {
+				assert.Equal(t, nil, tx.Bucket([]byte("bucket")))
+				return nil
+			}))
+		})
+	}
+}
+
+// TestEngine_SameAsBolt checks that cursors iterate over the same keys on every engine while
+// keys are written and deleted in a transaction, and once it is committed.
+func TestEngine_SameAsBolt(t *testing.T) {
+	engines := openEngines(t)
+	name := []byte("bucket")
+	key := func(i int) []byte {
+		return []byte(fmt.Sprintf("%03d", i))
+	}
+
+	for round := 0; round < 5; round++ {
+		r := rand.New(rand.NewSource(int64(round)))
+		ops := make([][2]int, 200)
+		for i := range ops {
+			ops[i] = [2]int{r.Intn(3), r.Intn(100)}
+		}
+		seeks := make([][]byte, 10)
+		for i := range seeks {
+			seeks[i] = key(r.Intn(110))
+		}
+		traversals := make(map[Kind][]string)
+		for kind, db := range engines {
+			traverse := func(tx Tx) {
+				c := tx.Bucket(name).Cursor()
+				var seen []string
+				for k, v := c.First(); k != nil; k, v = c.Next() {
+					seen = append(seen, string(k)+"="+string(v))
+				}
+				for k, _ := c.Last(); k != nil; k, _ = c.Prev() {
+					seen = append(seen, string(k))
+				}
+				for _, seek := range seeks {
+					k, _ := c.Seek(seek)
+					prev, _ := c.Prev()
+					next, _ := c.Next()
+					next2, _ := c.Next()
+					seen = append(seen, fmt.Sprintf("%s:%s,%s,%s,%s", seek, k, prev, next, next2))
+				}
+				traversals[kind] = append(traversals[kind], seen...)
+			}
+			require.NoError(t, db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucketIfNotExists(name)
+				require.NoError(t, err)
+				for i, op := range ops {
+					switch op[0] {
+					case 0:
+						require.NoError(t, bkt.Delete(key(op[1])))
+					default:
+						require.NoError(t, bkt.Put(key(op[1]), key(i)))
+					}
+				}
+				traverse(tx)
+				return nil
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				traverse(tx)
+				return nil
+			}))
+		}
+		assert.DeepEqual(t, traversals[Bolt], traversals[LevelDB], "Round %d", round)
+	}
+}
+
+func TestEngine_AppendToValueInTransaction(t *testing.T) {
+	for kind, db := range openEngines(t) {
+		t.Run(string(kind), func(t *testing.T) {
+			require.NoError(t, db.Update(func(tx Tx) error {
+				bkt, err := tx.CreateBucketIfNotExists([]byte("bucket"))
+				require.NoError(t, err)
+				require.NoError(t, bkt.Put([]byte("a"), []byte("1")))
+				require.NoError(t, bkt.Put([]byte("b"), []byte("2")))
+				// Appending to a value must not overwrite the values written after it.
+				v := append(bkt.Get([]byte("a")), '3')
+				assert.DeepEqual(t, []byte("2"), bkt.Get([]byte("b")))
+				return bkt.Put([]byte("a"), v)
+			}))
+			require.NoError(t, db.View(func(tx Tx) error {
+				bkt := tx.Bucket([]byte("bucket"))
+				assert.DeepEqual(t, []byte("13"), bkt.Get([]byte("a")))
+				assert.DeepEqual(t, []byte("2"), bkt.Get([]byte("b")))
+				return nil
+			}))
+		})
+	}
+}
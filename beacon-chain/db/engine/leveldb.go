@@ -0,0 +1,446 @@
+package engine
+
+import (
+	"bytes"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/comparer"
+	lerrors "github.com/syndtr/goleveldb/leveldb/errors"
+	"github.com/syndtr/goleveldb/leveldb/filter"
+	"github.com/syndtr/goleveldb/leveldb/iterator"
+	"github.com/syndtr/goleveldb/leveldb/memdb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	levelDBCacheSize       = 256 * opt.MiB
+	levelDBWriteBufferSize = 64 * opt.MiB
+	levelDBOpenFiles       = 512
+	levelDBMaxBucketName   = 255
+)
+
+// Every key of a bucket is stored under a prefix made of the length of the bucket name followed
+// by the name, so that no bucket prefix is the prefix of another one. The existence of a bucket
+// is recorded under the metadata prefix, which no bucket prefix starts with.
+var levelDBBucketsPrefix = []byte{0}
+
+// Values written in a transaction are flagged, to tell deleted keys from written ones until the
+// transaction is committed.
+const (
+	levelDBDeleted byte = iota
+	levelDBWritten
+)
+
+var (
+	errTxNotWritable  = errors.New("tx not writable")
+	errKeyRequired    = errors.New("key required")
+	errBucketNotFound = errors.New("bucket not found")
+	errBucketName     = errors.New("bucket name required and at most 255 bytes long")
+)
+
+var _ DB = (*levelDB)(nil)
+
+// levelDB is the LevelDB storage engine. Read-only transactions read from a snapshot of the
+// database. A read-write transaction buffers its writes in memory, where its own reads see
+// them, and writes them in a single atomic batch when it is committed.
+type levelDB struct {
+	db   *leveldb.DB
+	path string
+	// Only one read-write transaction runs at a time, so that it never overwrites the writes of
+	// another transaction which committed after its snapshot was taken.
+	writeLock sync.Mutex
+	// Like bolt, closing the database waits for the open transactions to end.
+	closeLock sync.RWMutex
+}
+
+// OpenLevelDB opens the LevelDB database in the directory at the given path, creating it if
+// needed.
+func OpenLevelDB(dirPath string, _ *Options) (DB, error) {
+	db, err := leveldb.OpenFile(dirPath, &opt.Options{
+		BlockCacheCapacity:     levelDBCacheSize,
+		WriteBuffer:            levelDBWriteBufferSize,
+		OpenFilesCacheCapacity: levelDBOpenFiles,
+		Filter:                 filter.NewBloomFilter(10),
+	})
+	if _, corrupted := err.(*lerrors.ErrCorrupted); corrupted {
+		db, err = leveldb.RecoverFile(dirPath, nil)
+	}
+	if err != nil {
+		if errors.Is(err, storage.ErrLocked) {
+			return nil, errLocked
+		}
+		return nil, errors.Wrap(err, "could not open leveldb database")
+	}
+	return &levelDB{db: db, path: dirPath}, nil
+}
+
+// View runs the function in a read-only transaction.
+func (l *levelDB) View(fn func(tx Tx) error) error {
+	l.closeLock.RLock()
+	defer l.closeLock.RUnlock()
+	tx, err := l.begin(false)
+	if err != nil {
+		return err
+	}
+	defer tx.release()
+	return fn(tx)
+}
+
+// Update runs the function in a read-write transaction.
+func (l *levelDB) Update(fn func(tx Tx) error) error {
+	l.closeLock.RLock()
+	defer l.closeLock.RUnlock()
+	l.writeLock.Lock()
+	defer l.writeLock.Unlock()
+	tx, err := l.begin(true)
+	if err != nil {
+		return err
+	}
+	defer tx.release()
+	if err := fn(tx); err != nil {
+		return err
+	}
+	return tx.commit()
+}
+
+// Kind of the storage engine.
+func (*levelDB) Kind() Kind {
+	return LevelDB
+}
+
+// Path of the database directory.
+func (l *levelDB) Path() string {
+	return l.path
+}
+
+// Close the database.
+func (l *levelDB) Close() error {
+	l.closeLock.Lock()
+	defer l.closeLock.Unlock()
+	return l.db.Close()
+}
+
+func (l *levelDB) begin(writable bool) (*levelDBTx, error) {
+	snapshot, err := l.db.GetSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	tx := &levelDBTx{db: l.db, snapshot: snapshot}
+	if writable {
+		tx.writes = memdb.New(comparer.DefaultComparer, 0)
+	}
+	return tx, nil
+}
+
+type levelDBTx struct {
+	db       *leveldb.DB
+	snapshot *leveldb.Snapshot
+	// writes holds the writes of a read-write transaction, and is nil for read-only ones.
+	writes    *memdb.DB
+	iterators []iterator.Iterator
+}
+
+func (t *levelDBTx) Bucket(name []byte) Bucket {
+	if len(name) == 0 || len(name) > levelDBMaxBucketName || t.get(levelDBBucketKey(name)) == nil {
+		return nil
+	}
+	return &levelDBBucket{tx: t, prefix: levelDBBucketPrefix(name)}
+}
+
+func (t *levelDBTx) CreateBucketIfNotExists(name []byte) (Bucket, error) {
+	if len(name) == 0 || len(name) > levelDBMaxBucketName {
+		return nil, errBucketName
+	}
+	if bkt := t.Bucket(name); bkt != nil {
+		return bkt, nil
+	}
+	if err := t.put(levelDBBucketKey(name), []byte{}); err != nil {
+		return nil, err
+	}
+	return &levelDBBucket{tx: t, prefix: levelDBBucketPrefix(name)}, nil
+}
+
+func (t *levelDBTx) DeleteBucket(name []byte) error {
+	if t.writes == nil {
+		return errTxNotWritable
+	}
+	if t.Bucket(name) == nil {
+		return errBucketNotFound
+	}
+	c := t.cursor(levelDBBucketPrefix(name))
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if err := t.delete(append(levelDBBucketPrefix(name), k...)); err != nil {
+			return err
+		}
+	}
+	return t.delete(levelDBBucketKey(name))
+}
+
+func (t *levelDBTx) ForEach(fn func(name []byte, b Bucket) error) error {
+	c := t.cursor(levelDBBucketsPrefix)
+	for name, _ := c.First(); name != nil; name, _ = c.Next() {
+		if err := fn(name, &levelDBBucket{tx: t, prefix: levelDBBucketPrefix(name)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *levelDBTx) get(key []byte) []byte {
+	if t.writes != nil {
+		if v, err := t.writes.Get(key); err == nil {
+			if v[0] == levelDBDeleted {
+				return nil
+			}
+			return capped(v[1:])
+		}
+	}
+	v, err := t.snapshot.Get(key, nil)
+	if err != nil {
+		return nil
+	}
+	return v
+}
+
+func (t *levelDBTx) put(key, value []byte) error {
+	if t.writes == nil {
+		return errTxNotWritable
+	}
+	return t.writes.Put(key, append([]byte{levelDBWritten}, value...))
+}
+
+func (t *levelDBTx) delete(key []byte) error {
+	if t.writes == nil {
+		return errTxNotWritable
+	}
+	return t.writes.Put(key, []byte{levelDBDeleted})
+}
+
+// cursor returns a cursor over the keys with the given prefix, which merges the writes of the
+// transaction into its snapshot.
+func (t *levelDBTx) cursor(prefix []byte) *levelDBCursor {
+	r := util.BytesPrefix(prefix)
+	base := t.snapshot.NewIterator(r, nil)
+	t.iterators = append(t.iterators, base)
+	var writes iterator.Iterator
+	if t.writes != nil {
+		writes = t.writes.NewIterator(r)
+	} else {
+		writes = iterator.NewEmptyIterator(nil)
+	}
+	t.iterators = append(t.iterators, writes)
+	return &levelDBCursor{prefix: prefix, base: base, writes: writes}
+}
+
+func (t *levelDBTx) commit() error {
+	if t.writes.Len() == 0 {
+		return nil
+	}
+	batch := new(leveldb.Batch)
+	it := t.writes.NewIterator(nil)
+	defer it.Release()
+	for it.Next() {
+		if v := it.Value(); v[0] == levelDBDeleted {
+			batch.Delete(it.Key())
+		} else {
+			batch.Put(it.Key(), v[1:])
+		}
+	}
+	if err := it.Error(); err != nil {
+		return err
+	}
+	return t.db.Write(batch, nil)
+}
+
+func (t *levelDBTx) release() {
+	for _, it := range t.iterators {
+		it.Release()
+	}
+	t.snapshot.Release()
+}
+
+type levelDBBucket struct {
+	tx     *levelDBTx
+	prefix []byte
+}
+
+func (b *levelDBBucket) Get(key []byte) []byte {
+	return b.tx.get(b.key(key))
+}
+
+func (b *levelDBBucket) Put(key, value []byte) error {
+	if len(key) == 0 {
+		return errKeyRequired
+	}
+	return b.tx.put(b.key(key), value)
+}
+
+func (b *levelDBBucket) Delete(key []byte) error {
+	return b.tx.delete(b.key(key))
+}
+
+func (b *levelDBBucket) ForEach(fn func(k, v []byte) error) error {
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.Next() {
+		if err := fn(k, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (b *levelDBBucket) Cursor() Cursor {
+	return b.tx.cursor(b.prefix)
+}
+
+func (b *levelDBBucket) key(key []byte) []byte {
+	k := make([]byte, len(b.prefix)+len(key))
+	copy(k, b.prefix)
+	copy(k[len(b.prefix):], key)
+	return k
+}
+
+func levelDBBucketPrefix(name []byte) []byte {
+	return append([]byte{byte(len(name))}, name...)
+}
+
+func levelDBBucketKey(name []byte) []byte {
+	return append(append([]byte{}, levelDBBucketsPrefix...), name...)
+}
+
+// levelDBCursor merges the writes of a transaction, which take precedence, with the keys of its
+// snapshot. When moving forward, both iterators are positioned at the first key at or after the
+// current key, and when moving backward, at the last key at or before it.
+type levelDBCursor struct {
+	prefix  []byte
+	base    iterator.Iterator
+	writes  iterator.Iterator
+	key     []byte
+	forward bool
+}
+
+func (c *levelDBCursor) First() ([]byte, []byte) {
+	c.base.First()
+	c.writes.First()
+	return c.settle(true)
+}
+
+func (c *levelDBCursor) Last() ([]byte, []byte) {
+	c.base.Last()
+	c.writes.Last()
+	return c.settle(false)
+}
+
+func (c *levelDBCursor) Seek(seek []byte) ([]byte, []byte) {
+	k := append(append([]byte{}, c.prefix...), seek...)
+	c.base.Seek(k)
+	c.writes.Seek(k)
+	return c.settle(true)
+}
+
+func (c *levelDBCursor) Next() ([]byte, []byte) {
+	if c.key == nil {
+		return nil, nil
+	}
+	if c.forward {
+		c.step(c.base, c.key, true)
+		c.step(c.writes, c.key, true)
+	} else {
+		seekAfter(c.base, c.key)
+		seekAfter(c.writes, c.key)
+	}
+	return c.settle(true)
+}
+
+func (c *levelDBCursor) Prev() ([]byte, []byte) {
+	if c.key == nil {
+		// Like bolt, moving back from past the last key moves to the last key.
+		if c.forward {
+			return c.Last()
+		}
+		return nil, nil
+	}
+	if c.forward {
+		seekBefore(c.base, c.key)
+		seekBefore(c.writes, c.key)
+	} else {
+		c.step(c.base, c.key, false)
+		c.step(c.writes, c.key, false)
+	}
+	return c.settle(false)
+}
+
+// step moves the iterator past the key if it is positioned on it.
+func (c *levelDBCursor) step(it iterator.Iterator, key []byte, forward bool) {
+	if !it.Valid() || !bytes.Equal(it.Key(), key) {
+		return
+	}
+	if forward {
+		it.Next()
+	} else {
+		it.Prev()
+	}
+}
+
+// settle positions the cursor on the closest key in the direction of the move which was not
+// deleted by the transaction.
+func (c *levelDBCursor) settle(forward bool) ([]byte, []byte) {
+	c.forward = forward
+	for {
+		baseValid, writesValid := c.base.Valid(), c.writes.Valid()
+		if !baseValid && !writesValid {
+			c.key = nil
+			return nil, nil
+		}
+		cmp := 0
+		if baseValid && writesValid {
+			cmp = bytes.Compare(c.writes.Key(), c.base.Key())
+		}
+		if !forward {
+			cmp = -cmp
+		}
+		if !writesValid || (baseValid && cmp > 0) {
+			// The keys and values of the snapshot iterator are only valid until it moves.
+			c.key = append([]byte{}, c.base.Key()...)
+			v := append([]byte{}, c.base.Value()...)
+			return c.key[len(c.prefix):], v
+		}
+		// The write takes precedence over the key of the snapshot.
+		key, v := c.writes.Key(), c.writes.Value()
+		if v[0] == levelDBDeleted {
+			if baseValid && cmp == 0 {
+				c.step(c.base, key, forward)
+			}
+			c.step(c.writes, key, forward)
+			continue
+		}
+		c.key = key
+		return capped(key[len(c.prefix):]), capped(v[1:])
+	}
+}
+
+// capped limits the capacity of a slice of the transaction writes to its length, so that
+// appending to it never overwrites the writes which follow it in memory.
+func capped(b []byte) []byte {
+	return b[:len(b):len(b)]
+}
+
+// seekAfter positions the iterator at the first key after the given one.
+func seekAfter(it iterator.Iterator, key []byte) {
+	if it.Seek(key) && bytes.Equal(it.Key(), key) {
+		it.Next()
+	}
+}
+
+// seekBefore positions the iterator at the last key before the given one.
+func seekBefore(it iterator.Iterator, key []byte) {
+	if it.Seek(key) {
+		it.Prev()
+	} else {
+		it.Last()
+	}
+}
@@ -8,4 +8,7 @@ var (
 	// ErrExistingGenesisState is an error when the user attempts to save a different genesis state
 	// when one already exists in a database.
 	ErrExistingGenesisState = errors.New("genesis state exists already in the DB")
+	// ErrNoOriginCheckpointBlockRoot is returned when no checkpoint sync origin block root exists in
+	// the database, which is the case for nodes that were synced from genesis.
+	ErrNoOriginCheckpointBlockRoot = errors.New("no origin checkpoint block root in db")
 )
@@ -0,0 +1,98 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// BackfillBlockRoot returns the root of the lowest block which has been backfilled, meaning every
+// block between it and the checkpoint sync origin is in the database. Before any block has been
+// backfilled, this is the origin block root. If the node was not started from a checkpoint,
+// iface.ErrNoOriginCheckpointBlockRoot is returned.
+func (s *Store) BackfillBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.BackfillBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx engine.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		r := bkt.Get(backfillBlockRootKey)
+		if r == nil {
+			r = bkt.Get(originCheckpointBlockRootKey)
+		}
+		if r == nil {
+			return dbIface.ErrNoOriginCheckpointBlockRoot
+		}
+		root = bytesutil.ToBytes32(r)
+		return nil
+	})
+	return root, err
+}
+
+// SaveBackfillBlockRoot records the lowest backfilled block root. Every block between the previous
+// backfill block root and the given root must already be in the database. Those blocks are added to
+// the finalized block roots index, as blocks before the checkpoint sync origin are finalized by
+// definition.
+func (s *Store) SaveBackfillBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveBackfillBlockRoot")
+	defer span.End()
+
+	return s.db.Update(func(tx engine.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		child := blocks.Get(backfillBlockRootKey)
+		if child == nil {
+			child = blocks.Get(originCheckpointBlockRootKey)
+		}
+		if child == nil {
+			return dbIface.ErrNoOriginCheckpointBlockRoot
+		}
+
+		// Walk down the ancestry chain from the previous backfill block root to the new one, linking
+		// every parent to its child in the finalized block roots index.
+		for bytesutil.ToBytes32(child) != blockRoot {
+			childBlock, err := blockFromTx(ctx, blocks, child)
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return err
+			}
+			parent := childBlock.Block().ParentRoot()
+			parentBlock, err := blockFromTx(ctx, blocks, parent)
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return err
+			}
+			enc, err := encode(ctx, &ethpb.FinalizedBlockRootContainer{
+				ParentRoot: parentBlock.Block().ParentRoot(),
+				ChildRoot:  child,
+			})
+			if err != nil {
+				traceutil.AnnotateError(span, err)
+				return err
+			}
+			if err := finalized.Put(parent, enc); err != nil {
+				traceutil.AnnotateError(span, err)
+				return err
+			}
+			child = parent
+		}
+		return blocks.Put(backfillBlockRootKey, blockRoot[:])
+	})
+}
+
+// blockFromTx reads a block from the blocks bucket within an existing transaction.
+func blockFromTx(ctx context.Context, bkt engine.Bucket, root []byte) (block.SignedBeaconBlock, error) {
+	enc := bkt.Get(root)
+	if enc == nil {
+		return nil, fmt.Errorf("missing block in database: block root=%#x", root)
+	}
+	return unmarshalBlock(ctx, enc)
+}
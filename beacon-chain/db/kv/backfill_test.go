@@ -0,0 +1,63 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_BackfillBlockRoot(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	_, err := db.BackfillBlockRoot(ctx)
+	require.Equal(t, iface.ErrNoOriginCheckpointBlockRoot, err)
+	require.Equal(t, iface.ErrNoOriginCheckpointBlockRoot, db.SaveBackfillBlockRoot(ctx, [32]byte{'a'}))
+
+	roots := make([][32]byte, 10)
+	var parentRoot [32]byte
+	for i := range roots {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = types.Slot(i)
+		blk.Block.ParentRoot = parentRoot[:]
+		require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+		roots[i], err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		parentRoot = roots[i]
+	}
+	origin := roots[9]
+	require.NoError(t, db.SaveOriginCheckpointBlockRoot(ctx, origin))
+
+	root, err := db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, origin, root)
+
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, roots[5]))
+	root, err = db.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[5], root)
+	for i := 5; i < 9; i++ {
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[i]), "block at slot %d not finalized", i)
+		child, err := db.FinalizedChildBlock(ctx, roots[i])
+		require.NoError(t, err)
+		childRoot, err := child.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, roots[i+1], childRoot)
+	}
+	assert.Equal(t, false, db.IsFinalizedBlock(ctx, roots[4]))
+
+	// Continue from the previous backfill block root.
+	require.NoError(t, db.SaveBackfillBlockRoot(ctx, roots[0]))
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[i]), "block at slot %d not finalized", i)
+	}
+
+	// Roots which are not ancestors of the backfilled chain are rejected.
+	require.ErrorContains(t, "missing block in database", db.SaveBackfillBlockRoot(ctx, [32]byte{'a'}))
+}
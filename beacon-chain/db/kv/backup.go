@@ -6,6 +6,7 @@ import (
 	"path"
 
 	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
@@ -20,15 +21,9 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.Backup")
 	defer span.End()
 
-	var backupsDir string
-	var err error
-	if outputDir != "" {
-		backupsDir, err = fileutil.ExpandPath(outputDir)
-		if err != nil {
-			return err
-		}
-	} else {
-		backupsDir = path.Join(s.databasePath, backupsDirectoryName)
+	backupsDir, err := s.backupsDirectory(outputDir)
+	if err != nil {
+		return err
 	}
 	head, err := s.HeadBlock(ctx)
 	if err != nil {
@@ -63,8 +58,8 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 	// bucket to use less memory usage when backing up.
 	var bucketKeys [][]byte
 	bucketMap := make(map[string][][]byte)
-	err = s.db.View(func(tx *bolt.Tx) error {
-		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+	err = s.db.View(func(tx engine.Tx) error {
+		return tx.ForEach(func(name []byte, b engine.Bucket) error {
 			newName := make([]byte, len(name))
 			copy(newName, name)
 			bucketKeys = append(bucketKeys, newName)
@@ -96,7 +91,7 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 		log.Debugf("Copying bucket %s\n", k)
 		innerKeys := bucketMap[string(k)]
 		for _, ik := range innerKeys {
-			err = s.db.View(func(tx *bolt.Tx) error {
+			err = s.db.View(func(tx engine.Tx) error {
 				bkt := tx.Bucket(k)
 				return copyDB.Update(func(tx2 *bolt.Tx) error {
 					b2, err := tx2.CreateBucketIfNotExists(k)
@@ -116,3 +111,11 @@ func (s *Store) Backup(ctx context.Context, outputDir string, permissionOverride
 	copyDB.NoSync = false
 	return nil
 }
+
+// backupsDirectory returns the expanded output directory, or the datadir backup directory if none is given.
+func (s *Store) backupsDirectory(outputDir string) (string, error) {
+	if outputDir != "" {
+		return fileutil.ExpandPath(outputDir)
+	}
+	return path.Join(s.databasePath, backupsDirectoryName), nil
+}
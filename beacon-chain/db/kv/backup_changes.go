@@ -0,0 +1,139 @@
+package kv
+
+import (
+	"bytes"
+	"encoding/binary"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+var (
+	// The changes bucket records the keys written to or deleted from the database since the latest
+	// incremental backup, with the number of the transaction which last changed them. It is created
+	// by the first incremental backup, so nothing is recorded by nodes which do not take any.
+	incrementalBackupChangesBucket = []byte("incremental-backup-changes")
+	// The number of the latest transaction which changed keys. Recorded keys start with the length of
+	// their bucket name, which is never zero.
+	incrementalBackupTxNumberKey = []byte{0}
+)
+
+// changeTrackingDB is a storage engine which records the keys changed by its read-write transactions
+// in the changes bucket, within the same transactions, once the changes bucket exists.
+type changeTrackingDB struct {
+	engine.DB
+}
+
+// Update runs the function in a read-write transaction which records the keys it changes.
+func (db *changeTrackingDB) Update(fn func(tx engine.Tx) error) error {
+	return db.DB.Update(func(tx engine.Tx) error {
+		changes := tx.Bucket(incrementalBackupChangesBucket)
+		if changes == nil {
+			return fn(tx)
+		}
+		return fn(&changeTrackingTx{Tx: tx, changes: changes})
+	})
+}
+
+type changeTrackingTx struct {
+	engine.Tx
+	changes engine.Bucket
+	// The number of the transaction, assigned when it changes its first key.
+	number []byte
+}
+
+func (t *changeTrackingTx) Bucket(name []byte) engine.Bucket {
+	bkt := t.Tx.Bucket(name)
+	if bkt == nil {
+		return nil
+	}
+	return t.track(name, bkt)
+}
+
+func (t *changeTrackingTx) CreateBucketIfNotExists(name []byte) (engine.Bucket, error) {
+	bkt, err := t.Tx.CreateBucketIfNotExists(name)
+	if err != nil {
+		return nil, err
+	}
+	return t.track(name, bkt), nil
+}
+
+func (t *changeTrackingTx) DeleteBucket(name []byte) error {
+	if bkt := t.Tx.Bucket(name); bkt != nil {
+		if err := bkt.ForEach(func(k, _ []byte) error {
+			return t.record(name, k)
+		}); err != nil {
+			return err
+		}
+	}
+	return t.Tx.DeleteBucket(name)
+}
+
+func (t *changeTrackingTx) ForEach(fn func(name []byte, b engine.Bucket) error) error {
+	return t.Tx.ForEach(func(name []byte, b engine.Bucket) error {
+		return fn(name, t.track(name, b))
+	})
+}
+
+// track wraps a bucket to record the keys written to or deleted from it, except for the changes
+// bucket itself.
+func (t *changeTrackingTx) track(name []byte, bkt engine.Bucket) engine.Bucket {
+	if bytes.Equal(name, incrementalBackupChangesBucket) {
+		return bkt
+	}
+	return &changeTrackingBucket{Bucket: bkt, tx: t, name: bytesutil.SafeCopyBytes(name)}
+}
+
+// record a key of a bucket as changed by the transaction.
+func (t *changeTrackingTx) record(name, key []byte) error {
+	if t.number == nil {
+		var latest uint64
+		if enc := t.changes.Get(incrementalBackupTxNumberKey); enc != nil {
+			latest = binary.BigEndian.Uint64(enc)
+		}
+		t.number = bytesutil.Uint64ToBytesBigEndian(latest + 1)
+		if err := t.changes.Put(incrementalBackupTxNumberKey, t.number); err != nil {
+			return err
+		}
+	}
+	return t.changes.Put(encodeChangeKey(name, key), t.number)
+}
+
+type changeTrackingBucket struct {
+	engine.Bucket
+	tx   *changeTrackingTx
+	name []byte
+}
+
+func (b *changeTrackingBucket) Put(key, value []byte) error {
+	if err := b.tx.record(b.name, key); err != nil {
+		return err
+	}
+	return b.Bucket.Put(key, value)
+}
+
+func (b *changeTrackingBucket) Delete(key []byte) error {
+	if err := b.tx.record(b.name, key); err != nil {
+		return err
+	}
+	return b.Bucket.Delete(key)
+}
+
+// encodeChangeKey returns the key of the changes bucket which records a key of a bucket: the length
+// of the bucket name, the bucket name and the key.
+func encodeChangeKey(name, key []byte) []byte {
+	enc := make([]byte, 0, 1+len(name)+len(key))
+	enc = append(enc, byte(len(name)))
+	enc = append(enc, name...)
+	return append(enc, key...)
+}
+
+// decodeChangeKey returns the bucket name and key recorded by a key of the changes bucket, or false
+// if it does not record one.
+func decodeChangeKey(enc []byte) (name, key []byte, ok bool) {
+	if len(enc) == 0 || enc[0] == 0 || len(enc) < 1+int(enc[0]) {
+		return nil, nil, false
+	}
+	n := 1 + int(enc[0])
+	return enc[1:n], enc[n:], true
+}
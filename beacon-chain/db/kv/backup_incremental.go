@@ -0,0 +1,518 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+const (
+	incrementalBackupsDirectoryName = "incremental"
+	incrementalBackupManifestName   = "manifest.db"
+	incrementalBackupFileFormat     = "prysm_beacondb_incremental_%05d_at_slot_%07d.backup"
+	// The number of keys, and the number of bytes, copied in a single write transaction.
+	incrementalBackupBatchKeys  = 1000
+	incrementalBackupBatchBytes = 64 << 20
+)
+
+var (
+	// The metadata bucket holds the sequence number and head slot of an incremental backup. In the
+	// manifest, it holds the sequence number of the next backup.
+	incrementalBackupMetadataBucket = []byte("incremental-backup-metadata")
+	// The deleted bucket holds a nested bucket for every bucket with keys deleted since the previous
+	// backup.
+	incrementalBackupDeletedBucket = []byte("incremental-backup-deleted")
+	incrementalBackupSequenceKey   = []byte("sequence")
+	incrementalBackupSlotKey       = []byte("slot")
+)
+
+// IncrementalBackup writes the keys which changed since the previous incremental backup to the
+// incremental backup directory. The first incremental backup holds the whole database.
+// Example for the third backup at slot 345: $DATADIR/backups/incremental/prysm_beacondb_incremental_00002_at_slot_0000345.backup
+//
+// The keys which change are recorded by the database as they are written, from the first incremental
+// backup on, and a manifest in the backup directory holds the sequence number of the next backup.
+// A backup is read in a single transaction, so it holds the database as of its head slot.
+func (s *Store) IncrementalBackup(ctx context.Context, outputDir string, permissionOverride bool) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.IncrementalBackup")
+	defer span.End()
+
+	backupsDir, err := s.backupsDirectory(outputDir)
+	if err != nil {
+		return err
+	}
+	backupsDir = path.Join(backupsDir, incrementalBackupsDirectoryName)
+	if err := fileutil.HandleBackupDir(backupsDir, permissionOverride); err != nil {
+		return err
+	}
+
+	manifest, err := openBackupDB(path.Join(backupsDir, incrementalBackupManifestName), false)
+	if err != nil {
+		return errors.Wrap(err, "could not open backup manifest")
+	}
+	defer func() {
+		if err := manifest.Close(); err != nil {
+			log.WithError(err).Error("Failed to close backup manifest")
+		}
+	}()
+	var sequence uint64
+	if err := manifest.View(func(tx *bolt.Tx) error {
+		if bkt := tx.Bucket(incrementalBackupMetadataBucket); bkt != nil {
+			if enc := bkt.Get(incrementalBackupSequenceKey); enc != nil {
+				sequence = binary.BigEndian.Uint64(enc)
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if sequence == 0 {
+		// Start recording the keys which change before the database is copied in full.
+		if err := s.db.Update(func(tx engine.Tx) error {
+			_, err := tx.CreateBucketIfNotExists(incrementalBackupChangesBucket)
+			return err
+		}); err != nil {
+			return err
+		}
+	}
+
+	// Remove what is left of a backup with the same sequence number which did not complete.
+	leftovers, err := filepath.Glob(path.Join(backupsDir, fmt.Sprintf("prysm_beacondb_incremental_%05d_at_slot_*.backup", sequence)))
+	if err != nil {
+		return err
+	}
+	for _, f := range leftovers {
+		if err := os.Remove(f); err != nil {
+			return errors.Wrap(err, "could not remove incomplete backup")
+		}
+	}
+
+	var backupPath string
+	var changed, deleted int
+	// The transaction numbers of the recorded changes which the backup holds.
+	backedUp := make(map[string][]byte)
+	if err := s.db.View(func(tx engine.Tx) error {
+		changes := tx.Bucket(incrementalBackupChangesBucket)
+		if changes == nil {
+			return fmt.Errorf("changes since the previous incremental backup were not recorded, "+
+				"move %s away to start a new sequence of incremental backups", backupsDir)
+		}
+		head, err := headBlockInTx(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if head == nil || head.IsNil() {
+			return errors.New("no head block")
+		}
+
+		backupPath = path.Join(backupsDir, fmt.Sprintf(incrementalBackupFileFormat, sequence, head.Block().Slot()))
+		log.WithField("backup", backupPath).Info("Writing incremental backup database.")
+		copyDB, err := openBackupDB(backupPath, true)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if err := copyDB.Close(); err != nil {
+				log.WithError(err).Error("Failed to close backup database")
+			}
+		}()
+		if err := copyDB.Update(func(btx *bolt.Tx) error {
+			bkt, err := btx.CreateBucketIfNotExists(incrementalBackupMetadataBucket)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(incrementalBackupSequenceKey, bytesutil.Uint64ToBytesBigEndian(sequence)); err != nil {
+				return err
+			}
+			return bkt.Put(incrementalBackupSlotKey, bytesutil.SlotToBytesBigEndian(head.Block().Slot()))
+		}); err != nil {
+			return err
+		}
+
+		batch := &backupBatch{db: copyDB}
+		if sequence == 0 {
+			if err := tx.ForEach(func(name []byte, bkt engine.Bucket) error {
+				if bytes.Equal(name, incrementalBackupChangesBucket) {
+					return nil
+				}
+				return bkt.ForEach(func(k, v []byte) error {
+					// Nested buckets are not used by the beacon DB.
+					if v == nil {
+						return nil
+					}
+					if ctx.Err() != nil {
+						return ctx.Err()
+					}
+					changed++
+					return batch.put(name, k, v)
+				})
+			}); err != nil {
+				return err
+			}
+		}
+		if err := changes.ForEach(func(k, number []byte) error {
+			name, key, ok := decodeChangeKey(k)
+			if !ok {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			backedUp[string(k)] = bytesutil.SafeCopyBytes(number)
+			// The first backup already holds every key.
+			if sequence == 0 {
+				return nil
+			}
+			var v []byte
+			if bkt := tx.Bucket(name); bkt != nil {
+				v = bkt.Get(key)
+			}
+			if v == nil {
+				deleted++
+				return batch.delete(name, key)
+			}
+			changed++
+			return batch.put(name, key, v)
+		}); err != nil {
+			return err
+		}
+		if err := batch.flush(); err != nil {
+			return err
+		}
+		// The backup must be on disk before the manifest moves past it.
+		copyDB.NoSync = false
+		return copyDB.Sync()
+	}); err != nil {
+		return err
+	}
+	if err := manifest.Update(func(tx *bolt.Tx) error {
+		bkt, err := tx.CreateBucketIfNotExists(incrementalBackupMetadataBucket)
+		if err != nil {
+			return err
+		}
+		return bkt.Put(incrementalBackupSequenceKey, bytesutil.Uint64ToBytesBigEndian(sequence+1))
+	}); err != nil {
+		return errors.Wrap(err, "could not update backup manifest")
+	}
+	// Keys changed again since the backup was read have a higher transaction number, and are kept for
+	// the next backup.
+	if err := s.db.Update(func(tx engine.Tx) error {
+		changes := tx.Bucket(incrementalBackupChangesBucket)
+		for k, number := range backedUp {
+			if bytes.Equal(changes.Get([]byte(k)), number) {
+				if err := changes.Delete([]byte(k)); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrap(err, "could not clear backed up changes")
+	}
+
+	log.WithFields(logrus.Fields{
+		"backup":  backupPath,
+		"changed": changed,
+		"deleted": deleted,
+	}).Info("Incremental backup completed")
+	return nil
+}
+
+// backupBatch buffers the keys written to a backup database, which are committed in write
+// transactions of bounded size.
+type backupBatch struct {
+	db      *bolt.DB
+	entries []backupEntry
+	size    int
+}
+
+type backupEntry struct {
+	name, key, value []byte
+	deleted          bool
+}
+
+// put adds a key and its value to the backup. The key and value must stay valid until the batch is
+// flushed.
+func (b *backupBatch) put(name, key, value []byte) error {
+	b.entries = append(b.entries, backupEntry{name: name, key: key, value: value})
+	b.size += len(value)
+	return b.flushIfFull()
+}
+
+// delete records a deleted key in the backup.
+func (b *backupBatch) delete(name, key []byte) error {
+	b.entries = append(b.entries, backupEntry{name: name, key: key, deleted: true})
+	return b.flushIfFull()
+}
+
+func (b *backupBatch) flushIfFull() error {
+	if len(b.entries) < incrementalBackupBatchKeys && b.size < incrementalBackupBatchBytes {
+		return nil
+	}
+	return b.flush()
+}
+
+// flush commits the buffered keys to the backup database.
+func (b *backupBatch) flush() error {
+	if len(b.entries) == 0 {
+		return nil
+	}
+	if err := b.db.Update(func(tx *bolt.Tx) error {
+		for _, e := range b.entries {
+			if !e.deleted {
+				bkt, err := tx.CreateBucketIfNotExists(e.name)
+				if err != nil {
+					return err
+				}
+				if err := bkt.Put(e.key, e.value); err != nil {
+					return err
+				}
+				continue
+			}
+			parent, err := tx.CreateBucketIfNotExists(incrementalBackupDeletedBucket)
+			if err != nil {
+				return err
+			}
+			bkt, err := parent.CreateBucketIfNotExists(e.name)
+			if err != nil {
+				return err
+			}
+			if err := bkt.Put(e.key, []byte{}); err != nil {
+				return err
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	b.entries = b.entries[:0]
+	b.size = 0
+	return nil
+}
+
+// RestoreIncrementalBackups restores a database file from the incremental backups in a directory. The
+// backups are applied in order up to the last one taken at or below the given slot, which is returned.
+// The database file must not exist yet, as the backups are applied on top of it.
+func RestoreIncrementalBackups(ctx context.Context, backupsDir, dbFilePath string, toSlot types.Slot) (types.Slot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.RestoreIncrementalBackups")
+	defer span.End()
+
+	backups, err := incrementalBackupFiles(backupsDir)
+	if err != nil {
+		return 0, err
+	}
+	if len(backups) == 0 || backups[0].slot > toSlot {
+		return 0, fmt.Errorf("no incremental backup at or below slot %d in %s", toSlot, backupsDir)
+	}
+
+	target, err := openBackupDB(dbFilePath, true)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		if err := target.Close(); err != nil {
+			log.WithError(err).Error("Failed to close restored database")
+		}
+	}()
+	var restoredSlot types.Slot
+	for _, b := range backups {
+		if b.slot > toSlot {
+			break
+		}
+		log.WithField("backup", b.path).Info("Applying incremental backup")
+		backup, err := bolt.Open(b.path, params.BeaconIoConfig().ReadWritePermissions, &bolt.Options{
+			ReadOnly: true,
+			Timeout:  params.BeaconIoConfig().BoltTimeout,
+		})
+		if err != nil {
+			return 0, err
+		}
+		err = applyIncrementalBackup(ctx, target, backup)
+		if closeErr := backup.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close backup database")
+		}
+		if err != nil {
+			return 0, errors.Wrapf(err, "could not apply backup %s", b.path)
+		}
+		restoredSlot = b.slot
+	}
+	target.NoSync = false
+	return restoredSlot, target.Sync()
+}
+
+type incrementalBackupFile struct {
+	path     string
+	sequence uint64
+	slot     types.Slot
+}
+
+// incrementalBackupFiles lists the incremental backups of a directory ordered by sequence number. An
+// error is returned if a backup is missing from the sequence.
+func incrementalBackupFiles(dir string) ([]*incrementalBackupFile, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	backups := make([]*incrementalBackupFile, 0, len(files))
+	for _, f := range files {
+		var sequence uint64
+		var slot types.Slot
+		if _, err := fmt.Sscanf(f.Name(), incrementalBackupFileFormat, &sequence, &slot); err != nil {
+			continue
+		}
+		backups = append(backups, &incrementalBackupFile{path: path.Join(dir, f.Name()), sequence: sequence, slot: slot})
+	}
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].sequence < backups[j].sequence
+	})
+	for i, b := range backups {
+		if b.sequence != uint64(i) {
+			return nil, fmt.Errorf("incremental backup %d is missing from %s", i, dir)
+		}
+	}
+	return backups, nil
+}
+
+// applyIncrementalBackup writes the keys of an incremental backup to the target database, and deletes
+// its deleted keys.
+func applyIncrementalBackup(ctx context.Context, target, backup *bolt.DB) error {
+	names, err := bucketNames(engine.WrapBolt(backup))
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		if bytes.Equal(name, incrementalBackupMetadataBucket) || bytes.Equal(name, incrementalBackupDeletedBucket) {
+			continue
+		}
+		name := name
+		source := func(tx *bolt.Tx) *bolt.Bucket {
+			return tx.Bucket(name)
+		}
+		if err := copyBucketInBatches(ctx, backup, target, name, source, func(bkt *bolt.Bucket, k, v []byte) error {
+			return bkt.Put(k, v)
+		}); err != nil {
+			return err
+		}
+	}
+
+	var deletedNames [][]byte
+	if err := backup.View(func(tx *bolt.Tx) error {
+		parent := tx.Bucket(incrementalBackupDeletedBucket)
+		if parent == nil {
+			return nil
+		}
+		return parent.ForEach(func(k, _ []byte) error {
+			deletedNames = append(deletedNames, bytesutil.SafeCopyBytes(k))
+			return nil
+		})
+	}); err != nil {
+		return err
+	}
+	for _, name := range deletedNames {
+		name := name
+		source := func(tx *bolt.Tx) *bolt.Bucket {
+			return tx.Bucket(incrementalBackupDeletedBucket).Bucket(name)
+		}
+		if err := copyBucketInBatches(ctx, backup, target, name, source, func(bkt *bolt.Bucket, k, _ []byte) error {
+			return bkt.Delete(k)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyBucketInBatches applies the given function to every key of a source bucket of the backup, within
+// write transactions of the target database on the bucket of the given name.
+func copyBucketInBatches(
+	ctx context.Context,
+	backup, target *bolt.DB,
+	name []byte,
+	source func(tx *bolt.Tx) *bolt.Bucket,
+	apply func(bkt *bolt.Bucket, k, v []byte) error,
+) error {
+	var start []byte
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		var next []byte
+		err := backup.View(func(tx *bolt.Tx) error {
+			src := source(tx)
+			if src == nil {
+				return nil
+			}
+			return target.Update(func(ttx *bolt.Tx) error {
+				bkt, err := ttx.CreateBucketIfNotExists(name)
+				if err != nil {
+					return err
+				}
+				c := src.Cursor()
+				k, v := c.First()
+				if start != nil {
+					k, v = c.Seek(start)
+				}
+				var scanned, size int
+				for ; k != nil; k, v = c.Next() {
+					if scanned >= incrementalBackupBatchKeys || size >= incrementalBackupBatchBytes {
+						next = bytesutil.SafeCopyBytes(k)
+						return nil
+					}
+					scanned++
+					size += len(v)
+					if err := apply(bkt, k, v); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+		})
+		if err != nil || next == nil {
+			return err
+		}
+		start = next
+	}
+}
+
+// bucketNames returns the names of the top level buckets of a database.
+func bucketNames(db engine.DB) ([][]byte, error) {
+	names := make([][]byte, 0)
+	err := db.View(func(tx engine.Tx) error {
+		return tx.ForEach(func(name []byte, _ engine.Bucket) error {
+			names = append(names, bytesutil.SafeCopyBytes(name))
+			return nil
+		})
+	})
+	return names, err
+}
+
+// openBackupDB opens a bolt database for writing a backup. Syncing is disabled when noSync is set,
+// and must be turned back on once the backup is complete.
+func openBackupDB(filePath string, noSync bool) (*bolt.DB, error) {
+	db, err := bolt.Open(
+		filePath,
+		params.BeaconIoConfig().ReadWritePermissions,
+		&bolt.Options{NoSync: noSync, Timeout: params.BeaconIoConfig().BoltTimeout, FreelistType: bolt.FreelistMapType},
+	)
+	if err != nil {
+		return nil, err
+	}
+	db.AllocSize = boltAllocSize
+	return db, nil
+}
@@ -0,0 +1,191 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	bolt "go.etcd.io/bbolt"
+)
+
+func TestStore_IncrementalBackup(t *testing.T) {
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{})
+	require.NoError(t, err, "Failed to instantiate DB")
+	ctx := context.Background()
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+
+	saveHead := func(slot types.Slot) [32]byte {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, db.SaveState(ctx, st, r))
+		require.NoError(t, db.SaveHeadBlockRoot(ctx, r))
+		return r
+	}
+
+	first := saveHead(10)
+	require.NoError(t, db.IncrementalBackup(ctx, "", false))
+	second := saveHead(20)
+	require.NoError(t, db.IncrementalBackup(ctx, "", false))
+	require.NoError(t, db.deleteBlocks(ctx, [][32]byte{first}))
+	third := saveHead(30)
+	require.NoError(t, db.IncrementalBackup(ctx, "", false))
+
+	backupsDir := filepath.Join(db.databasePath, backupsDirectoryName, incrementalBackupsDirectoryName)
+	backups, err := incrementalBackupFiles(backupsDir)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(backups))
+	assert.Equal(t, types.Slot(20), backups[1].slot)
+
+	// The second backup only holds what changed after the first one.
+	backup, err := bolt.Open(backups[1].path, 0600, &bolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	require.NoError(t, backup.View(func(tx *bolt.Tx) error {
+		bkt := tx.Bucket(blocksBucket)
+		assert.Equal(t, true, bkt.Get(second[:]) != nil, "New block was not backed up")
+		assert.Equal(t, true, bkt.Get(first[:]) == nil, "Unchanged block was backed up again")
+		assert.DeepEqual(t, second[:], bkt.Get(headBlockRootKey))
+		return nil
+	}))
+	require.NoError(t, backup.Close())
+	require.NoError(t, db.Close())
+
+	// Restore to the second backup, before the first block was deleted.
+	restoreDir := t.TempDir()
+	slot, err := RestoreIncrementalBackups(ctx, backupsDir, KVStoreDatafilePath(restoreDir), 25)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), slot)
+	restored, err := NewKVStore(ctx, restoreDir, &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, true, restored.HasBlock(ctx, first))
+	assert.Equal(t, true, restored.HasBlock(ctx, second))
+	assert.Equal(t, false, restored.HasBlock(ctx, third))
+	head, err := restored.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(20), head.Block().Slot())
+	require.NoError(t, restored.Close())
+
+	// Restore every backup, which applies the deletion.
+	restoreDir = t.TempDir()
+	slot, err = RestoreIncrementalBackups(ctx, backupsDir, KVStoreDatafilePath(restoreDir), 1000)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(30), slot)
+	restored, err = NewKVStore(ctx, restoreDir, &Config{})
+	require.NoError(t, err)
+	assert.Equal(t, false, restored.HasBlock(ctx, first))
+	assert.Equal(t, true, restored.HasBlock(ctx, third))
+	require.NoError(t, restored.Close())
+
+	_, err = RestoreIncrementalBackups(ctx, backupsDir, KVStoreDatafilePath(t.TempDir()), 5)
+	assert.ErrorContains(t, "no incremental backup at or below slot 5", err)
+}
+
+func TestStore_IncrementalBackup_LargeValueUpdatedInPlace(t *testing.T) {
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{})
+	require.NoError(t, err, "Failed to instantiate DB")
+	ctx := context.Background()
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 10
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, r))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, r))
+
+	// A large value is replaced by a better one of the same length, as light client updates are.
+	key := bytesutil.Uint64ToBytesBigEndian(1)
+	saveUpdate := func(v byte) {
+		require.NoError(t, db.db.Update(func(tx engine.Tx) error {
+			return tx.Bucket(lightClientUpdatesBucket).Put(key, bytes.Repeat([]byte{v}, 25000))
+		}))
+	}
+	saveUpdate(1)
+	require.NoError(t, db.IncrementalBackup(ctx, "", false))
+	saveUpdate(2)
+	require.NoError(t, db.IncrementalBackup(ctx, "", false))
+	require.NoError(t, db.Close())
+
+	backupsDir := filepath.Join(db.databasePath, backupsDirectoryName, incrementalBackupsDirectoryName)
+	restoreDir := t.TempDir()
+	_, err = RestoreIncrementalBackups(ctx, backupsDir, KVStoreDatafilePath(restoreDir), 1000)
+	require.NoError(t, err)
+	restored, err := NewKVStore(ctx, restoreDir, &Config{})
+	require.NoError(t, err)
+	require.NoError(t, restored.db.View(func(tx engine.Tx) error {
+		assert.DeepEqual(t, bytes.Repeat([]byte{2}, 25000), tx.Bucket(lightClientUpdatesBucket).Get(key))
+		return nil
+	}))
+	require.NoError(t, restored.Close())
+}
+
+func TestStore_IncrementalBackup_RecordsChangesWhenWritten(t *testing.T) {
+	db, err := NewKVStore(context.Background(), t.TempDir(), &Config{})
+	require.NoError(t, err, "Failed to instantiate DB")
+	ctx := context.Background()
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 10
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	r, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, r))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, r))
+
+	recordedChanges := func() [][]byte {
+		var keys [][]byte
+		require.NoError(t, db.db.View(func(tx engine.Tx) error {
+			bkt := tx.Bucket(incrementalBackupChangesBucket)
+			if bkt == nil {
+				return nil
+			}
+			return bkt.ForEach(func(k, _ []byte) error {
+				if _, key, ok := decodeChangeKey(k); ok {
+					keys = append(keys, bytesutil.SafeCopyBytes(key))
+				}
+				return nil
+			})
+		}))
+		return keys
+	}
+	// Nothing is recorded before the first incremental backup.
+	assert.Equal(t, 0, len(recordedChanges()))
+	require.NoError(t, db.IncrementalBackup(ctx, "", false))
+	assert.Equal(t, 0, len(recordedChanges()))
+
+	key := bytesutil.Uint64ToBytesBigEndian(7)
+	require.NoError(t, db.db.Update(func(tx engine.Tx) error {
+		return tx.Bucket(lightClientUpdatesBucket).Put(key, []byte{1})
+	}))
+	assert.DeepEqual(t, [][]byte{key}, recordedChanges())
+	require.NoError(t, db.IncrementalBackup(ctx, "", false))
+	assert.Equal(t, 0, len(recordedChanges()))
+	require.NoError(t, db.Close())
+
+	// The second backup only holds the recorded key.
+	backupsDir := filepath.Join(db.databasePath, backupsDirectoryName, incrementalBackupsDirectoryName)
+	backups, err := incrementalBackupFiles(backupsDir)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(backups))
+	backup, err := bolt.Open(backups[1].path, 0600, &bolt.Options{ReadOnly: true})
+	require.NoError(t, err)
+	require.NoError(t, backup.View(func(tx *bolt.Tx) error {
+		assert.Equal(t, true, tx.Bucket(blocksBucket) == nil, "Unchanged bucket was backed up")
+		assert.DeepEqual(t, []byte{1}, tx.Bucket(lightClientUpdatesBucket).Get(key))
+		return nil
+	}))
+	require.NoError(t, backup.Close())
+}
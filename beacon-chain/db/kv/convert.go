@@ -0,0 +1,135 @@
+package kv
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+const (
+	convertBatchKeys  = 10000
+	convertBatchBytes = 64 << 20
+	// convertedSuffix is appended to the path of the source database once it was converted.
+	convertedSuffix = ".converted"
+)
+
+// Convert the database in the directory to the given storage engine. The keys of every bucket are
+// copied in batches to a new database, and the source database is then renamed with a
+// ".converted" suffix, so that it can be deleted once the converted database is known to work.
+func Convert(ctx context.Context, dirPath string, to engine.Kind) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.Convert")
+	defer span.End()
+
+	from, err := StoredBackend(dirPath)
+	if err != nil {
+		return err
+	}
+	if from == "" {
+		return fmt.Errorf("no database in %s", dirPath)
+	}
+	if from == to {
+		return fmt.Errorf("database in %s already uses the %s backend", dirPath, to)
+	}
+	sourcePath, targetPath := backendPath(dirPath, from), backendPath(dirPath, to)
+	source, err := engine.Open(from, sourcePath, &engine.Options{})
+	if err != nil {
+		return errors.Wrapf(err, "could not open %s database", from)
+	}
+	target, err := engine.Open(to, targetPath, &engine.Options{})
+	if err != nil {
+		if closeErr := source.Close(); closeErr != nil {
+			log.WithError(closeErr).Error("Failed to close source database")
+		}
+		return errors.Wrapf(err, "could not create %s database", to)
+	}
+	log.WithFields(logrus.Fields{
+		"from": from,
+		"to":   to,
+		"path": dirPath,
+	}).Info("Converting database")
+
+	keys, err := copyDatabase(ctx, source, target)
+	if closeErr := source.Close(); closeErr != nil {
+		log.WithError(closeErr).Error("Failed to close source database")
+	}
+	if closeErr := target.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		// Do not leave a partial database behind, which would be opened instead of the source one.
+		if rmErr := os.RemoveAll(targetPath); rmErr != nil {
+			log.WithError(rmErr).Error("Failed to remove partially converted database")
+		}
+		return errors.Wrap(err, "could not convert database")
+	}
+	converted := sourcePath + convertedSuffix
+	if err := os.Rename(sourcePath, converted); err != nil {
+		return errors.Wrap(err, "could not rename converted database")
+	}
+	log.WithFields(logrus.Fields{
+		"keys":      keys,
+		"converted": converted,
+	}).Info("Converted database, the source database can be deleted once the node runs with the new one")
+	return nil
+}
+
+// copyDatabase copies every bucket of the source database to the target one, in write transactions of
+// bounded size, and returns the number of keys copied.
+func copyDatabase(ctx context.Context, source, target engine.DB) (int, error) {
+	names, err := bucketNames(source)
+	if err != nil {
+		return 0, err
+	}
+	var copied int
+	for _, name := range names {
+		var start []byte
+		for {
+			if ctx.Err() != nil {
+				return copied, ctx.Err()
+			}
+			var next []byte
+			err := source.View(func(tx engine.Tx) error {
+				return target.Update(func(ttx engine.Tx) error {
+					bkt, err := ttx.CreateBucketIfNotExists(name)
+					if err != nil {
+						return err
+					}
+					c := tx.Bucket(name).Cursor()
+					k, v := c.First()
+					if start != nil {
+						k, v = c.Seek(start)
+					}
+					var scanned, size int
+					for ; k != nil; k, v = c.Next() {
+						if scanned >= convertBatchKeys || size >= convertBatchBytes {
+							next = bytesutil.SafeCopyBytes(k)
+							return nil
+						}
+						if err := bkt.Put(k, v); err != nil {
+							return err
+						}
+						scanned++
+						copied++
+						size += len(v)
+					}
+					return nil
+				})
+			})
+			if err != nil {
+				return copied, err
+			}
+			if next == nil {
+				break
+			}
+			start = next
+		}
+		log.WithField("bucket", string(name)).Debug("Converted bucket")
+	}
+	return copied, nil
+}
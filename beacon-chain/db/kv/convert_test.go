@@ -0,0 +1,55 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestConvert(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	db, err := NewKVStore(ctx, dir, &Config{Backend: engine.Bolt})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, 100, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	head, err := blks[99].Block().HashTreeRoot()
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, head))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, head))
+	require.NoError(t, db.Close())
+
+	require.NoError(t, Convert(ctx, dir, engine.LevelDB))
+	backend, err := StoredBackend(dir)
+	require.NoError(t, err)
+	assert.Equal(t, engine.LevelDB, backend)
+	assert.Equal(t, true, fileutil.FileExists(KVStoreDatafilePath(dir)+convertedSuffix))
+	assert.ErrorContains(t, "already uses the leveldb backend", Convert(ctx, dir, engine.LevelDB))
+
+	// The converted database is opened with its backend, and cannot be opened with another one.
+	_, err = NewKVStore(ctx, dir, &Config{Backend: engine.Bolt})
+	assert.ErrorContains(t, "run `beacon-chain db convert`", err)
+	converted, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, converted.Close())
+	}()
+	assert.Equal(t, engine.LevelDB, converted.Backend())
+	headBlock, err := converted.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(100), headBlock.Block().Slot())
+	for _, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		assert.Equal(t, true, converted.HasBlock(ctx, r))
+	}
+}
@@ -1,21 +1,21 @@
-// Package kv defines a bolt-db, key-value store implementation
-// of the Database interface defined by a Prysm beacon node.
+// Package kv defines a key-value store implementation of the Database interface defined by a
+// Prysm beacon node, on top of a bolt-db or LevelDB storage engine.
 package kv
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path"
-	"time"
 
 	"github.com/dgraph-io/ristretto"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	prombolt "github.com/prysmaticlabs/prombbolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
-	"github.com/prysmaticlabs/prysm/shared/params"
 	bolt "go.etcd.io/bbolt"
 )
 
@@ -31,6 +31,8 @@ const (
 	BeaconNodeDbDirName = "beaconchaindata"
 	// DatabaseFileName is the name of the beacon node database.
 	DatabaseFileName = "beaconchain.db"
+	// LevelDBDirName is the name of the directory of the beacon node database when stored in LevelDB.
+	LevelDBDirName = "beaconchain.ldb"
 
 	boltAllocSize = 8 * 1024 * 1024
 	// The size of hash length in bytes
@@ -68,16 +70,20 @@ var blockedBuckets = [][]byte{
 	finalizedBlockRootsIndexBucket,
 }
 
-// Config for the bolt db kv store.
+// Config for the kv store.
 type Config struct {
 	InitialMMapSize int
+	// Backend is the storage engine of a new database. An existing database is opened with the
+	// storage engine it was created with. Defaults to bolt.
+	Backend engine.Kind
 }
 
 // Store defines an implementation of the Prysm Database interface
-// using BoltDB as the underlying persistent kv-store for Ethereum Beacon Nodes.
+// using a storage engine as the underlying persistent kv-store for Ethereum Beacon Nodes.
 type Store struct {
-	db                  *bolt.DB
+	db                  engine.DB
 	databasePath        string
+	collector           prometheus.Collector
 	blockCache          *ristretto.Cache
 	validatorEntryCache *ristretto.Cache
 	stateSummaryCache   *stateSummaryCache
@@ -91,9 +97,10 @@ func KVStoreDatafilePath(dirPath string) string {
 	return path.Join(dirPath, DatabaseFileName)
 }
 
-// NewKVStore initializes a new boltDB key-value store at the directory
+// NewKVStore initializes a new key-value store at the directory
 // path specified, creates the kv-buckets based on the schema, and stores
-// an open connection db object as a property of the Store struct.
+// an open connection db object as a property of the Store struct. An existing
+// database is opened with its storage engine, and a new one with the configured engine.
 func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, error) {
 	hasDir, err := fileutil.HasDir(dirPath)
 	if err != nil {
@@ -104,22 +111,26 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			return nil, err
 		}
 	}
-	datafile := KVStoreDatafilePath(dirPath)
-	boltDB, err := bolt.Open(
-		datafile,
-		params.BeaconIoConfig().ReadWritePermissions,
-		&bolt.Options{
-			Timeout:         1 * time.Second,
-			InitialMmapSize: config.InitialMMapSize,
-		},
-	)
+	backend, err := StoredBackend(dirPath)
+	if err != nil {
+		return nil, err
+	}
+	if backend == "" {
+		backend = config.Backend
+	}
+	if backend == "" {
+		backend = engine.Bolt
+	}
+	if config.Backend != "" && config.Backend != backend {
+		return nil, fmt.Errorf("database in %s uses the %s backend, run `beacon-chain db convert` to convert it to %s",
+			dirPath, backend, config.Backend)
+	}
+	db, err := engine.Open(backend, backendPath(dirPath, backend), &engine.Options{
+		InitialMMapSize: config.InitialMMapSize,
+	})
 	if err != nil {
-		if errors.Is(err, bolt.ErrTimeout) {
-			return nil, errors.New("cannot obtain database lock, database may be in use by another process")
-		}
 		return nil, err
 	}
-	boltDB.AllocSize = boltAllocSize
 	blockCache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1000,           // number of keys to track frequency of (1000).
 		MaxCost:     BlockCacheSize, // maximum cost of cache (1000 Blocks).
@@ -139,7 +150,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 	}
 
 	kv := &Store{
-		db:                  boltDB,
+		db:                  &changeTrackingDB{DB: db},
 		databasePath:        dirPath,
 		blockCache:          blockCache,
 		validatorEntryCache: validatorCache,
@@ -147,7 +158,7 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		ctx:                 ctx,
 	}
 
-	if err := kv.db.Update(func(tx *bolt.Tx) error {
+	if err := kv.db.Update(func(tx engine.Tx) error {
 		return createBuckets(
 			tx,
 			attestationsBucket,
@@ -161,6 +172,8 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 			powchainBucket,
 			stateSummaryBucket,
 			stateValidatorsBucket,
+			lightClientUpdatesBucket,
+			stateDiffBucket,
 			// Indices buckets.
 			attestationHeadBlockRootBucket,
 			attestationSourceRootIndicesBucket,
@@ -181,26 +194,61 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		return nil, err
 	}
 
-	err = prometheus.Register(createBoltCollector(kv.db))
+	if boltDB, ok := engine.UnwrapBolt(db); ok {
+		kv.collector = createBoltCollector(boltDB)
+		err = prometheus.Register(kv.collector)
+	}
 
 	return kv, err
 }
 
+// StoredBackend returns the storage engine of the database in the directory, or an empty kind if
+// there is no database.
+func StoredBackend(dirPath string) (engine.Kind, error) {
+	var found engine.Kind
+	for _, backend := range engine.Kinds {
+		exists := fileutil.FileExists(backendPath(dirPath, backend))
+		if backend == engine.LevelDB {
+			hasDir, err := fileutil.HasDir(backendPath(dirPath, backend))
+			if err != nil {
+				return "", err
+			}
+			exists = hasDir
+		}
+		if !exists {
+			continue
+		}
+		if found != "" {
+			return "", fmt.Errorf("found both a %s and a %s database in %s", found, backend, dirPath)
+		}
+		found = backend
+	}
+	return found, nil
+}
+
+// backendPath returns the path of the files of a storage engine in the database directory.
+func backendPath(dirPath string, backend engine.Kind) string {
+	if backend == engine.LevelDB {
+		return path.Join(dirPath, LevelDBDirName)
+	}
+	return KVStoreDatafilePath(dirPath)
+}
+
 // ClearDB removes the previously stored database in the data directory.
 func (s *Store) ClearDB() error {
 	if _, err := os.Stat(s.databasePath); os.IsNotExist(err) {
 		return nil
 	}
-	prometheus.Unregister(createBoltCollector(s.db))
-	if err := os.Remove(path.Join(s.databasePath, DatabaseFileName)); err != nil {
+	s.unregisterCollector()
+	if err := os.RemoveAll(s.db.Path()); err != nil {
 		return errors.Wrap(err, "could not remove database file")
 	}
 	return nil
 }
 
-// Close closes the underlying BoltDB database.
+// Close closes the underlying storage engine.
 func (s *Store) Close() error {
-	prometheus.Unregister(createBoltCollector(s.db))
+	s.unregisterCollector()
 
 	// Before DB closes, we should dump the cached state summary objects to DB.
 	if err := s.saveCachedStateSummariesDB(s.ctx); err != nil {
@@ -215,7 +263,18 @@ func (s *Store) DatabasePath() string {
 	return s.databasePath
 }
 
-func createBuckets(tx *bolt.Tx, buckets ...[]byte) error {
+// Backend returns the storage engine of the database.
+func (s *Store) Backend() engine.Kind {
+	return s.db.Kind()
+}
+
+func (s *Store) unregisterCollector() {
+	if s.collector != nil {
+		prometheus.Unregister(s.collector)
+	}
+}
+
+func createBuckets(tx engine.Tx, buckets ...[]byte) error {
 	for _, bucket := range buckets {
 		if _, err := tx.CreateBucketIfNotExists(bucket); err != nil {
 			return err
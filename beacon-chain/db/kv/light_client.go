@@ -0,0 +1,82 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveLightClientUpdate saves the light client update of a sync committee period,
+// replacing any update previously saved for that period.
+func (s *Store) SaveLightClientUpdate(ctx context.Context, period uint64, update *ethpbv2.LightClientUpdate) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveLightClientUpdate")
+	defer span.End()
+
+	if update == nil {
+		err := errors.New("cannot save nil light client update")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	enc, err := encode(ctx, update)
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx engine.Tx) error {
+		bkt := tx.Bucket(lightClientUpdatesBucket)
+		return bkt.Put(bytesutil.Uint64ToBytesBigEndian(period), enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// LightClientUpdate retrieves the light client update of a sync committee period.
+// It returns nil if no update was saved for that period.
+func (s *Store) LightClientUpdate(ctx context.Context, period uint64) (*ethpbv2.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdate")
+	defer span.End()
+
+	var update *ethpbv2.LightClientUpdate
+	err := s.db.View(func(tx engine.Tx) error {
+		bkt := tx.Bucket(lightClientUpdatesBucket)
+		enc := bkt.Get(bytesutil.Uint64ToBytesBigEndian(period))
+		if len(enc) == 0 {
+			return nil
+		}
+		update = &ethpbv2.LightClientUpdate{}
+		return decode(ctx, enc, update)
+	})
+	return update, err
+}
+
+// LightClientUpdates retrieves the light client updates saved for the sync committee
+// periods in [startPeriod, endPeriod], sorted by period. Periods without an update
+// are skipped.
+func (s *Store) LightClientUpdates(ctx context.Context, startPeriod, endPeriod uint64) ([]*ethpbv2.LightClientUpdate, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LightClientUpdates")
+	defer span.End()
+
+	if endPeriod < startPeriod {
+		return nil, errors.New("end period cannot be before start period")
+	}
+	updates := make([]*ethpbv2.LightClientUpdate, 0)
+	err := s.db.View(func(tx engine.Tx) error {
+		c := tx.Bucket(lightClientUpdatesBucket).Cursor()
+		for k, enc := c.Seek(bytesutil.Uint64ToBytesBigEndian(startPeriod)); k != nil; k, enc = c.Next() {
+			if bytesutil.BytesToUint64BigEndian(k) > endPeriod {
+				break
+			}
+			update := &ethpbv2.LightClientUpdate{}
+			if err := decode(ctx, enc, update); err != nil {
+				return err
+			}
+			updates = append(updates, update)
+		}
+		return nil
+	})
+	return updates, err
+}
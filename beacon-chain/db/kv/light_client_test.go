@@ -0,0 +1,77 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_LightClientUpdate_SaveRetrieve(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+
+	update, err := db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpbv2.LightClientUpdate)(nil), update)
+	require.ErrorContains(t, "cannot save nil light client update", db.SaveLightClientUpdate(ctx, 1, nil))
+
+	want := lightClientUpdateAtSlot(10)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, update)
+
+	// A later update of the same period replaces the saved one.
+	want = lightClientUpdateAtSlot(20)
+	require.NoError(t, db.SaveLightClientUpdate(ctx, 1, want))
+	update, err = db.LightClientUpdate(ctx, 1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, update)
+}
+
+func TestStore_LightClientUpdates(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	for _, period := range []uint64{1, 2, 4, 300} {
+		require.NoError(t, db.SaveLightClientUpdate(ctx, period, lightClientUpdateAtSlot(types.Slot(period))))
+	}
+
+	updates, err := db.LightClientUpdates(ctx, 2, 5)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(updates))
+	assert.Equal(t, types.Slot(2), updates[0].AttestedHeader.Slot)
+	assert.Equal(t, types.Slot(4), updates[1].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 0, 1000)
+	require.NoError(t, err)
+	require.Equal(t, 4, len(updates))
+	assert.Equal(t, types.Slot(300), updates[3].AttestedHeader.Slot)
+
+	updates, err = db.LightClientUpdates(ctx, 5, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(updates))
+
+	_, err = db.LightClientUpdates(ctx, 5, 4)
+	require.ErrorContains(t, "end period cannot be before start period", err)
+}
+
+func lightClientUpdateAtSlot(slot types.Slot) *ethpbv2.LightClientUpdate {
+	return &ethpbv2.LightClientUpdate{
+		AttestedHeader: &ethpbv1.BeaconBlockHeader{
+			Slot:       slot,
+			ParentRoot: make([]byte, 32),
+			StateRoot:  make([]byte, 32),
+			BodyRoot:   make([]byte, 32),
+		},
+		SyncAggregate: &ethpbv1.SyncAggregate{
+			SyncCommitteeBits:      make([]byte, 64),
+			SyncCommitteeSignature: make([]byte, 96),
+		},
+		SignatureSlot: slot + 1,
+	}
+}
@@ -3,12 +3,12 @@ package kv
 import (
 	"context"
 
-	bolt "go.etcd.io/bbolt"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 )
 
 var migrationCompleted = []byte("done")
 
-type migration func(context.Context, *bolt.DB) error
+type migration func(context.Context, engine.DB) error
 
 var migrations = []migration{
 	migrateArchivedIndex,
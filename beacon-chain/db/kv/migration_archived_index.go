@@ -5,15 +5,15 @@ import (
 	"context"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
-	bolt "go.etcd.io/bbolt"
 )
 
 var migrationArchivedIndex0Key = []byte("archive_index_0")
 
-func migrateArchivedIndex(ctx context.Context, db *bolt.DB) error {
-	if updateErr := db.Update(func(tx *bolt.Tx) error {
+func migrateArchivedIndex(ctx context.Context, db engine.DB) error {
+	if updateErr := db.Update(func(tx engine.Tx) error {
 		mb := tx.Bucket(migrationsBucket)
 		if b := mb.Get(migrationArchivedIndex0Key); bytes.Equal(b, migrationCompleted) {
 			return nil // Migration already completed.
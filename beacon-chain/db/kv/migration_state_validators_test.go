@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/golang/snappy"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
 	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
 	v1alpha1 "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -13,7 +14,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
-	"go.etcd.io/bbolt"
 )
 
 func Test_migrateStateValidators(t *testing.T) {
@@ -26,7 +26,7 @@ func Test_migrateStateValidators(t *testing.T) {
 			name: "only runs once",
 			setup: func(t *testing.T, dbStore *Store, state *v1.BeaconState, vals []*v1alpha1.Validator) {
 				// create some new buckets that should be present for this migration
-				err := dbStore.db.Update(func(tx *bbolt.Tx) error {
+				err := dbStore.db.Update(func(tx engine.Tx) error {
 					_, err := tx.CreateBucketIfNotExists(stateValidatorsBucket)
 					assert.NoError(t, err)
 					_, err = tx.CreateBucketIfNotExists(blockRootValidatorHashesBucket)
@@ -37,7 +37,7 @@ func Test_migrateStateValidators(t *testing.T) {
 			},
 			eval: func(t *testing.T, dbStore *Store, state *v1.BeaconState, vals []*v1alpha1.Validator) {
 				// check if the migration is completed, per migration table.
-				err := dbStore.db.View(func(tx *bbolt.Tx) error {
+				err := dbStore.db.View(func(tx engine.Tx) error {
 					migrationCompleteOrNot := tx.Bucket(migrationsBucket).Get(migrationStateValidatorsKey)
 					assert.DeepEqual(t, migrationCompleted, migrationCompleteOrNot, "migration is not complete")
 					return nil
@@ -49,7 +49,7 @@ func Test_migrateStateValidators(t *testing.T) {
 			name: "once migrated, always enable flag",
 			setup: func(t *testing.T, dbStore *Store, state *v1.BeaconState, vals []*v1alpha1.Validator) {
 				// create some new buckets that should be present for this migration
-				err := dbStore.db.Update(func(tx *bbolt.Tx) error {
+				err := dbStore.db.Update(func(tx engine.Tx) error {
 					_, err := tx.CreateBucketIfNotExists(stateValidatorsBucket)
 					assert.NoError(t, err)
 					_, err = tx.CreateBucketIfNotExists(blockRootValidatorHashesBucket)
@@ -66,7 +66,7 @@ func Test_migrateStateValidators(t *testing.T) {
 				defer resetCfg()
 
 				// check if the migration is completed, per migration table.
-				err := dbStore.db.View(func(tx *bbolt.Tx) error {
+				err := dbStore.db.View(func(tx engine.Tx) error {
 					migrationCompleteOrNot := tx.Bucket(migrationsBucket).Get(migrationStateValidatorsKey)
 					assert.DeepEqual(t, migrationCompleted, migrationCompleteOrNot, "migration is not complete")
 					return nil
@@ -113,7 +113,7 @@ func Test_migrateStateValidators(t *testing.T) {
 			name: "migrates validators and adds them to new buckets",
 			setup: func(t *testing.T, dbStore *Store, state *v1.BeaconState, vals []*v1alpha1.Validator) {
 				// create some new buckets that should be present for this migration
-				err := dbStore.db.Update(func(tx *bbolt.Tx) error {
+				err := dbStore.db.Update(func(tx engine.Tx) error {
 					_, err := tx.CreateBucketIfNotExists(stateValidatorsBucket)
 					assert.NoError(t, err)
 					_, err = tx.CreateBucketIfNotExists(blockRootValidatorHashesBucket)
@@ -124,7 +124,7 @@ func Test_migrateStateValidators(t *testing.T) {
 			},
 			eval: func(t *testing.T, dbStore *Store, state *v1.BeaconState, vals []*v1alpha1.Validator) {
 				// check whether the new buckets are present
-				err := dbStore.db.View(func(tx *bbolt.Tx) error {
+				err := dbStore.db.View(func(tx engine.Tx) error {
 					valBkt := tx.Bucket(stateValidatorsBucket)
 					assert.NotNil(t, valBkt)
 					idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
@@ -168,7 +168,7 @@ func Test_migrateStateValidators(t *testing.T) {
 				require.Equal(t, len(vals), validatorsFoundCount)
 
 				// check if the state validator indexes are stored properly
-				err = dbStore.db.View(func(tx *bbolt.Tx) error {
+				err = dbStore.db.View(func(tx engine.Tx) error {
 					rcvdValhashBytes := tx.Bucket(blockRootValidatorHashesBucket).Get(blockRoot[:])
 					rcvdValHashes, sErr := snappy.Decode(nil, rcvdValhashBytes)
 					assert.NoError(t, sErr)
@@ -216,7 +216,7 @@ func Test_migrateAltairStateValidators(t *testing.T) {
 			name: "migrates validators and adds them to new buckets",
 			setup: func(t *testing.T, dbStore *Store, state *v2.BeaconState, vals []*v1alpha1.Validator) {
 				// create some new buckets that should be present for this migration
-				err := dbStore.db.Update(func(tx *bbolt.Tx) error {
+				err := dbStore.db.Update(func(tx engine.Tx) error {
 					_, err := tx.CreateBucketIfNotExists(stateValidatorsBucket)
 					assert.NoError(t, err)
 					_, err = tx.CreateBucketIfNotExists(blockRootValidatorHashesBucket)
@@ -227,7 +227,7 @@ func Test_migrateAltairStateValidators(t *testing.T) {
 			},
 			eval: func(t *testing.T, dbStore *Store, state *v2.BeaconState, vals []*v1alpha1.Validator) {
 				// check whether the new buckets are present
-				err := dbStore.db.View(func(tx *bbolt.Tx) error {
+				err := dbStore.db.View(func(tx engine.Tx) error {
 					valBkt := tx.Bucket(stateValidatorsBucket)
 					assert.NotNil(t, valBkt)
 					idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
@@ -271,7 +271,7 @@ func Test_migrateAltairStateValidators(t *testing.T) {
 				require.Equal(t, len(vals), validatorsFoundCount)
 
 				// check if the state validator indexes are stored properly
-				err = dbStore.db.View(func(tx *bbolt.Tx) error {
+				err = dbStore.db.View(func(tx engine.Tx) error {
 					rcvdValhashBytes := tx.Bucket(blockRootValidatorHashesBucket).Get(blockRoot[:])
 					rcvdValHashes, sErr := snappy.Decode(nil, rcvdValhashBytes)
 					assert.NoError(t, sErr)
@@ -3,8 +3,8 @@ package kv
 import (
 	"context"
 
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
-	bolt "go.etcd.io/bbolt"
 	"go.opencensus.io/trace"
 )
 
@@ -49,7 +49,7 @@ func (s *Store) SaveVoluntaryExit(ctx context.Context, exit *ethpb.VoluntaryExit
 	if err != nil {
 		return err
 	}
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx engine.Tx) error {
 		bucket := tx.Bucket(voluntaryExitsBucket)
 		return bucket.Put(exitRoot[:], enc)
 	})
@@ -59,7 +59,7 @@ func (s *Store) voluntaryExitBytes(ctx context.Context, exitRoot [32]byte) ([]by
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.voluntaryExitBytes")
 	defer span.End()
 	var dst []byte
-	err := s.db.View(func(tx *bolt.Tx) error {
+	err := s.db.View(func(tx engine.Tx) error {
 		bkt := tx.Bucket(voluntaryExitsBucket)
 		dst = bkt.Get(exitRoot[:])
 		return nil
@@ -71,7 +71,7 @@ func (s *Store) voluntaryExitBytes(ctx context.Context, exitRoot [32]byte) ([]by
 func (s *Store) deleteVoluntaryExit(ctx context.Context, exitRoot [32]byte) error {
 	ctx, span := trace.StartSpan(ctx, "BeaconDB.deleteVoluntaryExit")
 	defer span.End()
-	return s.db.Update(func(tx *bolt.Tx) error {
+	return s.db.Update(func(tx engine.Tx) error {
 		bucket := tx.Bucket(voluntaryExitsBucket)
 		return bucket.Delete(exitRoot[:])
 	})
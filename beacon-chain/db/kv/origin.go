@@ -0,0 +1,207 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	dbIface "github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	statev1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/sirupsen/logrus"
+	"go.opencensus.io/trace"
+)
+
+// The ssz encoded beacon state begins with genesis_time (8 bytes), genesis_validators_root (32 bytes)
+// and slot (8 bytes), followed by the fork container. The current fork version of the state lives at
+// the offset below and is used to detect which state type a serialized origin state belongs to.
+const (
+	stateForkCurrentVersionOffset = 8 + 32 + 8 + 4
+	stateForkCurrentVersionEnd    = stateForkCurrentVersionOffset + 4
+)
+
+// SaveOrigin loads an ssz serialized finalized state and its block into the database as the origin of
+// the chain, so that the node is able to sync forward from there instead of from genesis (checkpoint sync).
+// The genesis state must already be present in the database as several services still depend on it.
+func (s *Store) SaveOrigin(ctx context.Context, serState, serBlock []byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOrigin")
+	defer span.End()
+
+	gs, err := s.GenesisState(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve genesis state")
+	}
+	if gs == nil || gs.IsNil() {
+		return errors.New("genesis state not found: a genesis state is required for checkpoint sync")
+	}
+
+	st, err := unmarshalOriginState(serState)
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal origin state")
+	}
+	blk, err := unmarshalOriginBlock(serBlock, st.Version())
+	if err != nil {
+		return errors.Wrap(err, "could not unmarshal origin block")
+	}
+	if !bytes.Equal(st.GenesisValidatorRoot(), gs.GenesisValidatorRoot()) {
+		return fmt.Errorf("origin state genesis validators root %#x does not match genesis state %#x",
+			st.GenesisValidatorRoot(), gs.GenesisValidatorRoot())
+	}
+	// The origin state may have been advanced past the slot of its block (e.g. an epoch boundary
+	// checkpoint state), so the block is verified against the latest block header of the state.
+	stateRoot, err := st.HashTreeRoot(ctx)
+	if err != nil {
+		return errors.Wrap(err, "could not hash origin state")
+	}
+	header := st.LatestBlockHeader()
+	if bytes.Equal(header.StateRoot, params.BeaconConfig().ZeroHash[:]) {
+		header.StateRoot = stateRoot[:]
+	}
+	headerRoot, err := header.HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash latest block header of origin state")
+	}
+	blockRoot, err := blk.Block().HashTreeRoot()
+	if err != nil {
+		return errors.Wrap(err, "could not hash origin block")
+	}
+	if blockRoot != headerRoot {
+		return fmt.Errorf("origin block root %#x does not match latest block header root %#x of origin state",
+			blockRoot, headerRoot)
+	}
+
+	log.WithFields(logrus.Fields{
+		"slot":      st.Slot(),
+		"blockRoot": fmt.Sprintf("%#x", blockRoot),
+	}).Info("Saving checkpoint sync origin block and state")
+
+	if err := s.SaveBlock(ctx, blk); err != nil {
+		return errors.Wrap(err, "could not save origin block")
+	}
+	if err := s.SaveState(ctx, st, blockRoot); err != nil {
+		return errors.Wrap(err, "could not save origin state")
+	}
+	if err := s.SaveStateSummary(ctx, &ethpb.StateSummary{
+		Slot: st.Slot(),
+		Root: blockRoot[:],
+	}); err != nil {
+		return errors.Wrap(err, "could not save origin state summary")
+	}
+
+	// The origin is treated as both justified and finalized, which also marks the block as canonical
+	// in the finalized block roots index.
+	checkpoint := &ethpb.Checkpoint{
+		Epoch: helpers.SlotToEpoch(st.Slot()),
+		Root:  blockRoot[:],
+	}
+	enc, err := encode(ctx, checkpoint)
+	if err != nil {
+		return err
+	}
+	// The origin block root, head and checkpoints are saved in a single transaction, after the block
+	// and state. The database is only considered initialized from the checkpoint once the origin block
+	// root is saved, so an interrupted save is started over on the next run.
+	return s.db.Update(func(tx engine.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		// The origin block root is saved under its own key, to be used wherever the first block of
+		// the chain that is available in the database is needed, as an alternative to genesis.
+		if err := blocks.Put(originCheckpointBlockRootKey, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not save origin checkpoint block root")
+		}
+		// The origin block becomes the head so that initial sync picks up from this point.
+		if err := blocks.Put(headBlockRootKey, blockRoot[:]); err != nil {
+			return errors.Wrap(err, "could not save head block root")
+		}
+		checkpoints := tx.Bucket(checkpointBucket)
+		if err := checkpoints.Put(justifiedCheckpointKey, enc); err != nil {
+			return errors.Wrap(err, "could not save justified checkpoint")
+		}
+		if err := checkpoints.Put(finalizedCheckpointKey, enc); err != nil {
+			return errors.Wrap(err, "could not save finalized checkpoint")
+		}
+		return s.updateFinalizedBlockRoots(ctx, tx, checkpoint)
+	})
+}
+
+// OriginCheckpointBlockRoot returns the block root of the checkpoint sync origin. If the node was not
+// started from a checkpoint, iface.ErrNoOriginCheckpointBlockRoot is returned.
+func (s *Store) OriginCheckpointBlockRoot(ctx context.Context) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.OriginCheckpointBlockRoot")
+	defer span.End()
+
+	var root [32]byte
+	err := s.db.View(func(tx engine.Tx) error {
+		r := tx.Bucket(blocksBucket).Get(originCheckpointBlockRootKey)
+		if r == nil {
+			return dbIface.ErrNoOriginCheckpointBlockRoot
+		}
+		root = bytesutil.ToBytes32(r)
+		return nil
+	})
+	return root, err
+}
+
+// SaveOriginCheckpointBlockRoot saves the block root of the checkpoint sync origin.
+func (s *Store) SaveOriginCheckpointBlockRoot(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveOriginCheckpointBlockRoot")
+	defer span.End()
+	return s.db.Update(func(tx engine.Tx) error {
+		return tx.Bucket(blocksBucket).Put(originCheckpointBlockRootKey, blockRoot[:])
+	})
+}
+
+// unmarshalOriginState detects the fork of an ssz encoded state from its current fork version and
+// unmarshals it into the matching versioned state type.
+func unmarshalOriginState(enc []byte) (state.BeaconState, error) {
+	if len(enc) < stateForkCurrentVersionEnd {
+		return nil, errors.New("encoded state is too short")
+	}
+	cfg := params.BeaconConfig()
+	forkVersion := enc[stateForkCurrentVersionOffset:stateForkCurrentVersionEnd]
+	switch {
+	case bytes.Equal(forkVersion, cfg.AltairForkVersion):
+		protoState := &ethpb.BeaconStateAltair{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for altair")
+		}
+		return v2.InitializeFromProtoUnsafe(protoState)
+	case bytes.Equal(forkVersion, cfg.GenesisForkVersion):
+		protoState := &ethpb.BeaconState{}
+		if err := protoState.UnmarshalSSZ(enc); err != nil {
+			return nil, errors.Wrap(err, "failed to unmarshal encoding for phase0")
+		}
+		return statev1.InitializeFromProtoUnsafe(protoState)
+	default:
+		return nil, fmt.Errorf("unknown fork version %#x in encoded state", forkVersion)
+	}
+}
+
+// unmarshalOriginBlock unmarshals an ssz encoded signed block of the same fork as its post state.
+func unmarshalOriginBlock(enc []byte, stateVersion int) (block.SignedBeaconBlock, error) {
+	switch stateVersion {
+	case version.Altair:
+		rawBlock := &ethpb.SignedBeaconBlockAltair{}
+		if err := rawBlock.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return wrapper.WrappedAltairSignedBeaconBlock(rawBlock)
+	case version.Phase0:
+		rawBlock := &ethpb.SignedBeaconBlock{}
+		if err := rawBlock.UnmarshalSSZ(enc); err != nil {
+			return nil, err
+		}
+		return wrapper.WrappedPhase0SignedBeaconBlock(rawBlock), nil
+	default:
+		return nil, errors.New("unknown state version")
+	}
+}
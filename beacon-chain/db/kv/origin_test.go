@@ -0,0 +1,164 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func testOrigin(t *testing.T, slot types.Slot, gvr []byte) (*v1.BeaconState, *ethpb.SignedBeaconBlock) {
+	st, err := testutil.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.GenesisValidatorsRoot = gvr
+		s.Slot = slot
+		return nil
+	})
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlock()
+	blk.Block.Slot = slot
+	blk.Block.ParentRoot = bytesutil.PadTo([]byte("parent"), 32)
+	bodyRoot, err := blk.Block.Body.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, st.SetLatestBlockHeader(&ethpb.BeaconBlockHeader{
+		Slot:       slot,
+		ParentRoot: blk.Block.ParentRoot,
+		StateRoot:  params.BeaconConfig().ZeroHash[:],
+		BodyRoot:   bodyRoot[:],
+	}))
+	stateRoot, err := st.HashTreeRoot(context.Background())
+	require.NoError(t, err)
+	blk.Block.StateRoot = stateRoot[:]
+	return st, blk
+}
+
+func TestStore_SaveOrigin(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	gvr := bytesutil.PadTo([]byte("gvr"), 32)
+	gs, err := testutil.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.GenesisValidatorsRoot = gvr
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+
+	_, err = db.OriginCheckpointBlockRoot(ctx)
+	require.Equal(t, iface.ErrNoOriginCheckpointBlockRoot, err)
+
+	slot := 2 * params.BeaconConfig().SlotsPerEpoch
+	st, blk := testOrigin(t, slot, gvr)
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOrigin(ctx, serState, serBlock))
+
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	originRoot, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+	assert.Equal(t, true, db.HasBlock(ctx, blockRoot))
+	assert.Equal(t, true, db.HasState(ctx, blockRoot))
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, blockRoot))
+
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	headRoot, err := head.Block().HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, headRoot)
+
+	finalized, err := db.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Epoch(2), finalized.Epoch)
+	assert.DeepEqual(t, blockRoot[:], finalized.Root)
+	justified, err := db.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, finalized, justified)
+}
+
+func TestStore_SaveOrigin_Interrupted(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	gvr := bytesutil.PadTo([]byte("gvr"), 32)
+	gs, err := testutil.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.GenesisValidatorsRoot = gvr
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+
+	// A previous save was interrupted after the origin block and state were written.
+	st, blk := testOrigin(t, 64, gvr)
+	blockRoot, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+	require.NoError(t, db.SaveState(ctx, st, blockRoot))
+
+	// The database is not considered initialized from the checkpoint, and the head is still genesis.
+	_, err = db.OriginCheckpointBlockRoot(ctx)
+	require.Equal(t, iface.ErrNoOriginCheckpointBlockRoot, err)
+	head, err := db.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), head.Block().Slot())
+
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveOrigin(ctx, serState, serBlock))
+	originRoot, err := db.OriginCheckpointBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, blockRoot, originRoot)
+	assert.Equal(t, true, db.IsFinalizedBlock(ctx, blockRoot))
+}
+
+func TestStore_SaveOrigin_NoGenesis(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	st, blk := testOrigin(t, 64, bytesutil.PadTo([]byte("gvr"), 32))
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	assert.ErrorContains(t, "genesis state not found", db.SaveOrigin(ctx, serState, serBlock))
+}
+
+func TestStore_SaveOrigin_Mismatches(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	gvr := bytesutil.PadTo([]byte("gvr"), 32)
+	gs, err := testutil.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.GenesisValidatorsRoot = gvr
+		return nil
+	})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisData(ctx, gs))
+
+	st, blk := testOrigin(t, 64, bytesutil.PadTo([]byte("other"), 32))
+	serState, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err := blk.MarshalSSZ()
+	require.NoError(t, err)
+	assert.ErrorContains(t, "genesis validators root", db.SaveOrigin(ctx, serState, serBlock))
+
+	st, blk = testOrigin(t, 64, gvr)
+	blk.Block.ProposerIndex = 100
+	serState, err = st.MarshalSSZ()
+	require.NoError(t, err)
+	serBlock, err = blk.MarshalSSZ()
+	require.NoError(t, err)
+	assert.ErrorContains(t, "does not match latest block header root", db.SaveOrigin(ctx, serState, serBlock))
+
+	_, err = db.OriginCheckpointBlockRoot(ctx)
+	require.Equal(t, iface.ErrNoOriginCheckpointBlockRoot, err)
+}
@@ -0,0 +1,174 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// pruneBatchSize is the number of block roots pruned in a single db transaction.
+const pruneBatchSize = 256
+
+var errPruneAnchorNotFinalized = errors.New("prune anchor is not in the finalized block roots index")
+
+// LowestRetainedSlot returns the lowest slot from which the database still holds every
+// finalized block. It is zero until the history of the database is pruned.
+func (s *Store) LowestRetainedSlot(ctx context.Context) (types.Slot, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.LowestRetainedSlot")
+	defer span.End()
+
+	var slot types.Slot
+	err := s.db.View(func(tx engine.Tx) error {
+		enc := tx.Bucket(blocksBucket).Get(lowestRetainedSlotKey)
+		if enc != nil {
+			slot = bytesutil.BytesToSlotBigEndian(enc)
+		}
+		return nil
+	})
+	return slot, err
+}
+
+// PruneHistory deletes every block below the slot of the given finalized anchor block, along
+// with the state summaries, states and state diffs of those blocks, their block indices and
+// their entries in the finalized block roots index. The genesis block and state are never
+// pruned. The slot of the anchor block is recorded as the lowest retained slot.
+//
+// The anchor must have its state saved in the database, otherwise the states above it can no
+// longer be regenerated.
+func (s *Store) PruneHistory(ctx context.Context, anchorRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.PruneHistory")
+	defer span.End()
+
+	var anchorSlot types.Slot
+	var genesisRoot [32]byte
+	err := s.db.Update(func(tx engine.Tx) error {
+		if tx.Bucket(finalizedBlockRootsIndexBucket).Get(anchorRoot[:]) == nil {
+			return errPruneAnchorNotFinalized
+		}
+		bkt := tx.Bucket(blocksBucket)
+		anchor, err := blockFromTx(ctx, bkt, anchorRoot[:])
+		if err != nil {
+			return err
+		}
+		anchorSlot = anchor.Block().Slot()
+		genesisRoot = bytesutil.ToBytes32(bkt.Get(genesisBlockRootKey))
+		if enc := bkt.Get(lowestRetainedSlotKey); enc != nil && bytesutil.BytesToSlotBigEndian(enc) > anchorSlot {
+			return nil
+		}
+		// The lowest retained slot is recorded before anything is deleted, so that blocks
+		// which are about to be pruned are no longer advertised to peers.
+		return bkt.Put(lowestRetainedSlotKey, bytesutil.SlotToBytesBigEndian(anchorSlot))
+	})
+	if err != nil {
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+
+	// Every batch starts over from the bottom of the block slot index, as the previous batches
+	// have been deleted from it. This also picks up the leftovers of an interrupted prune.
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		slotKeys, roots, err := s.prunableBlockRoots(ctx, anchorSlot, genesisRoot)
+		if err != nil {
+			traceutil.AnnotateError(span, err)
+			return err
+		}
+		if len(slotKeys) == 0 {
+			return nil
+		}
+		if err := s.pruneBlockRoots(ctx, slotKeys, roots); err != nil {
+			traceutil.AnnotateError(span, errors.Wrap(err, "could not prune blocks"))
+			return err
+		}
+	}
+}
+
+// prunableBlockRoots returns the next batch of block roots below the anchor slot along with
+// the keys of the block slot index which hold them. Only whole slots are returned.
+func (s *Store) prunableBlockRoots(ctx context.Context, anchorSlot types.Slot, genesisRoot [32]byte) ([][]byte, [][32]byte, error) {
+	_, span := trace.StartSpan(ctx, "BeaconDB.prunableBlockRoots")
+	defer span.End()
+
+	slotKeys := make([][]byte, 0)
+	roots := make([][32]byte, 0, pruneBatchSize)
+	max := bytesutil.SlotToBytesBigEndian(anchorSlot)
+	err := s.db.View(func(tx engine.Tx) error {
+		c := tx.Bucket(blockSlotIndicesBucket).Cursor()
+		// Slot 0 only holds the genesis block, which is never pruned.
+		for k, v := c.Seek(bytesutil.SlotToBytesBigEndian(1)); k != nil && bytes.Compare(k, max) < 0; k, v = c.Next() {
+			if len(roots) >= pruneBatchSize {
+				break
+			}
+			slotKeys = append(slotKeys, bytesutil.SafeCopyBytes(k))
+			for i := 0; i+32 <= len(v); i += 32 {
+				r := bytesutil.ToBytes32(v[i : i+32])
+				if r != genesisRoot {
+					roots = append(roots, r)
+				}
+			}
+		}
+		return nil
+	})
+	return slotKeys, roots, err
+}
+
+// pruneBlockRoots deletes the given block roots and everything stored for them.
+func (s *Store) pruneBlockRoots(ctx context.Context, slotKeys [][]byte, roots [][32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.pruneBlockRoots")
+	defer span.End()
+
+	// States are deleted first as they also need their block or summary to look up their slot.
+	for _, r := range roots {
+		if !s.HasState(ctx, r) {
+			continue
+		}
+		if err := s.DeleteState(ctx, r); err != nil {
+			return errors.Wrapf(err, "could not delete state of block root %#x", r)
+		}
+	}
+
+	return s.db.Update(func(tx engine.Tx) error {
+		blocks := tx.Bucket(blocksBucket)
+		summaries := tx.Bucket(stateSummaryBucket)
+		diffs := tx.Bucket(stateDiffBucket)
+		finalized := tx.Bucket(finalizedBlockRootsIndexBucket)
+		parentIndices := tx.Bucket(blockParentRootIndicesBucket)
+		for _, r := range roots {
+			if enc := blocks.Get(r[:]); enc != nil {
+				blk, err := unmarshalBlock(ctx, enc)
+				if err != nil {
+					return err
+				}
+				indicesByBucket := createBlockIndicesFromBlock(ctx, blk.Block())
+				if err := deleteValueForIndices(ctx, indicesByBucket, r[:], tx); err != nil {
+					return errors.Wrap(err, "could not delete root for DB indices")
+				}
+				if err := blocks.Delete(r[:]); err != nil {
+					return err
+				}
+			}
+			s.blockCache.Del(string(r[:]))
+			s.stateSummaryCache.delete(r)
+			for _, bkt := range []engine.Bucket{summaries, diffs, finalized, parentIndices} {
+				if err := bkt.Delete(r[:]); err != nil {
+					return err
+				}
+			}
+		}
+		slotIndices := tx.Bucket(blockSlotIndicesBucket)
+		for _, k := range slotKeys {
+			if err := slotIndices.Delete(k); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,93 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_PruneHistory(t *testing.T) {
+	slotsPerEpoch := uint64(params.BeaconConfig().SlotsPerEpoch)
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	// Blocks from slot 1 to slot 3 epochs.
+	blks := makeBlocks(t, 0, slotsPerEpoch*3, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	roots := make([][32]byte, len(blks))
+	for i, b := range blks {
+		r, err := b.Block().HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = r
+		require.NoError(t, db.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: b.Block().Slot(), Root: r[:]}))
+	}
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	// The anchor at the first epoch boundary, an older state and the finalized state.
+	anchor := slotsPerEpoch - 1
+	require.NoError(t, st.SetSlot(types.Slot(slotsPerEpoch)))
+	require.NoError(t, db.SaveState(ctx, st, roots[anchor]))
+	require.NoError(t, st.SetSlot(3))
+	require.NoError(t, db.SaveState(ctx, st, roots[2]))
+	require.NoError(t, db.SaveStateDiff(ctx, roots[3], &ethpb.StateDiff{BaseBlockRoot: roots[2][:]}))
+	finalized := 2*slotsPerEpoch - 1
+	require.NoError(t, st.SetSlot(types.Slot(2*slotsPerEpoch)))
+	require.NoError(t, db.SaveState(ctx, st, roots[finalized]))
+	require.NoError(t, db.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 2, Root: roots[finalized][:]}))
+
+	lowest, err := db.LowestRetainedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), lowest)
+
+	require.NoError(t, db.PruneHistory(ctx, roots[anchor]))
+
+	for i := uint64(0); i < anchor; i++ {
+		assert.Equal(t, false, db.HasBlock(ctx, roots[i]), "Block at index %d was not pruned", i)
+		assert.Equal(t, false, db.HasStateSummary(ctx, roots[i]), "State summary at index %d was not pruned", i)
+		assert.Equal(t, false, db.IsFinalizedBlock(ctx, roots[i]), "Block at index %d is still in the finalized index", i)
+	}
+	assert.Equal(t, false, db.HasState(ctx, roots[2]))
+	assert.Equal(t, false, db.HasStateDiff(ctx, roots[3]))
+	for i := anchor; i < uint64(len(blks)); i++ {
+		assert.Equal(t, true, db.HasBlock(ctx, roots[i]), "Block at index %d was pruned", i)
+		assert.Equal(t, true, db.HasStateSummary(ctx, roots[i]), "State summary at index %d was pruned", i)
+	}
+	for i := anchor; i < finalized; i++ {
+		assert.Equal(t, true, db.IsFinalizedBlock(ctx, roots[i]), "Block at index %d was removed from the finalized index", i)
+	}
+	assert.Equal(t, true, db.HasState(ctx, roots[anchor]))
+	assert.Equal(t, true, db.HasState(ctx, roots[finalized]))
+
+	lowest, err = db.LowestRetainedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(slotsPerEpoch), lowest)
+	blockRoots, err := db.BlockRoots(ctx, filters.NewFilter().SetStartSlot(0).SetEndSlot(types.Slot(3*slotsPerEpoch)))
+	require.NoError(t, err)
+	assert.Equal(t, len(blks)-int(anchor), len(blockRoots))
+}
+
+func TestStore_PruneHistory_AnchorNotFinalized(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisBlockRoot))
+	blks := makeBlocks(t, 0, 4, genesisBlockRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	r, err := blks[2].Block().HashTreeRoot()
+	require.NoError(t, err)
+
+	assert.ErrorContains(t, errPruneAnchorNotFinalized.Error(), db.PruneHistory(ctx, r))
+	assert.Equal(t, true, db.HasBlock(ctx, r))
+	lowest, err := db.LowestRetainedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), lowest)
+}
@@ -19,6 +19,12 @@ var (
 	powchainBucket          = []byte("powchain")
 	stateValidatorsBucket   = []byte("state-validators")
 
+	// Light client updates, keyed by sync committee period.
+	lightClientUpdatesBucket = []byte("light-client-updates")
+
+	// State diffs of cold states in between full states, keyed by block root.
+	stateDiffBucket = []byte("state-diff")
+
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
 	slotsHasObjectBucket = []byte("slots-has-objects")
 	// Deprecated: This bucket was migrated in PR 6461. Do not use, except for migrations.
@@ -37,12 +43,15 @@ var (
 	blockRootValidatorHashesBucket      = []byte("block-root-validator-hashes")
 
 	// Specific item keys.
-	headBlockRootKey          = []byte("head-root")
-	genesisBlockRootKey       = []byte("genesis-root")
-	depositContractAddressKey = []byte("deposit-contract")
-	justifiedCheckpointKey    = []byte("justified-checkpoint")
-	finalizedCheckpointKey    = []byte("finalized-checkpoint")
-	powchainDataKey           = []byte("powchain-data")
+	headBlockRootKey             = []byte("head-root")
+	genesisBlockRootKey          = []byte("genesis-root")
+	originCheckpointBlockRootKey = []byte("origin-checkpoint-block-root")
+	backfillBlockRootKey         = []byte("backfill-block-root")
+	lowestRetainedSlotKey        = []byte("lowest-retained-slot")
+	depositContractAddressKey    = []byte("deposit-contract")
+	justifiedCheckpointKey       = []byte("justified-checkpoint")
+	finalizedCheckpointKey       = []byte("finalized-checkpoint")
+	powchainDataKey              = []byte("powchain-data")
 	// Altair key used to identify object is altair compatible.
 	// Objects that are only compatible with altair should be prefixed with such key.
 	altairKey = []byte("altair")
@@ -0,0 +1,79 @@
+package kv
+
+import (
+	"context"
+	"errors"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"go.opencensus.io/trace"
+)
+
+// SaveStateDiff stores the diff of the state of a block root to the state of its base block root.
+func (s *Store) SaveStateDiff(ctx context.Context, blockRoot [32]byte, diff *ethpb.StateDiff) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.SaveStateDiff")
+	defer span.End()
+
+	if diff == nil {
+		err := errors.New("cannot save nil state diff")
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	enc, err := encode(ctx, diff)
+	if err != nil {
+		return err
+	}
+	err = s.db.Update(func(tx engine.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		return bkt.Put(blockRoot[:], enc)
+	})
+	traceutil.AnnotateError(span, err)
+	return err
+}
+
+// StateDiff retrieves the state diff of a block root. It returns nil if no state diff
+// was saved for that block root.
+func (s *Store) StateDiff(ctx context.Context, blockRoot [32]byte) (*ethpb.StateDiff, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.StateDiff")
+	defer span.End()
+
+	var diff *ethpb.StateDiff
+	err := s.db.View(func(tx engine.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		enc := bkt.Get(blockRoot[:])
+		if len(enc) == 0 {
+			return nil
+		}
+		diff = &ethpb.StateDiff{}
+		return decode(ctx, enc, diff)
+	})
+	return diff, err
+}
+
+// HasStateDiff checks if a state diff by block root exists in the db.
+func (s *Store) HasStateDiff(ctx context.Context, blockRoot [32]byte) bool {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.HasStateDiff")
+	defer span.End()
+
+	hasDiff := false
+	if err := s.db.View(func(tx engine.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		hasDiff = len(bkt.Get(blockRoot[:])) > 0
+		return nil
+	}); err != nil {
+		panic(err)
+	}
+	return hasDiff
+}
+
+// DeleteStateDiff deletes the state diff of a block root.
+func (s *Store) DeleteStateDiff(ctx context.Context, blockRoot [32]byte) error {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.DeleteStateDiff")
+	defer span.End()
+
+	return s.db.Update(func(tx engine.Tx) error {
+		bkt := tx.Bucket(stateDiffBucket)
+		return bkt.Delete(blockRoot[:])
+	})
+}
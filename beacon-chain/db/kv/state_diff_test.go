@@ -0,0 +1,41 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_StateDiff_SaveRetrieveDelete(t *testing.T) {
+	ctx := context.Background()
+	db := setupDB(t)
+	root := bytesutil.ToBytes32([]byte{'A'})
+
+	diff, err := db.StateDiff(ctx, root)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.StateDiff)(nil), diff)
+	assert.Equal(t, false, db.HasStateDiff(ctx, root))
+	require.ErrorContains(t, "cannot save nil state diff", db.SaveStateDiff(ctx, root, nil))
+
+	want := &ethpb.StateDiff{
+		BaseBlockRoot: bytesutil.PadTo([]byte{'B'}, 32),
+		Slot:          64,
+		BalanceDeltas: []int64{-10, 0, 32},
+		RandaoMixes: &ethpb.IndexedRoots{
+			Indices: []uint64{2},
+			Roots:   [][]byte{bytesutil.PadTo([]byte{'C'}, 32)},
+		},
+	}
+	require.NoError(t, db.SaveStateDiff(ctx, root, want))
+	assert.Equal(t, true, db.HasStateDiff(ctx, root))
+	diff, err = db.StateDiff(ctx, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, want, diff)
+
+	require.NoError(t, db.DeleteStateDiff(ctx, root))
+	assert.Equal(t, false, db.HasStateDiff(ctx, root))
+}
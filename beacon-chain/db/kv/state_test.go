@@ -8,6 +8,7 @@ import (
 	"time"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	v1alpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -19,7 +20,6 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
-	bolt "go.etcd.io/bbolt"
 )
 
 func TestState_CanSaveRetrieve(t *testing.T) {
@@ -75,7 +75,7 @@ func TestState_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "saved state with validators and retrieved state are not matching")
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r[:])
 		require.NotEqual(t, 0, len(data))
@@ -84,7 +84,7 @@ func TestState_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -127,7 +127,7 @@ func TestStateAltair_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "saved state with validators and retrieved state are not matching")
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r[:])
 		require.NotEqual(t, 0, len(data))
@@ -136,7 +136,7 @@ func TestStateAltair_CanSaveRetrieveValidatorEntries(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -191,7 +191,7 @@ func TestState_CanSaveRetrieveValidatorEntriesFromCache(t *testing.T) {
 	}
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -237,7 +237,7 @@ func TestState_CanSaveRetrieveValidatorEntriesWithoutCache(t *testing.T) {
 	require.DeepSSZEqual(t, st.InnerStateUnsafe(), savedS.InnerStateUnsafe(), "saved state with validators and retrieved state are not matching")
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r[:])
 		require.NotEqual(t, 0, len(data))
@@ -246,7 +246,7 @@ func TestState_CanSaveRetrieveValidatorEntriesWithoutCache(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
@@ -309,7 +309,7 @@ func TestState_DeleteState(t *testing.T) {
 	}
 
 	// check if the index of the first state is deleted.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r1[:])
 		require.Equal(t, 0, len(data))
@@ -318,7 +318,7 @@ func TestState_DeleteState(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if the index of the second state is still present.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		idxBkt := tx.Bucket(blockRootValidatorHashesBucket)
 		data := idxBkt.Get(r2[:])
 		require.NotEqual(t, 0, len(data))
@@ -327,7 +327,7 @@ func TestState_DeleteState(t *testing.T) {
 	require.NoError(t, err)
 
 	// check if all the validator entries are still intact in the validator entry bucket.
-	err = db.db.Update(func(tx *bolt.Tx) error {
+	err = db.db.Update(func(tx engine.Tx) error {
 		valBkt := tx.Bucket(stateValidatorsBucket)
 		// if any of the original validator entry is not present, then fail the test.
 		for _, val := range stateValidators {
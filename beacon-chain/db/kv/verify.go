@@ -0,0 +1,227 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/golang/snappy"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"go.opencensus.io/trace"
+)
+
+// consistencyCheck reports the inconsistencies of a part of the database.
+type consistencyCheck func(ctx context.Context, tx engine.Tx, report func(format string, args ...interface{})) error
+
+// VerifyConsistency checks that the blocks, states, state summaries and their indices in the database
+// are consistent with each other, as they should be after restoring a backup. It returns a description
+// of every inconsistency found.
+func (s *Store) VerifyConsistency(ctx context.Context) ([]string, error) {
+	ctx, span := trace.StartSpan(ctx, "BeaconDB.VerifyConsistency")
+	defer span.End()
+
+	issues := make([]string, 0)
+	report := func(format string, args ...interface{}) {
+		issues = append(issues, fmt.Sprintf(format, args...))
+	}
+	checks := []consistencyCheck{
+		verifyCheckpointRoots,
+		verifyBlocks,
+		verifyStates,
+		verifyStateSummaries,
+		verifyIndices,
+	}
+	for _, check := range checks {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if err := s.db.View(func(tx engine.Tx) error {
+			return check(ctx, tx, report)
+		}); err != nil {
+			return nil, err
+		}
+	}
+	return issues, nil
+}
+
+// verifyCheckpointRoots checks that the genesis, head, justified and finalized roots have a block.
+func verifyCheckpointRoots(ctx context.Context, tx engine.Tx, report func(string, ...interface{})) error {
+	blocks := tx.Bucket(blocksBucket)
+	for name, key := range map[string][]byte{"genesis": genesisBlockRootKey, "head": headBlockRootKey} {
+		if r := blocks.Get(key); r != nil && blocks.Get(r) == nil {
+			report("%s block root %#x has no block", name, r)
+		}
+	}
+	checkpoints := tx.Bucket(checkpointBucket)
+	for name, key := range map[string][]byte{"justified": justifiedCheckpointKey, "finalized": finalizedCheckpointKey} {
+		enc := checkpoints.Get(key)
+		if enc == nil {
+			continue
+		}
+		cp := &ethpb.Checkpoint{}
+		if err := decode(ctx, enc, cp); err != nil {
+			return err
+		}
+		if bytesutil.ToBytes32(cp.Root) == [32]byte{} {
+			continue
+		}
+		if blocks.Get(cp.Root) == nil {
+			report("%s checkpoint root %#x has no block", name, cp.Root)
+		}
+		if tx.Bucket(stateBucket).Get(cp.Root) == nil && tx.Bucket(stateSummaryBucket).Get(cp.Root) == nil {
+			report("%s checkpoint root %#x has no state or state summary", name, cp.Root)
+		}
+	}
+	return nil
+}
+
+// verifyBlocks checks that every block is in the block slot index and that its parent is in the
+// database, except for the blocks at the bottom of the database.
+func verifyBlocks(ctx context.Context, tx engine.Tx, report func(string, ...interface{})) error {
+	blocks := tx.Bucket(blocksBucket)
+	slotIndices := tx.Bucket(blockSlotIndicesBucket)
+	// The parents of the origin, the lowest backfilled block and the lowest retained block may be missing.
+	var lowestSlot types.Slot
+	if enc := blocks.Get(lowestRetainedSlotKey); enc != nil {
+		lowestSlot = bytesutil.BytesToSlotBigEndian(enc)
+	}
+	bottomRoots := [][]byte{blocks.Get(originCheckpointBlockRootKey), blocks.Get(backfillBlockRootKey)}
+	return blocks.ForEach(func(k, v []byte) error {
+		if len(k) != 32 {
+			return nil
+		}
+		blk, err := unmarshalBlock(ctx, v)
+		if err != nil {
+			report("block %#x could not be decoded: %v", k, err)
+			return nil
+		}
+		slot := blk.Block().Slot()
+		if !rootIn(slotIndices.Get(bytesutil.SlotToBytesBigEndian(slot)), k) {
+			report("block %#x at slot %d is missing from the block slot index", k, slot)
+		}
+		if slot == 0 || slot <= lowestSlot || bytes.Equal(k, bottomRoots[0]) || bytes.Equal(k, bottomRoots[1]) {
+			return nil
+		}
+		if blocks.Get(blk.Block().ParentRoot()) == nil {
+			report("parent %#x of block %#x at slot %d is missing", blk.Block().ParentRoot(), k, slot)
+		}
+		return nil
+	})
+}
+
+// verifyStates checks that every state has a block or state summary, and that its validator entries
+// are in the database.
+func verifyStates(ctx context.Context, tx engine.Tx, report func(string, ...interface{})) error {
+	validatorsMigrated := featureconfig.Get().EnableHistoricalSpaceRepresentation ||
+		bytes.Equal(tx.Bucket(migrationsBucket).Get(migrationStateValidatorsKey), migrationCompleted)
+	blocks := tx.Bucket(blocksBucket)
+	summaries := tx.Bucket(stateSummaryBucket)
+	validatorHashes := tx.Bucket(blockRootValidatorHashesBucket)
+	validators := tx.Bucket(stateValidatorsBucket)
+	c := tx.Bucket(stateBucket).Cursor()
+	for k, _ := c.First(); k != nil; k, _ = c.Next() {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if blocks.Get(k) == nil && summaries.Get(k) == nil {
+			report("state %#x has no block or state summary", k)
+		}
+		if !validatorsMigrated {
+			continue
+		}
+		enc := validatorHashes.Get(k)
+		if enc == nil {
+			report("state %#x has no validator entries", k)
+			continue
+		}
+		hashes, err := snappy.Decode(nil, enc)
+		if err != nil || len(hashes)%hashLength != 0 {
+			report("state %#x has invalid validator entry keys", k)
+			continue
+		}
+		for i := 0; i < len(hashes); i += hashLength {
+			if validators.Get(hashes[i:i+hashLength]) == nil {
+				report("state %#x is missing validator entry %#x", k, hashes[i:i+hashLength])
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// verifyStateSummaries checks that every state summary has a block at the same slot.
+func verifyStateSummaries(ctx context.Context, tx engine.Tx, report func(string, ...interface{})) error {
+	blocks := tx.Bucket(blocksBucket)
+	return tx.Bucket(stateSummaryBucket).ForEach(func(k, v []byte) error {
+		summary := &ethpb.StateSummary{}
+		if err := decode(ctx, v, summary); err != nil {
+			report("state summary %#x could not be decoded: %v", k, err)
+			return nil
+		}
+		if blocks.Get(k) == nil {
+			report("state summary %#x at slot %d has no block", k, summary.Slot)
+		}
+		return nil
+	})
+}
+
+// verifyIndices checks that the roots of the block slot index, the state slot index, the finalized
+// block roots index and the state diffs point to blocks and states which are in the database.
+func verifyIndices(ctx context.Context, tx engine.Tx, report func(string, ...interface{})) error {
+	blocks := tx.Bucket(blocksBucket)
+	states := tx.Bucket(stateBucket)
+	diffs := tx.Bucket(stateDiffBucket)
+	if err := tx.Bucket(blockSlotIndicesBucket).ForEach(func(k, v []byte) error {
+		for i := 0; i+32 <= len(v); i += 32 {
+			if blocks.Get(v[i:i+32]) == nil {
+				report("block slot index at slot %d references missing block %#x", bytesutil.BytesToSlotBigEndian(k), v[i:i+32])
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := tx.Bucket(stateSlotIndicesBucket).ForEach(func(k, v []byte) error {
+		for i := 0; i+32 <= len(v); i += 32 {
+			if states.Get(v[i:i+32]) == nil {
+				report("state slot index at slot %d references missing state %#x", bytesutil.BytesToSlotBigEndian(k), v[i:i+32])
+			}
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := tx.Bucket(finalizedBlockRootsIndexBucket).ForEach(func(k, _ []byte) error {
+		if len(k) == 32 && blocks.Get(k) == nil {
+			report("finalized block roots index references missing block %#x", k)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	return diffs.ForEach(func(k, v []byte) error {
+		diff := &ethpb.StateDiff{}
+		if err := decode(ctx, v, diff); err != nil {
+			report("state diff %#x could not be decoded: %v", k, err)
+			return nil
+		}
+		if states.Get(diff.BaseBlockRoot) == nil && diffs.Get(diff.BaseBlockRoot) == nil {
+			report("base %#x of state diff %#x is missing", diff.BaseBlockRoot, k)
+		}
+		return nil
+	})
+}
+
+// rootIn returns true if the root is one of the concatenated roots.
+func rootIn(roots, root []byte) bool {
+	for i := 0; i+32 <= len(roots); i += 32 {
+		if bytes.Equal(roots[i:i+32], root) {
+			return true
+		}
+	}
+	return false
+}
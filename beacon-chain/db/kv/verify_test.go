@@ -0,0 +1,49 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_VerifyConsistency(t *testing.T) {
+	db := setupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveGenesisBlockRoot(ctx, genesisRoot))
+	blks := makeBlocks(t, 0, 8, genesisRoot)
+	require.NoError(t, db.SaveBlocks(ctx, blks))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, genesisRoot))
+	head, err := blks[7].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveState(ctx, st, head))
+	require.NoError(t, db.SaveHeadBlockRoot(ctx, head))
+
+	issues, err := db.VerifyConsistency(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(issues), "Unexpected issues: %v", issues)
+
+	// Remove a block without its indices, as a partially restored database would.
+	missing, err := blks[3].Block().HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.db.Update(func(tx engine.Tx) error {
+		return tx.Bucket(blocksBucket).Delete(missing[:])
+	}))
+	db.blockCache.Del(string(missing[:]))
+
+	issues, err = db.VerifyConsistency(ctx)
+	require.NoError(t, err)
+	// The child of the block is orphaned and the block slot index points to the missing block.
+	require.Equal(t, 2, len(issues), "Unexpected issues: %v", issues)
+}
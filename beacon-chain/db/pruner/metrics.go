@@ -0,0 +1,18 @@
+package pruner
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	lowestRetainedSlot = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "history_lowest_retained_slot",
+		Help: "The lowest slot from which the node still holds every finalized block.",
+	})
+	pruneDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "history_prune_duration_seconds",
+		Help:    "The time it takes to prune the history below the retention window.",
+		Buckets: []float64{0.1, 0.5, 1, 5, 10, 30, 60, 300},
+	})
+)
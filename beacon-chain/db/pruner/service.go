@@ -0,0 +1,158 @@
+// Package pruner deletes the history of the beacon database on non-archive nodes. Every time
+// the chain finalizes, the blocks, states and indices older than a retention window behind the
+// finalized checkpoint are deleted, down to the closest archived point so that every state
+// above it can still be regenerated.
+package pruner
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+// Config to set up the pruner service.
+type Config struct {
+	DB db.NoHeadAccessDatabase
+	// RetentionEpochs is the number of epochs behind the finalized checkpoint which are kept.
+	RetentionEpochs types.Epoch
+	// SlotsPerArchivedPoint is the slot interval at which full states are saved in the cold section
+	// of the DB. The history is only pruned down to an archived point.
+	SlotsPerArchivedPoint types.Slot
+}
+
+// Service prunes the history of the beacon database below a retention window.
+type Service struct {
+	cfg       *Config
+	ctx       context.Context
+	cancel    context.CancelFunc
+	finalized chan types.Slot
+}
+
+// NewService initializes the pruner service. It returns an error if the retention window is
+// shorter than the range over which a node must serve blocks to its peers.
+func NewService(ctx context.Context, cfg *Config) (*Service, error) {
+	minEpochs := params.BeaconNetworkConfig().MinEpochsForBlockRequests
+	if cfg.RetentionEpochs < minEpochs {
+		return nil, errors.Errorf("history retention of %d epochs is less than the minimum of %d epochs", cfg.RetentionEpochs, minEpochs)
+	}
+	if cfg.SlotsPerArchivedPoint == 0 {
+		return nil, errors.New("slots per archived point must be greater than 0")
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	return &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+		// Only the latest finalized slot matters, as pruning up to it covers every earlier one.
+		finalized: make(chan types.Slot, 1),
+	}, nil
+}
+
+// Start the pruner service.
+func (s *Service) Start() {
+	slot, err := s.cfg.DB.LowestRetainedSlot(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get lowest retained slot")
+	}
+	lowestRetainedSlot.Set(float64(slot))
+	log.WithFields(logrus.Fields{
+		"retentionEpochs":    s.cfg.RetentionEpochs,
+		"lowestRetainedSlot": slot,
+	}).Info("Pruning history below the retention window")
+	go s.run()
+}
+
+// Stop the pruner service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the pruner service. Failing to prune does not affect the health of the node.
+func (s *Service) Status() error {
+	return nil
+}
+
+// OnFinalized queues the finalized slot for pruning. It is meant to be registered as a
+// finalization hook of the state manager and never blocks the migration to cold.
+func (s *Service) OnFinalized(_ context.Context, fSlot types.Slot, _ [32]byte) {
+	for {
+		select {
+		case s.finalized <- fSlot:
+			return
+		default:
+		}
+		// Replace a pending finalized slot which has not been picked up yet.
+		select {
+		case <-s.finalized:
+		default:
+		}
+	}
+}
+
+func (s *Service) run() {
+	for {
+		select {
+		case fSlot := <-s.finalized:
+			if err := s.prune(s.ctx, fSlot); err != nil {
+				log.WithError(err).Error("Could not prune history")
+			}
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// prune deletes the history below the highest archived point which is at least the retention
+// window behind the finalized slot.
+func (s *Service) prune(ctx context.Context, fSlot types.Slot) error {
+	retention, err := helpers.StartSlot(s.cfg.RetentionEpochs)
+	if err != nil {
+		return err
+	}
+	if fSlot <= retention {
+		return nil
+	}
+	slot := fSlot - retention
+	slot -= slot % s.cfg.SlotsPerArchivedPoint
+	if slot == 0 {
+		return nil
+	}
+	lowest, err := s.cfg.DB.LowestRetainedSlot(ctx)
+	if err != nil {
+		return err
+	}
+	if slot <= lowest {
+		return nil
+	}
+	if !s.cfg.DB.HasArchivedPoint(ctx, slot) {
+		log.WithField("slot", slot).Debug("No archived state at slot, not pruning")
+		return nil
+	}
+
+	start := time.Now()
+	anchorRoot := s.cfg.DB.ArchivedPointRoot(ctx, slot)
+	if err := s.cfg.DB.PruneHistory(ctx, anchorRoot); err != nil {
+		return errors.Wrapf(err, "could not prune history below slot %d", slot)
+	}
+	lowest, err = s.cfg.DB.LowestRetainedSlot(ctx)
+	if err != nil {
+		return err
+	}
+	pruneDuration.Observe(time.Since(start).Seconds())
+	lowestRetainedSlot.Set(float64(lowest))
+	log.WithFields(logrus.Fields{
+		"lowestRetainedSlot": lowest,
+		"duration":           time.Since(start),
+	}).Info("Pruned history")
+	return nil
+}
@@ -0,0 +1,106 @@
+package pruner
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestNewService_MinimumRetention(t *testing.T) {
+	_, err := NewService(context.Background(), &Config{
+		RetentionEpochs:       params.BeaconNetworkConfig().MinEpochsForBlockRequests - 1,
+		SlotsPerArchivedPoint: 2048,
+	})
+	assert.ErrorContains(t, "less than the minimum", err)
+
+	_, err = NewService(context.Background(), &Config{
+		RetentionEpochs:       params.BeaconNetworkConfig().MinEpochsForBlockRequests,
+		SlotsPerArchivedPoint: 2048,
+	})
+	require.NoError(t, err)
+}
+
+func TestService_Prune(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	networkCfg := params.BeaconNetworkConfig()
+	networkCfg.MinEpochsForBlockRequests = 1
+	params.OverrideBeaconNetworkConfig(networkCfg)
+
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	s, err := NewService(ctx, &Config{
+		DB:                    beaconDB,
+		RetentionEpochs:       1,
+		SlotsPerArchivedPoint: 2 * slotsPerEpoch,
+	})
+	require.NoError(t, err)
+
+	genesis := testutil.NewBeaconBlock()
+	genesisRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, genesisRoot))
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+
+	// A chain of 6 epochs, with archived states every 2 epochs.
+	roots := make(map[types.Slot][32]byte)
+	parent := genesisRoot
+	for slot := types.Slot(1); slot <= 6*slotsPerEpoch; slot++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: slot, Root: r[:]}))
+		if slot%(2*slotsPerEpoch) == 0 {
+			require.NoError(t, st.SetSlot(slot))
+			require.NoError(t, beaconDB.SaveState(ctx, st, r))
+		}
+		roots[slot] = r
+		parent = r
+	}
+	fSlot := 5 * slotsPerEpoch
+	fRoot := roots[fSlot]
+	require.NoError(t, beaconDB.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 5, Root: fRoot[:]}))
+
+	// The retention window reaches back to epoch 4, so the history below the archived point of
+	// epoch 4 is pruned.
+	require.NoError(t, s.prune(ctx, fSlot))
+	lowest, err := beaconDB.LowestRetainedSlot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 4*slotsPerEpoch, lowest)
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, genesisRoot))
+	assert.Equal(t, false, beaconDB.HasBlock(ctx, roots[1]))
+	assert.Equal(t, false, beaconDB.HasBlock(ctx, roots[4*slotsPerEpoch-1]))
+	assert.Equal(t, false, beaconDB.HasState(ctx, roots[2*slotsPerEpoch]))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, roots[4*slotsPerEpoch]))
+	assert.Equal(t, true, beaconDB.HasState(ctx, roots[4*slotsPerEpoch]))
+	assert.Equal(t, true, beaconDB.IsFinalizedBlock(ctx, roots[4*slotsPerEpoch]))
+
+	// Finalizing within the same archived point interval does not prune anything further.
+	require.NoError(t, s.prune(ctx, fSlot+1))
+	assert.Equal(t, true, beaconDB.HasBlock(ctx, roots[4*slotsPerEpoch]))
+}
+
+func TestService_OnFinalized_KeepsLatest(t *testing.T) {
+	s, err := NewService(context.Background(), &Config{
+		RetentionEpochs:       params.BeaconNetworkConfig().MinEpochsForBlockRequests,
+		SlotsPerArchivedPoint: 2048,
+	})
+	require.NoError(t, err)
+	s.OnFinalized(context.Background(), 64, [32]byte{})
+	s.OnFinalized(context.Background(), 96, [32]byte{})
+	assert.Equal(t, types.Slot(96), <-s.finalized)
+}
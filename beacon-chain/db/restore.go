@@ -1,11 +1,14 @@
 package db
 
 import (
+	"fmt"
+	"math"
 	"os"
 	"path"
 	"strings"
 
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
@@ -37,6 +40,9 @@ func Restore(cliCtx *cli.Context) error {
 	if err := fileutil.MkdirAll(restoreDir); err != nil {
 		return err
 	}
+	if sourceDir := cliCtx.String(cmd.RestoreSourceDirFlag.Name); sourceDir != "" {
+		return restoreIncremental(cliCtx, sourceDir, path.Join(restoreDir, kv.DatabaseFileName))
+	}
 	if err := fileutil.CopyFile(sourceFile, path.Join(restoreDir, kv.DatabaseFileName)); err != nil {
 		return err
 	}
@@ -44,3 +50,50 @@ func Restore(cliCtx *cli.Context) error {
 	log.Info("Restore completed successfully")
 	return nil
 }
+
+// restoreIncremental restores the database file from the incremental backups in the source
+// directory, up to the slot requested by the user.
+func restoreIncremental(cliCtx *cli.Context, sourceDir, dbFilePath string) error {
+	toSlot := types.Slot(math.MaxUint64)
+	if cliCtx.IsSet(cmd.RestoreToSlotFlag.Name) {
+		toSlot = types.Slot(cliCtx.Uint64(cmd.RestoreToSlotFlag.Name))
+	}
+	// Incremental backups are applied on top of the database file, so an existing one is replaced.
+	if err := os.RemoveAll(dbFilePath); err != nil {
+		return errors.Wrap(err, "could not remove existing database file")
+	}
+	slot, err := kv.RestoreIncrementalBackups(cliCtx.Context, sourceDir, dbFilePath, toSlot)
+	if err != nil {
+		return err
+	}
+
+	log.WithField("slot", slot).Info("Restore completed successfully")
+	return nil
+}
+
+// Verify checks the consistency of the beacon chain database in the data directory, such as
+// after a restore, and returns an error if any inconsistency is found.
+func Verify(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
+	d, err := kv.NewKVStore(cliCtx.Context, path.Join(dataDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	if err != nil {
+		return errors.Wrap(err, "could not open database")
+	}
+	defer func() {
+		if err := d.Close(); err != nil {
+			log.WithError(err).Error("Failed to close database")
+		}
+	}()
+	issues, err := d.VerifyConsistency(cliCtx.Context)
+	if err != nil {
+		return errors.Wrap(err, "could not verify database")
+	}
+	for _, issue := range issues {
+		log.Error(issue)
+	}
+	if len(issues) > 0 {
+		return fmt.Errorf("found %d inconsistencies in the database", len(issues))
+	}
+	log.Info("Database is consistent")
+	return nil
+}
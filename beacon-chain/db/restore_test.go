@@ -68,3 +68,55 @@ func TestRestore(t *testing.T) {
 	assert.LogsContain(t, logHook, "Restore completed successfully")
 
 }
+
+func TestRestore_Incremental(t *testing.T) {
+	ctx := context.Background()
+
+	backupDb, err := kv.NewKVStore(context.Background(), t.TempDir(), &kv.Config{})
+	require.NoError(t, err)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	genesis := testutil.NewBeaconBlock()
+	require.NoError(t, backupDb.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	root, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	backupsDir := t.TempDir()
+	for _, slot := range []types.Slot{100, 200} {
+		head := testutil.NewBeaconBlock()
+		head.Block.Slot = slot
+		parent := root
+		head.Block.ParentRoot = parent[:]
+		require.NoError(t, backupDb.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(head)))
+		root, err = head.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, backupDb.SaveState(ctx, st, root))
+		require.NoError(t, backupDb.SaveHeadBlockRoot(ctx, root))
+		require.NoError(t, backupDb.IncrementalBackup(ctx, backupsDir, false))
+	}
+	require.NoError(t, backupDb.Close())
+
+	restoreDir := t.TempDir()
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.String(cmd.RestoreSourceDirFlag.Name, "", "")
+	set.Uint64(cmd.RestoreToSlotFlag.Name, 0, "")
+	set.String(cmd.RestoreTargetDirFlag.Name, "", "")
+	require.NoError(t, set.Set(cmd.RestoreSourceDirFlag.Name, path.Join(backupsDir, "incremental")))
+	require.NoError(t, set.Set(cmd.RestoreToSlotFlag.Name, "150"))
+	require.NoError(t, set.Set(cmd.RestoreTargetDirFlag.Name, restoreDir))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	assert.NoError(t, Restore(cliCtx))
+
+	restoredDb, err := kv.NewKVStore(context.Background(), path.Join(restoreDir, kv.BeaconNodeDbDirName), &kv.Config{})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, restoredDb.Close())
+	}()
+	headBlock, err := restoredDb.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(100), headBlock.Block().Slot(), "Restored database is not at the requested slot")
+	issues, err := restoredDb.VerifyConsistency(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(issues), "Unexpected issues: %v", issues)
+}
@@ -1,4 +1,4 @@
-// Package testing allows for spinning up a real bolt-db
+// Package testing allows for spinning up a real bolt-db or LevelDB
 // instance for unit tests throughout the Prysm repo.
 package testing
 
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/iface"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
@@ -14,7 +15,13 @@ import (
 
 // SetupDB instantiates and returns database backed by key value store.
 func SetupDB(t testing.TB) db.Database {
-	s, err := kv.NewKVStore(context.Background(), t.TempDir(), &kv.Config{})
+	return SetupDBWithBackend(t, engine.Bolt)
+}
+
+// SetupDBWithBackend instantiates and returns database backed by key value store on the given
+// storage engine.
+func SetupDBWithBackend(t testing.TB, backend engine.Kind) db.Database {
+	s, err := kv.NewKVStore(context.Background(), t.TempDir(), &kv.Config{Backend: backend})
 	if err != nil {
 		t.Fatal(err)
 	}
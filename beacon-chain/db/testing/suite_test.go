@@ -0,0 +1,163 @@
+package testing
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// TestDatabaseSuite runs the same checks of the Database interface against every storage engine,
+// so that the behavior of the beacon node does not depend on the configured backend.
+func TestDatabaseSuite(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func(t *testing.T, d db.Database)
+	}{
+		{name: "blocks", run: testBlocks},
+		{name: "states", run: testStates},
+		{name: "checkpoints", run: testCheckpoints},
+		{name: "operations", run: testOperations},
+	}
+	for _, backend := range engine.Kinds {
+		for _, tt := range tests {
+			t.Run(string(backend)+"/"+tt.name, func(t *testing.T) {
+				tt.run(t, SetupDBWithBackend(t, backend))
+			})
+		}
+	}
+}
+
+// saveChain saves a chain of blocks from slot 0 to the given slot, and returns their roots.
+func saveChain(t *testing.T, d db.Database, slots types.Slot) [][32]byte {
+	ctx := context.Background()
+	roots := make([][32]byte, 0, slots+1)
+	parent := make([]byte, 32)
+	for slot := types.Slot(0); slot <= slots; slot++ {
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parent
+		require.NoError(t, d.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		roots = append(roots, r)
+		parent = r[:]
+	}
+	require.NoError(t, d.SaveGenesisBlockRoot(ctx, roots[0]))
+	return roots
+}
+
+func testBlocks(t *testing.T, d db.Database) {
+	ctx := context.Background()
+	roots := saveChain(t, d, 64)
+
+	for i, r := range roots {
+		require.Equal(t, true, d.HasBlock(ctx, r))
+		blk, err := d.Block(ctx, r)
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(i), blk.Block().Slot())
+	}
+	genesis, err := d.GenesisBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(0), genesis.Block().Slot())
+
+	blockRoots, err := d.BlockRoots(ctx, filters.NewFilter().SetStartSlot(10).SetEndSlot(19))
+	require.NoError(t, err)
+	assert.DeepEqual(t, roots[10:20], blockRoots)
+	ok, bySlot, err := d.BlockRootsBySlot(ctx, 32)
+	require.NoError(t, err)
+	assert.Equal(t, true, ok)
+	assert.DeepEqual(t, [][32]byte{roots[32]}, bySlot)
+	below, err := d.HighestSlotBlocksBelow(ctx, 40)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(below))
+	assert.Equal(t, types.Slot(39), below[0].Block().Slot())
+
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveState(ctx, st, roots[64]))
+	require.NoError(t, d.SaveHeadBlockRoot(ctx, roots[64]))
+	head, err := d.HeadBlock(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(64), head.Block().Slot())
+}
+
+func testStates(t *testing.T, d db.Database) {
+	ctx := context.Background()
+	roots := saveChain(t, d, 8)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	for _, i := range []int{2, 5} {
+		require.NoError(t, st.SetSlot(types.Slot(i)))
+		require.NoError(t, d.SaveState(ctx, st, roots[i]))
+	}
+	saved, err := d.State(ctx, roots[5])
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(5), saved.Slot())
+	below, err := d.HighestSlotStatesBelow(ctx, 4)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(below))
+	assert.Equal(t, types.Slot(2), below[0].Slot())
+
+	require.NoError(t, d.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: 7, Root: roots[7][:]}))
+	summary, err := d.StateSummary(ctx, roots[7])
+	require.NoError(t, err)
+	assert.Equal(t, types.Slot(7), summary.Slot)
+
+	require.NoError(t, d.DeleteState(ctx, roots[2]))
+	assert.Equal(t, false, d.HasState(ctx, roots[2]))
+	assert.Equal(t, true, d.HasState(ctx, roots[5]))
+}
+
+func testCheckpoints(t *testing.T, d db.Database) {
+	ctx := context.Background()
+	roots := saveChain(t, d, 16)
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, st.SetSlot(8))
+	require.NoError(t, d.SaveState(ctx, st, roots[8]))
+
+	cp := &ethpb.Checkpoint{Epoch: 1, Root: roots[8][:]}
+	require.NoError(t, d.SaveJustifiedCheckpoint(ctx, cp))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, cp))
+	justified, err := d.JustifiedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, cp, justified)
+	finalized, err := d.FinalizedCheckpoint(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, cp, finalized)
+	for i := 0; i <= 8; i++ {
+		assert.Equal(t, true, d.IsFinalizedBlock(ctx, roots[i]), "Block at slot %d is not finalized", i)
+	}
+	assert.Equal(t, false, d.IsFinalizedBlock(ctx, roots[9]))
+}
+
+func testOperations(t *testing.T, d db.Database) {
+	ctx := context.Background()
+	addr := common.HexToAddress("0x1234567890123456789012345678901234567890")
+	require.NoError(t, d.SaveDepositContractAddress(ctx, addr))
+	saved, err := d.DepositContractAddress(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, addr.Bytes(), saved)
+
+	exit := &ethpb.VoluntaryExit{Epoch: 5, ValidatorIndex: 3}
+	require.NoError(t, d.SaveVoluntaryExit(ctx, exit))
+	r, err := exit.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, true, d.HasVoluntaryExit(ctx, r))
+
+	data := &ethpb.ETH1ChainData{CurrentEth1Data: &ethpb.LatestETH1Data{BlockHeight: 100}}
+	require.NoError(t, d.SavePowchainData(ctx, data))
+	savedData, err := d.PowchainData(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), savedData.CurrentEth1Data.BlockHeight)
+}
@@ -48,4 +48,10 @@ var (
 			Help: "The number of times pruning happened.",
 		},
 	)
+	proposerBoostCount = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Name: "proto_array_proposer_boost_count",
+			Help: "The number of times a timely block is boosted for fork choice.",
+		},
+	)
 )
@@ -0,0 +1,82 @@
+package protoarray
+
+import (
+	"context"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
+)
+
+// BoostProposerRoot sets the block root which should be boosted during
+// the fork choice weight computation. Only a block of the current slot,
+// received before the attestation deadline of the slot, gets boosted.
+//
+// Spec pseudocode definition:
+//    # Add proposer score boost if the block is timely
+//    time_into_slot = (store.time - store.genesis_time) % SECONDS_PER_SLOT
+//    is_before_attesting_interval = time_into_slot < SECONDS_PER_SLOT // INTERVALS_PER_SLOT
+//    if get_current_slot(store) == block.slot and is_before_attesting_interval:
+//        store.proposer_boost_root = hash_tree_root(block)
+func (f *ForkChoice) BoostProposerRoot(_ context.Context, blockSlot types.Slot, blockRoot [32]byte, genesisTime, receivedTime time.Time) {
+	slotStart := slotutil.SlotStartTime(uint64(genesisTime.Unix()), blockSlot)
+	slotDuration := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	attestingDeadline := slotStart.Add(slotDuration / time.Duration(params.BeaconConfig().IntervalsPerSlot))
+	if receivedTime.Before(slotStart) || !receivedTime.Before(attestingDeadline) {
+		return
+	}
+
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	f.store.proposerBoostRoot = blockRoot
+	f.store.proposerBoostSlot = blockSlot
+	proposerBoostCount.Inc()
+}
+
+// ResetBoostedProposerRoot clears the boosted block root once the current slot is past the slot
+// of the boosted block, so that a block boosted early in its slot keeps its boost even if the
+// reset for the start of that slot runs after it. The weight of the boost is removed from the
+// block on the next head computation.
+//
+// Spec pseudocode definition:
+//    # Reset store.proposer_boost_root if this is a new slot
+//    if current_slot > previous_slot:
+//        store.proposer_boost_root = Root()
+func (f *ForkChoice) ResetBoostedProposerRoot(_ context.Context, currentSlot types.Slot) {
+	f.store.proposerBoostLock.Lock()
+	defer f.store.proposerBoostLock.Unlock()
+	if currentSlot <= f.store.proposerBoostSlot {
+		return
+	}
+	f.store.proposerBoostRoot = [32]byte{}
+}
+
+// computeProposerBoostScore computes the score of the boost given to a timely block, which is a
+// fraction of the weight of a committee as defined by the proposer score boost percentage.
+//
+// Spec pseudocode definition:
+//    num_validators = len(get_active_validator_indices(state, get_current_epoch(state)))
+//    avg_balance = get_total_active_balance(state) // num_validators
+//    committee_size = num_validators // SLOTS_PER_EPOCH
+//    committee_weight = committee_size * avg_balance
+//    proposer_score = (committee_weight * PROPOSER_SCORE_BOOST) // 100
+func computeProposerBoostScore(validatorBalances []uint64) uint64 {
+	totalActiveBalance := uint64(0)
+	numActive := uint64(0)
+	for _, balance := range validatorBalances {
+		// Only validators with a balance are considered active.
+		if balance == 0 {
+			continue
+		}
+		totalActiveBalance += balance
+		numActive++
+	}
+	if numActive == 0 {
+		return 0
+	}
+	avgBalance := totalActiveBalance / numActive
+	committeeSize := numActive / uint64(params.BeaconConfig().SlotsPerEpoch)
+	committeeWeight := committeeSize * avgBalance
+	return committeeWeight * params.BeaconConfig().ProposerScoreBoost / 100
+}
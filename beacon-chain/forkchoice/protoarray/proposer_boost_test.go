@@ -0,0 +1,103 @@
+package protoarray
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestForkChoice_BoostProposerRoot(t *testing.T) {
+	ctx := context.Background()
+	genesis := time.Unix(1000, 0)
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	slotStart := genesis.Add(2 * secondsPerSlot)
+
+	t.Run("timely block is boosted", func(t *testing.T) {
+		f := setup(1, 1)
+		f.BoostProposerRoot(ctx, 2, indexToHash(1), genesis, slotStart.Add(time.Second))
+		assert.Equal(t, indexToHash(1), f.store.proposerBoostRoot)
+	})
+	t.Run("block after the attesting interval is not boosted", func(t *testing.T) {
+		f := setup(1, 1)
+		f.BoostProposerRoot(ctx, 2, indexToHash(1), genesis, slotStart.Add(secondsPerSlot/2))
+		assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+	})
+	t.Run("block of a previous slot is not boosted", func(t *testing.T) {
+		f := setup(1, 1)
+		f.BoostProposerRoot(ctx, 1, indexToHash(1), genesis, slotStart.Add(time.Second))
+		assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+	})
+	t.Run("reset clears the boosted root in a later slot", func(t *testing.T) {
+		f := setup(1, 1)
+		f.BoostProposerRoot(ctx, 2, indexToHash(1), genesis, slotStart)
+		f.ResetBoostedProposerRoot(ctx, 3)
+		assert.Equal(t, [32]byte{}, f.store.proposerBoostRoot)
+	})
+	t.Run("reset keeps the boosted root in its slot", func(t *testing.T) {
+		f := setup(1, 1)
+		// The block is received before the reset for the start of its slot runs.
+		f.BoostProposerRoot(ctx, 2, indexToHash(1), genesis, slotStart)
+		f.ResetBoostedProposerRoot(ctx, 2)
+		assert.Equal(t, indexToHash(1), f.store.proposerBoostRoot)
+	})
+}
+
+func TestForkChoice_ProposerBoost_ChangesHead(t *testing.T) {
+	ctx := context.Background()
+	genesis := time.Unix(1000, 0)
+	secondsPerSlot := time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second
+	balances := make([]uint64, 2*params.BeaconConfig().SlotsPerEpoch)
+	for i := range balances {
+		balances[i] = 10
+	}
+	f := setup(1, 1)
+
+	// Insert blocks 1 and 2 and vote for block 1:
+	//            0
+	//           / \
+	//  vote -> 1   2
+	require.NoError(t, f.ProcessBlock(ctx, 1, indexToHash(1), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	require.NoError(t, f.ProcessBlock(ctx, 2, indexToHash(2), params.BeaconConfig().ZeroHash, [32]byte{}, 1, 1))
+	f.ProcessAttestation(ctx, []uint64{0}, indexToHash(1), 2)
+	r, err := f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head with a vote for block 1")
+
+	// Block 2 arrived timely in its slot, its boost outweighs the vote for block 1.
+	f.BoostProposerRoot(ctx, 2, indexToHash(2), genesis, genesis.Add(2*secondsPerSlot+time.Second))
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with boosted block 2")
+	wantedBoost := computeProposerBoostScore(balances)
+	assert.Equal(t, uint64(14), wantedBoost)
+	assert.Equal(t, wantedBoost, f.store.nodes[f.store.nodesIndices[indexToHash(2)]].weight)
+
+	// Computing head again in the same slot does not apply the boost twice.
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(2), r, "Incorrect head with boosted block 2")
+	assert.Equal(t, wantedBoost, f.store.nodes[f.store.nodesIndices[indexToHash(2)]].weight)
+
+	// The boost is removed in the next slot, and the head goes back to block 1.
+	f.ResetBoostedProposerRoot(ctx, 3)
+	r, err = f.Head(ctx, 1, params.BeaconConfig().ZeroHash, balances, 1)
+	require.NoError(t, err)
+	assert.Equal(t, indexToHash(1), r, "Incorrect head after the boost is reset")
+	assert.Equal(t, uint64(0), f.store.nodes[f.store.nodesIndices[indexToHash(2)]].weight)
+}
+
+func TestComputeProposerBoostScore(t *testing.T) {
+	assert.Equal(t, uint64(0), computeProposerBoostScore(nil))
+	assert.Equal(t, uint64(0), computeProposerBoostScore([]uint64{0, 0}))
+
+	balances := make([]uint64, 4*params.BeaconConfig().SlotsPerEpoch)
+	for i := range balances {
+		balances[i] = params.BeaconConfig().MaxEffectiveBalance
+	}
+	committeeWeight := 4 * params.BeaconConfig().MaxEffectiveBalance
+	assert.Equal(t, committeeWeight*params.BeaconConfig().ProposerScoreBoost/100, computeProposerBoostScore(balances))
+}
@@ -63,7 +63,7 @@ func (f *ForkChoice) Head(
 	}
 	f.votes = newVotes
 
-	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, deltas); err != nil {
+	if err := f.store.applyWeightChanges(ctx, justifiedEpoch, finalizedEpoch, newBalances, deltas); err != nil {
 		return [32]byte{}, errors.Wrap(err, "Could not apply score changes")
 	}
 	f.balances = newBalances
@@ -376,9 +376,16 @@ func (s *Store) insert(ctx context.Context,
 
 // applyWeightChanges iterates backwards through the nodes in store. It checks all nodes parent
 // and its best child. For each node, it updates the weight with input delta and
-// back propagate the nodes delta to its parents delta. After scoring changes,
-// the best child is then updated along with best descendant.
-func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalizedEpoch types.Epoch, delta []int) error {
+// back propagate the nodes delta to its parents delta. The proposer boost of the previously
+// boosted block is removed and the boost of the current timely block is added, computed from
+// the input justified balances. After scoring changes, the best child is then updated along
+// with best descendant.
+func (s *Store) applyWeightChanges(
+	ctx context.Context,
+	justifiedEpoch, finalizedEpoch types.Epoch,
+	newBalances []uint64,
+	delta []int,
+) error {
 	ctx, span := trace.StartSpan(ctx, "protoArrayForkChoice.applyWeightChanges")
 	defer span.End()
 
@@ -393,6 +400,11 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		s.finalizedEpoch = finalizedEpoch
 	}
 
+	s.proposerBoostLock.Lock()
+	defer s.proposerBoostLock.Unlock()
+	// Proposer score defaults to 0 when no block is boosted.
+	proposerScore := uint64(0)
+
 	// Iterate backwards through all index to node in store.
 	for i := len(s.nodes) - 1; i >= 0; i-- {
 		n := s.nodes[i]
@@ -405,6 +417,15 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 
 		nodeDelta := delta[i]
 
+		// Remove the boost previously applied to the node, and boost the node of the latest timely block.
+		if s.previousProposerBoostRoot != params.BeaconConfig().ZeroHash && s.previousProposerBoostRoot == n.root {
+			nodeDelta -= int(s.previousProposerBoostScore)
+		}
+		if s.proposerBoostRoot != params.BeaconConfig().ZeroHash && s.proposerBoostRoot == n.root {
+			proposerScore = computeProposerBoostScore(newBalances)
+			nodeDelta += int(proposerScore)
+		}
+
 		if nodeDelta < 0 {
 			// A node's weight can not be negative but the delta can be negative.
 			if int(n.weight)+nodeDelta < 0 {
@@ -436,6 +457,9 @@ func (s *Store) applyWeightChanges(ctx context.Context, justifiedEpoch, finalize
 		}
 	}
 
+	s.previousProposerBoostRoot = s.proposerBoostRoot
+	s.previousProposerBoostScore = proposerScore
+
 	for i := len(s.nodes) - 1; i >= 0; i-- {
 		n := s.nodes[i]
 		if n.parent != NonExistentNode {
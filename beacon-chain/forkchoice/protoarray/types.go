@@ -24,6 +24,12 @@ type Store struct {
 	nodesIndices   map[[32]byte]uint64 // the root of block node and the nodes index in the list.
 	canonicalNodes map[[32]byte]bool   // the canonical block nodes.
 	nodesLock      sync.RWMutex
+
+	proposerBoostRoot          [32]byte   // latest block root that was boosted after being received in a timely manner.
+	proposerBoostSlot          types.Slot // slot of the latest block root that was boosted.
+	previousProposerBoostRoot  [32]byte   // previous block root that was boosted, its boost is removed on the next weight update.
+	previousProposerBoostScore uint64     // score which was applied to the previous boosted block root.
+	proposerBoostLock          sync.Mutex
 }
 
 // Node defines the individual block which includes its block parent, ancestor and how much weight accounted for it.
@@ -28,6 +28,7 @@ func DefaultConfig(enableDebugRPCEndpoints bool) MuxConfig {
 		ethpbservice.RegisterBeaconChainHandler,
 		ethpbservice.RegisterBeaconValidatorHandler,
 		ethpbservice.RegisterEventsHandler,
+		ethpbservice.RegisterBeaconLightClientHandler,
 	}
 	if enableDebugRPCEndpoints {
 		v1Alpha1Registrations = append(v1Alpha1Registrations, ethpbalpha.RegisterDebugHandler)
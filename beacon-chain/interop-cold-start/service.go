@@ -150,6 +150,11 @@ func (s *Service) DepositsNumberAndRootAtHeight(_ context.Context, _ *big.Int) (
 	return 0, [32]byte{}
 }
 
+// DepositSnapshot mocks out the deposit cache functionality for interop.
+func (s *Service) DepositSnapshot(_ context.Context) *ethpb.DepositSnapshot {
+	return nil
+}
+
 // FinalizedDeposits mocks out the deposit cache functionality for interop.
 func (s *Service) FinalizedDeposits(_ context.Context) *depositcache.FinalizedDeposits {
 	return nil
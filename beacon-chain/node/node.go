@@ -16,11 +16,16 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/backup"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/engine"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/kv"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/pruner"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db/slasherkv"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice"
 	"github.com/prysmaticlabs/prysm/beacon-chain/forkchoice/protoarray"
 	gateway2 "github.com/prysmaticlabs/prysm/beacon-chain/gateway"
@@ -28,21 +33,27 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/node/registration"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/apimiddleware"
+	"github.com/prysmaticlabs/prysm/beacon-chain/slasher"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	regularsync "github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/backfill"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync/checkpoint"
 	initialsync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/debug"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/gateway"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/prereq"
@@ -59,23 +70,27 @@ const testSkipPowFlag = "test-skip-pow"
 // full PoS node. It handles the lifecycle of the entire system and registers
 // services to a service registry.
 type BeaconNode struct {
-	cliCtx          *cli.Context
-	ctx             context.Context
-	cancel          context.CancelFunc
-	services        *shared.ServiceRegistry
-	lock            sync.RWMutex
-	stop            chan struct{} // Channel to wait for termination notifications.
-	db              db.Database
-	attestationPool attestations.Pool
-	exitPool        voluntaryexits.PoolManager
-	slashingsPool   slashings.PoolManager
-	depositCache    *depositcache.DepositCache
-	stateFeed       *event.Feed
-	blockFeed       *event.Feed
-	opFeed          *event.Feed
-	forkChoiceStore forkchoice.ForkChoicer
-	stateGen        *stategen.State
-	collector       *bcnodeCollector
+	cliCtx            *cli.Context
+	ctx               context.Context
+	cancel            context.CancelFunc
+	services          *shared.ServiceRegistry
+	lock              sync.RWMutex
+	stop              chan struct{} // Channel to wait for termination notifications.
+	db                db.Database
+	slasherDB         db.SlasherDatabase
+	attestationPool   attestations.Pool
+	exitPool          voluntaryexits.PoolManager
+	slashingsPool     slashings.PoolManager
+	syncCommitteePool synccommittee.Pool
+	depositCache      *depositcache.DepositCache
+	stateFeed         *event.Feed
+	blockFeed         *event.Feed
+	opFeed            *event.Feed
+	slasherAttsFeed   *event.Feed
+	slasherBlockFeed  *event.Feed
+	forkChoiceStore   forkchoice.ForkChoicer
+	stateGen          *stategen.State
+	collector         *bcnodeCollector
 }
 
 // New creates a new node instance, sets up configuration options, and registers
@@ -99,17 +114,20 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 
 	ctx, cancel := context.WithCancel(cliCtx.Context)
 	beacon := &BeaconNode{
-		cliCtx:          cliCtx,
-		ctx:             ctx,
-		cancel:          cancel,
-		services:        registry,
-		stop:            make(chan struct{}),
-		stateFeed:       new(event.Feed),
-		blockFeed:       new(event.Feed),
-		opFeed:          new(event.Feed),
-		attestationPool: attestations.NewPool(),
-		exitPool:        voluntaryexits.NewPool(),
-		slashingsPool:   slashings.NewPool(),
+		cliCtx:            cliCtx,
+		ctx:               ctx,
+		cancel:            cancel,
+		services:          registry,
+		stop:              make(chan struct{}),
+		stateFeed:         new(event.Feed),
+		blockFeed:         new(event.Feed),
+		opFeed:            new(event.Feed),
+		slasherAttsFeed:   new(event.Feed),
+		slasherBlockFeed:  new(event.Feed),
+		attestationPool:   attestations.NewPool(),
+		exitPool:          voluntaryexits.NewPool(),
+		slashingsPool:     slashings.NewPool(),
+		syncCommitteePool: synccommittee.NewPool(),
 	}
 
 	depositAddress, err := registration.DepositContractAddress()
@@ -120,6 +138,12 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Bool(flags.SlasherFlag.Name) {
+		if err := beacon.startSlasherDB(cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	beacon.startStateGen()
 
 	if err := beacon.registerP2P(cliCtx); err != nil {
@@ -152,6 +176,27 @@ func New(cliCtx *cli.Context) (*BeaconNode, error) {
 		return nil, err
 	}
 
+	if cliCtx.Bool(flags.SlasherFlag.Name) {
+		if err := beacon.registerSlasherService(); err != nil {
+			return nil, err
+		}
+	}
+
+	// History below the retention window is not backfilled, as it would be pruned again.
+	if cliCtx.Uint64(flags.HistoryRetentionEpochs.Name) > 0 {
+		if err := beacon.registerPrunerService(cliCtx); err != nil {
+			return nil, err
+		}
+	} else if err := beacon.registerBackfillService(); err != nil {
+		return nil, err
+	}
+
+	if cliCtx.Duration(flags.IncrementalBackupInterval.Name) > 0 {
+		if err := beacon.registerBackupService(cliCtx); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := beacon.registerRPCService(); err != nil {
 		return nil, err
 	}
@@ -237,6 +282,11 @@ func (b *BeaconNode) Close() {
 	if err := b.db.Close(); err != nil {
 		log.Errorf("Failed to close database: %v", err)
 	}
+	if b.slasherDB != nil {
+		if err := b.slasherDB.Close(); err != nil {
+			log.Errorf("Failed to close slasher database: %v", err)
+		}
+	}
 	b.collector.unregister()
 	b.cancel()
 	close(b.stop)
@@ -255,9 +305,17 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 
 	log.WithField("database-path", dbPath).Info("Checking DB")
 
-	d, err := db.NewDB(b.ctx, dbPath, &kv.Config{
+	dbConfig := &kv.Config{
 		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
-	})
+	}
+	if cliCtx.IsSet(cmd.DBBackendFlag.Name) {
+		backend, err := engine.ParseKind(cliCtx.String(cmd.DBBackendFlag.Name))
+		if err != nil {
+			return err
+		}
+		dbConfig.Backend = backend
+	}
+	d, err := db.NewDB(b.ctx, dbPath, dbConfig)
 	if err != nil {
 		return err
 	}
@@ -279,9 +337,7 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		if err := d.ClearDB(); err != nil {
 			return errors.Wrap(err, "could not clear database")
 		}
-		d, err = db.NewDB(b.ctx, dbPath, &kv.Config{
-			InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
-		})
+		d, err = db.NewDB(b.ctx, dbPath, dbConfig)
 		if err != nil {
 			return errors.Wrap(err, "could not create new database")
 		}
@@ -324,6 +380,10 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 		return err
 	}
 
+	if err := b.startFromCheckpoint(cliCtx); err != nil {
+		return err
+	}
+
 	knownContract, err := b.db.DepositContractAddress(b.ctx)
 	if err != nil {
 		return err
@@ -345,6 +405,68 @@ func (b *BeaconNode) startDB(cliCtx *cli.Context, depositAddress string) error {
 	return nil
 }
 
+func (b *BeaconNode) startSlasherDB(cliCtx *cli.Context) error {
+	baseDir := cliCtx.String(cmd.DataDirFlag.Name)
+	if cliCtx.IsSet(flags.SlasherDirFlag.Name) {
+		baseDir = cliCtx.String(flags.SlasherDirFlag.Name)
+	}
+	dbPath := filepath.Join(baseDir, kv.BeaconNodeDbDirName)
+
+	log.WithField("database-path", dbPath).Info("Checking slasher DB")
+
+	d, err := slasherkv.NewKVStore(b.ctx, dbPath, &slasherkv.Config{
+		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not open slasher database")
+	}
+	b.slasherDB = d
+	return nil
+}
+
+// startFromCheckpoint saves a finalized state and its block as the origin of the chain when
+// checkpoint sync is requested, so that the node syncs forward from there instead of from genesis.
+func (b *BeaconNode) startFromCheckpoint(cliCtx *cli.Context) error {
+	statePath := cliCtx.String(flags.CheckpointStatePath.Name)
+	blockPath := cliCtx.String(flags.CheckpointBlockPath.Name)
+	remoteURL := cliCtx.String(flags.CheckpointSyncURL.Name)
+	if statePath == "" && blockPath == "" && remoteURL == "" {
+		return nil
+	}
+	if remoteURL != "" && (statePath != "" || blockPath != "") {
+		return fmt.Errorf("--%s cannot be used together with --%s or --%s", flags.CheckpointSyncURL.Name,
+			flags.CheckpointStatePath.Name, flags.CheckpointBlockPath.Name)
+	}
+
+	_, err := b.db.OriginCheckpointBlockRoot(b.ctx)
+	if err == nil {
+		log.Info("Database was already initialized from a checkpoint, ignoring checkpoint sync flags")
+		return nil
+	}
+	if err != db.ErrNoOriginCheckpointBlockRoot {
+		return err
+	}
+	head, err := b.db.HeadBlock(b.ctx)
+	if err != nil {
+		return err
+	}
+	if head != nil && !head.IsNil() && head.Block().Slot() > 0 {
+		return errors.New("Checkpoint sync flags specified but the database already contains chain data. " +
+			"Run again with --clear-db or use an alternative data directory with '--datadir'")
+	}
+
+	var origin *checkpoint.Origin
+	if remoteURL != "" {
+		origin, err = checkpoint.FromRemote(b.ctx, remoteURL)
+	} else {
+		origin, err = checkpoint.FromFiles(statePath, blockPath)
+	}
+	if err != nil {
+		return errors.Wrap(err, "could not retrieve checkpoint sync origin")
+	}
+	return b.db.SaveOrigin(b.ctx, origin.State, origin.Block)
+}
+
 func (b *BeaconNode) startStateGen() {
 	b.stateGen = stategen.New(b.db)
 }
@@ -358,6 +480,7 @@ func (b *BeaconNode) registerP2P(cliCtx *cli.Context) error {
 	svc, err := p2p.NewService(b.ctx, &p2p.Config{
 		NoDiscovery:       cliCtx.Bool(cmd.NoDiscovery.Name),
 		StaticPeers:       sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.StaticPeers.Name)),
+		TrustedPeers:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(cmd.TrustedPeers.Name)),
 		BootstrapNodeAddr: bootstrapNodeAddrs,
 		RelayNodeAddr:     cliCtx.String(cmd.RelayNode.Name),
 		DataDir:           dataDir,
@@ -464,6 +587,17 @@ func (b *BeaconNode) registerPOWChainService() error {
 		Eth1HeaderReqLimit:     b.cliCtx.Uint64(flags.Eth1HeaderReqLimit.Name),
 		BeaconNodeStatsUpdater: bs,
 	}
+	if b.cliCtx.IsSet(flags.DepositSnapshotPath.Name) {
+		enc, err := fileutil.ReadFileAsBytes(b.cliCtx.String(flags.DepositSnapshotPath.Name))
+		if err != nil {
+			return errors.Wrap(err, "could not read deposit snapshot")
+		}
+		snapshot := &ethpb.DepositSnapshot{}
+		if err := snapshot.UnmarshalSSZ(enc); err != nil {
+			return errors.Wrap(err, "could not unmarshal deposit snapshot")
+		}
+		cfg.DepositSnapshot = snapshot
+	}
 
 	web3Service, err := powchain.NewService(b.ctx, cfg)
 	if err != nil {
@@ -489,7 +623,7 @@ func (b *BeaconNode) registerSyncService() error {
 		return err
 	}
 
-	rs := regularsync.NewService(b.ctx, &regularsync.Config{
+	cfg := &regularsync.Config{
 		DB:                b.db,
 		P2P:               b.fetchP2P(),
 		Chain:             chainService,
@@ -501,11 +635,42 @@ func (b *BeaconNode) registerSyncService() error {
 		ExitPool:          b.exitPool,
 		SlashingPool:      b.slashingsPool,
 		StateGen:          b.stateGen,
-	})
+	}
+	if b.slasherDB != nil {
+		cfg.SlasherAttestationsFeed = b.slasherAttsFeed
+		cfg.SlasherBlockHeadersFeed = b.slasherBlockFeed
+	}
+	rs := regularsync.NewService(b.ctx, cfg)
 
 	return b.services.RegisterService(rs)
 }
 
+func (b *BeaconNode) registerSlasherService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	slasherSrv, err := slasher.New(b.ctx, &slasher.ServiceConfig{
+		IndexedAttestationsFeed: b.slasherAttsFeed,
+		BeaconBlockHeadersFeed:  b.slasherBlockFeed,
+		Database:                b.slasherDB,
+		StateNotifier:           b,
+		SlashingPoolInserter:    b.slashingsPool,
+		HeadStateFetcher:        chainService,
+		SyncChecker:             initSync,
+	})
+	if err != nil {
+		return err
+	}
+	return b.services.RegisterService(slasherSrv)
+}
+
 func (b *BeaconNode) registerInitialSyncService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -522,6 +687,51 @@ func (b *BeaconNode) registerInitialSyncService() error {
 	return b.services.RegisterService(is)
 }
 
+func (b *BeaconNode) registerBackfillService() error {
+	var chainService *blockchain.Service
+	if err := b.services.FetchService(&chainService); err != nil {
+		return err
+	}
+
+	var initSync *initialsync.Service
+	if err := b.services.FetchService(&initSync); err != nil {
+		return err
+	}
+
+	bs := backfill.NewService(b.ctx, &backfill.Config{
+		DB:          b.db,
+		P2P:         b.fetchP2P(),
+		Chain:       chainService,
+		InitialSync: initSync,
+	})
+	return b.services.RegisterService(bs)
+}
+
+func (b *BeaconNode) registerPrunerService(cliCtx *cli.Context) error {
+	svc, err := pruner.NewService(b.ctx, &pruner.Config{
+		DB:                    b.db,
+		RetentionEpochs:       types.Epoch(cliCtx.Uint64(flags.HistoryRetentionEpochs.Name)),
+		SlotsPerArchivedPoint: params.BeaconConfig().SlotsPerArchivedPoint,
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create pruner service")
+	}
+	b.stateGen.AddFinalizationHook(svc.OnFinalized)
+	return b.services.RegisterService(svc)
+}
+
+func (b *BeaconNode) registerBackupService(cliCtx *cli.Context) error {
+	svc, err := backup.NewService(b.ctx, &backup.Config{
+		DB:        b.db,
+		Interval:  cliCtx.Duration(flags.IncrementalBackupInterval.Name),
+		OutputDir: cliCtx.String(cmd.BackupWebhookOutputDir.Name),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not create backup service")
+	}
+	return b.services.RegisterService(svc)
+}
+
 func (b *BeaconNode) registerRPCService() error {
 	var chainService *blockchain.Service
 	if err := b.services.FetchService(&chainService); err != nil {
@@ -538,6 +748,11 @@ func (b *BeaconNode) registerRPCService() error {
 		return err
 	}
 
+	var accessListManager *p2p.Service
+	if err := b.services.FetchService(&accessListManager); err != nil {
+		return err
+	}
+
 	genesisValidators := b.cliCtx.Uint64(flags.InteropNumValidatorsFlag.Name)
 	genesisStatePath := b.cliCtx.String(flags.InteropGenesisStateFlag.Name)
 	var depositFetcher depositcache.DepositFetcher
@@ -575,6 +790,7 @@ func (b *BeaconNode) registerRPCService() error {
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
 		PeerManager:             p2pService,
+		AccessListManager:       accessListManager,
 		MetadataProvider:        p2pService,
 		ChainInfoFetcher:        chainService,
 		HeadFetcher:             chainService,
@@ -588,7 +804,10 @@ func (b *BeaconNode) registerRPCService() error {
 		AttestationsPool:        b.attestationPool,
 		ExitPool:                b.exitPool,
 		SlashingsPool:           b.slashingsPool,
+		SyncCommitteeObjectPool: b.syncCommitteePool,
 		POWChainService:         web3Service,
+		Eth1HealthFetcher:       web3Service,
+		DepositSnapshotFetcher:  web3Service,
 		ChainStartFetcher:       chainStartFetcher,
 		MockEth1Votes:           mockEth1DataVotes,
 		SyncService:             syncService,
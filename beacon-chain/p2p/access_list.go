@@ -0,0 +1,224 @@
+package p2p
+
+import (
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// AccessList is a list of peer ids and IP networks which are allowed or denied at runtime, on
+// top of the networks of the allow list and deny list flags. Allowed peers are never considered
+// bad because of their score and are dialed regardless of the IP networks. Denied peers are never
+// connected to. Inbound connections are only accepted from the allowed networks, if there are
+// any, and never from the denied networks.
+type AccessList struct {
+	AllowedPeers []peer.ID `json:"allowed_peers,omitempty"`
+	DeniedPeers  []peer.ID `json:"denied_peers,omitempty"`
+	AllowedCIDRs []string  `json:"allowed_cidrs,omitempty"`
+	DeniedCIDRs  []string  `json:"denied_cidrs,omitempty"`
+}
+
+// accessList holds the runtime access list entries, along with whether they are allowed or denied.
+type accessList struct {
+	lock  sync.RWMutex
+	peers map[peer.ID]multiaddr.Action
+	nets  map[string]multiaddr.Action
+}
+
+func (a *accessList) isDenied(pid peer.ID) bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.peers[pid] == multiaddr.ActionDeny
+}
+
+func (a *accessList) isAllowed(pid peer.ID) bool {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+	return a.peers[pid] == multiaddr.ActionAccept
+}
+
+// AccessList returns the peer ids and IP networks which were allowed or denied at runtime.
+func (s *Service) AccessList() *AccessList {
+	s.accessList.lock.RLock()
+	defer s.accessList.lock.RUnlock()
+
+	list := &AccessList{
+		AllowedPeers: make([]peer.ID, 0),
+		DeniedPeers:  make([]peer.ID, 0),
+		AllowedCIDRs: make([]string, 0),
+		DeniedCIDRs:  make([]string, 0),
+	}
+	for pid, action := range s.accessList.peers {
+		if action == multiaddr.ActionAccept {
+			list.AllowedPeers = append(list.AllowedPeers, pid)
+		} else {
+			list.DeniedPeers = append(list.DeniedPeers, pid)
+		}
+	}
+	for cidr, action := range s.accessList.nets {
+		if action == multiaddr.ActionAccept {
+			list.AllowedCIDRs = append(list.AllowedCIDRs, cidr)
+		} else {
+			list.DeniedCIDRs = append(list.DeniedCIDRs, cidr)
+		}
+	}
+	sort.Slice(list.AllowedPeers, func(i, j int) bool { return list.AllowedPeers[i] < list.AllowedPeers[j] })
+	sort.Slice(list.DeniedPeers, func(i, j int) bool { return list.DeniedPeers[i] < list.DeniedPeers[j] })
+	sort.Strings(list.AllowedCIDRs)
+	sort.Strings(list.DeniedCIDRs)
+	return list
+}
+
+// AddToAccessList allows or denies the peers and IP networks of the given list. An entry which
+// was already in the list is moved to the new side. Denied peers, and peers connected from a
+// denied network, are disconnected right away.
+func (s *Service) AddToAccessList(list *AccessList) error {
+	if err := s.addToAccessList(list); err != nil {
+		return err
+	}
+	s.disconnectDenied()
+	if err := s.saveReputation(); err != nil {
+		log.WithError(err).Error("Could not save peer reputation")
+	}
+	log.WithFields(accessListFields(list)).Info("Added entries to the peer access list")
+	return nil
+}
+
+// RemoveFromAccessList removes the peers and IP networks of the given list from the access list.
+// The networks of the allow list and deny list flags are kept.
+func (s *Service) RemoveFromAccessList(list *AccessList) error {
+	allowed, denied, err := parseCIDRs(list)
+	if err != nil {
+		return err
+	}
+	pids := make([]peer.ID, 0, len(list.AllowedPeers)+len(list.DeniedPeers))
+	pids = append(append(pids, list.AllowedPeers...), list.DeniedPeers...)
+	nets := append(allowed, denied...)
+	// The flag networks are restored when a runtime entry for the same network is removed.
+	flagFilter, err := configureFilter(&Config{AllowListCIDR: s.cfg.AllowListCIDR, DenyListCIDR: s.cfg.DenyListCIDR})
+	if err != nil {
+		return err
+	}
+
+	s.accessList.lock.Lock()
+	for _, pid := range pids {
+		if _, ok := s.accessList.peers[pid]; !ok {
+			s.accessList.lock.Unlock()
+			return errors.Errorf("peer %s is not in the access list", pid)
+		}
+	}
+	for _, ipnet := range nets {
+		if _, ok := s.accessList.nets[ipnet.String()]; !ok {
+			s.accessList.lock.Unlock()
+			return errors.Errorf("network %s is not in the access list", ipnet.String())
+		}
+	}
+	for _, pid := range pids {
+		delete(s.accessList.peers, pid)
+		s.peers.SetAllowed(pid, false)
+	}
+	for _, ipnet := range nets {
+		delete(s.accessList.nets, ipnet.String())
+		s.addrFilter.RemoveLiteral(*ipnet)
+		if action, ok := flagFilter.ActionForFilter(*ipnet); ok {
+			s.addrFilter.AddFilter(*ipnet, action)
+		}
+	}
+	s.accessList.lock.Unlock()
+
+	if err := s.saveReputation(); err != nil {
+		log.WithError(err).Error("Could not save peer reputation")
+	}
+	log.WithFields(accessListFields(list)).Info("Removed entries from the peer access list")
+	return nil
+}
+
+// addToAccessList adds the entries of the list to the access list and to the address filter,
+// without disconnecting peers.
+func (s *Service) addToAccessList(list *AccessList) error {
+	allowed, denied, err := parseCIDRs(list)
+	if err != nil {
+		return err
+	}
+
+	s.accessList.lock.Lock()
+	defer s.accessList.lock.Unlock()
+	if s.accessList.peers == nil {
+		s.accessList.peers = make(map[peer.ID]multiaddr.Action)
+		s.accessList.nets = make(map[string]multiaddr.Action)
+	}
+	for _, pid := range list.AllowedPeers {
+		s.accessList.peers[pid] = multiaddr.ActionAccept
+		s.peers.SetAllowed(pid, true)
+	}
+	for _, pid := range list.DeniedPeers {
+		s.accessList.peers[pid] = multiaddr.ActionDeny
+		s.peers.SetAllowed(pid, false)
+	}
+	for _, ipnet := range allowed {
+		s.accessList.nets[ipnet.String()] = multiaddr.ActionAccept
+		s.addrFilter.AddFilter(*ipnet, multiaddr.ActionAccept)
+	}
+	for _, ipnet := range denied {
+		s.accessList.nets[ipnet.String()] = multiaddr.ActionDeny
+		s.addrFilter.AddFilter(*ipnet, multiaddr.ActionDeny)
+	}
+	return nil
+}
+
+// disconnectDenied disconnects the peers which are denied, or connected from a denied network.
+func (s *Service) disconnectDenied() {
+	if s.host == nil {
+		return
+	}
+	for _, conn := range s.host.Network().Conns() {
+		pid := conn.RemotePeer()
+		if s.accessList.isAllowed(pid) {
+			continue
+		}
+		if !s.accessList.isDenied(pid) && !s.addrFilter.AddrBlocked(conn.RemoteMultiaddr()) {
+			continue
+		}
+		if err := s.Disconnect(pid); err != nil {
+			log.WithError(err).WithField("peer", pid).Debug("Could not disconnect denied peer")
+		}
+	}
+}
+
+// parseCIDRs parses the allowed and denied networks of the list.
+func parseCIDRs(list *AccessList) ([]*net.IPNet, []*net.IPNet, error) {
+	parse := func(cidrs []string) ([]*net.IPNet, error) {
+		nets := make([]*net.IPNet, len(cidrs))
+		for i, cidr := range cidrs {
+			_, ipnet, err := net.ParseCIDR(cidr)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not parse network %s", cidr)
+			}
+			nets[i] = ipnet
+		}
+		return nets, nil
+	}
+	allowed, err := parse(list.AllowedCIDRs)
+	if err != nil {
+		return nil, nil, err
+	}
+	denied, err := parse(list.DeniedCIDRs)
+	if err != nil {
+		return nil, nil, err
+	}
+	return allowed, denied, nil
+}
+
+func accessListFields(list *AccessList) logrus.Fields {
+	return logrus.Fields{
+		"allowedPeers": list.AllowedPeers,
+		"deniedPeers":  list.DeniedPeers,
+		"allowedCIDRs": list.AllowedCIDRs,
+		"deniedCIDRs":  list.DeniedCIDRs,
+	}
+}
@@ -0,0 +1,96 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func newAccessListTestService(t *testing.T, cfg *Config) *Service {
+	s := &Service{
+		cfg: cfg,
+		peers: peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 20,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold: 3,
+				},
+			},
+		}),
+	}
+	var err error
+	s.addrFilter, err = configureFilter(&Config{AllowListCIDR: cfg.AllowListCIDR, DenyListCIDR: cfg.DenyListCIDR})
+	require.NoError(t, err)
+	return s
+}
+
+func TestService_AccessList(t *testing.T) {
+	s := newAccessListTestService(t, &Config{DenyListCIDR: []string{"10.0.0.0/8"}})
+	deniedPeer := peer.ID("denied")
+	allowedPeer := peer.ID("allowed")
+	privateAddr, err := ma.NewMultiaddr("/ip4/10.1.1.1/tcp/13000")
+	require.NoError(t, err)
+	spammyAddr, err := ma.NewMultiaddr("/ip4/212.67.10.1/tcp/13000")
+	require.NoError(t, err)
+
+	// The allowed peer is bad because of its score.
+	s.peers.Add(nil, allowedPeer, spammyAddr, network.DirOutbound)
+	for i := 0; i < 3; i++ {
+		s.peers.Scorers().BadResponsesScorer().Increment(allowedPeer)
+	}
+	require.Equal(t, true, s.peers.IsBad(allowedPeer))
+	assert.Equal(t, true, filterConnections(s.addrFilter, spammyAddr))
+
+	require.NoError(t, s.AddToAccessList(&AccessList{
+		AllowedPeers: []peer.ID{allowedPeer},
+		DeniedPeers:  []peer.ID{deniedPeer},
+		DeniedCIDRs:  []string{"212.67.0.0/16"},
+	}))
+	assert.Equal(t, false, s.InterceptPeerDial(deniedPeer))
+	assert.Equal(t, false, s.InterceptSecured(network.DirInbound, deniedPeer, nil))
+	assert.Equal(t, true, s.InterceptPeerDial(allowedPeer))
+	assert.Equal(t, false, s.peers.IsBad(allowedPeer), "Allowed peer is still bad")
+	assert.Equal(t, true, s.InterceptAddrDial(allowedPeer, privateAddr), "Allowed peer was filtered")
+	assert.Equal(t, false, filterConnections(s.addrFilter, spammyAddr), "Denied network was not filtered")
+	assert.DeepEqual(t, &AccessList{
+		AllowedPeers: []peer.ID{allowedPeer},
+		DeniedPeers:  []peer.ID{deniedPeer},
+		AllowedCIDRs: []string{},
+		DeniedCIDRs:  []string{"212.67.0.0/16"},
+	}, s.AccessList())
+
+	// Entries are moved to the other side of the list.
+	require.NoError(t, s.AddToAccessList(&AccessList{AllowedPeers: []peer.ID{deniedPeer}, AllowedCIDRs: []string{"10.0.0.0/8"}}))
+	assert.Equal(t, true, s.InterceptPeerDial(deniedPeer))
+	assert.Equal(t, true, filterConnections(s.addrFilter, privateAddr), "Allowed network was filtered")
+
+	// Removing a network restores the network of the deny list flag.
+	require.NoError(t, s.RemoveFromAccessList(&AccessList{
+		AllowedPeers: []peer.ID{allowedPeer, deniedPeer},
+		AllowedCIDRs: []string{"10.0.0.0/8"},
+		DeniedCIDRs:  []string{"212.67.0.0/16"},
+	}))
+	assert.Equal(t, false, filterConnections(s.addrFilter, privateAddr), "Deny list flag network was not restored")
+	assert.Equal(t, true, filterConnections(s.addrFilter, spammyAddr))
+	assert.Equal(t, true, s.peers.IsBad(allowedPeer))
+	assert.DeepEqual(t, &AccessList{
+		AllowedPeers: []peer.ID{},
+		DeniedPeers:  []peer.ID{},
+		AllowedCIDRs: []string{},
+		DeniedCIDRs:  []string{},
+	}, s.AccessList())
+}
+
+func TestService_AccessList_InvalidEntries(t *testing.T) {
+	s := newAccessListTestService(t, &Config{})
+	assert.ErrorContains(t, "could not parse network", s.AddToAccessList(&AccessList{DeniedCIDRs: []string{"212.67.0.0"}}))
+	assert.ErrorContains(t, "is not in the access list", s.RemoveFromAccessList(&AccessList{DeniedPeers: []peer.ID{"unknown"}}))
+	assert.ErrorContains(t, "is not in the access list", s.RemoveFromAccessList(&AccessList{DeniedCIDRs: []string{"212.67.0.0/16"}}))
+}
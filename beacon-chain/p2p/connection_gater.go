@@ -25,13 +25,17 @@ const (
 )
 
 // InterceptPeerDial tests whether we're permitted to Dial the specified peer.
-func (s *Service) InterceptPeerDial(_ peer.ID) (allow bool) {
-	return true
+func (s *Service) InterceptPeerDial(pid peer.ID) (allow bool) {
+	return !s.accessList.isDenied(pid)
 }
 
 // InterceptAddrDial tests whether we're permitted to dial the specified
 // multiaddr for the given peer.
 func (s *Service) InterceptAddrDial(pid peer.ID, m multiaddr.Multiaddr) (allow bool) {
+	// Allowed and trusted peers are dialed regardless of the address filter.
+	if s.accessList.isAllowed(pid) || s.peers.IsTrusted(pid) {
+		return true
+	}
 	// Disallow bad peers from dialing in.
 	if s.peers.IsBad(pid) {
 		return false
@@ -49,7 +53,8 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 			"reason": "exceeded dial limit"}).Trace("Not accepting inbound dial from ip address")
 		return false
 	}
-	if s.isPeerAtLimit(true /* inbound */) {
+	// Trusted peers are not subject to the peer limit.
+	if s.isPeerAtLimit(true /* inbound */) && !s.isTrustedAddr(n.RemoteMultiaddr()) {
 		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(),
 			"reason": "at peer limit"}).Trace("Not accepting inbound dial")
 		return false
@@ -59,7 +64,12 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection, now authenticated,
 // is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+	if s.accessList.isDenied(pid) {
+		log.WithFields(logrus.Fields{"peer": pid,
+			"reason": "denied peer"}).Trace("Not accepting connection")
+		return false
+	}
 	return true
 }
 
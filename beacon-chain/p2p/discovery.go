@@ -17,6 +17,7 @@ import (
 	"github.com/prysmaticlabs/go-bitfield"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/version"
 )
@@ -42,8 +43,17 @@ func (s *Service) RefreshENR() {
 	if s.dv5Listener == nil || !s.isInitialized() {
 		return
 	}
+	currEpoch := helpers.SlotToEpoch(helpers.CurrentSlot(uint64(s.genesisTime.Unix())))
 	bitV := bitfield.NewBitvector64()
 	committees := cache.SubnetIDs.GetAllSubnets()
+	if flags.Get().SubscribeToNodeIDSubnets {
+		var err error
+		committees, err = ComputeSubscribedSubnets(s.dv5Listener.LocalNode().ID(), currEpoch)
+		if err != nil {
+			log.WithError(err).Error("Could not compute subscribed subnets")
+			return
+		}
+	}
 	for _, idx := range committees {
 		bitV.SetBitAt(idx, true)
 	}
@@ -53,7 +63,6 @@ func (s *Service) RefreshENR() {
 		return
 	}
 	// Compare current epoch with our fork epochs
-	currEpoch := helpers.SlotToEpoch(helpers.CurrentSlot(uint64(s.genesisTime.Unix())))
 	altairForkEpoch := params.BeaconConfig().AltairForkEpoch
 	switch {
 	// Altair Behaviour
@@ -313,22 +322,23 @@ func (s *Service) filterPeer(node *enode.Node) bool {
 
 // This checks our set max peers in our config, and
 // determines whether our currently connected and
-// active peers are above our set max peer limit.
+// active peers are above our set max peer limit. Trusted
+// peers are not counted towards the limit.
 func (s *Service) isPeerAtLimit(inbound bool) bool {
-	numOfConns := len(s.host.Network().Peers())
+	numOfConns := len(s.peers.WithoutTrusted(s.host.Network().Peers()))
 	maxPeers := int(s.cfg.MaxPeers)
 	// If we are measuring the limit for inbound peers
 	// we apply the high watermark buffer.
 	if inbound {
 		maxPeers += highWatermarkBuffer
 		maxInbound := s.peers.InboundLimit() + highWatermarkBuffer
-		currInbound := len(s.peers.InboundConnected())
+		currInbound := len(s.peers.WithoutTrusted(s.peers.InboundConnected()))
 		// Exit early if we are at the inbound limit.
 		if currInbound >= maxInbound {
 			return true
 		}
 	}
-	activePeers := len(s.Peers().Active())
+	activePeers := len(s.peers.WithoutTrusted(s.peers.Active()))
 	return activePeers >= maxPeers || numOfConns >= maxPeers
 }
 
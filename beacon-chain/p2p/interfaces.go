@@ -81,6 +81,13 @@ type PeerManager interface {
 	AddPingMethod(reqFunc func(ctx context.Context, id peer.ID) error)
 }
 
+// AccessListManager manages the peer ids and IP networks which are allowed or denied at runtime.
+type AccessListManager interface {
+	AccessList() *AccessList
+	AddToAccessList(list *AccessList) error
+	RemoveFromAccessList(list *AccessList) error
+}
+
 // Sender abstracts the sending functionality from libp2p.
 type Sender interface {
 	Send(context.Context, interface{}, string, peer.ID) (network.Stream, error)
@@ -35,9 +35,10 @@ type StoreConfig struct {
 // the mutex when accessing data.
 type Store struct {
 	sync.RWMutex
-	ctx    context.Context
-	config *StoreConfig
-	peers  map[peer.ID]*PeerData
+	ctx          context.Context
+	config       *StoreConfig
+	peers        map[peer.ID]*PeerData
+	trustedPeers map[peer.ID]bool
 }
 
 // PeerData aggregates protocol and application level info about a single peer.
@@ -66,9 +67,10 @@ type PeerData struct {
 // NewStore creates new peer data store.
 func NewStore(ctx context.Context, config *StoreConfig) *Store {
 	return &Store{
-		ctx:    ctx,
-		config: config,
-		peers:  make(map[peer.ID]*PeerData),
+		ctx:          ctx,
+		config:       config,
+		peers:        make(map[peer.ID]*PeerData),
+		trustedPeers: make(map[peer.ID]bool),
 	}
 }
 
@@ -108,6 +110,31 @@ func (s *Store) Peers() map[peer.ID]*PeerData {
 	return s.peers
 }
 
+// SetTrustedPeers replaces the set of trusted peers. The trusted peers are kept when their data is deleted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) SetTrustedPeers(pids []peer.ID) {
+	s.trustedPeers = make(map[peer.ID]bool, len(pids))
+	for _, pid := range pids {
+		s.trustedPeers[pid] = true
+	}
+}
+
+// IsTrustedPeer returns true if the peer is trusted.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) IsTrustedPeer(pid peer.ID) bool {
+	return s.trustedPeers[pid]
+}
+
+// TrustedPeers returns the trusted peers.
+// Important: it is assumed that store mutex is locked when calling this method.
+func (s *Store) TrustedPeers() []peer.ID {
+	pids := make([]peer.ID, 0, len(s.trustedPeers))
+	for pid := range s.trustedPeers {
+		pids = append(pids, pid)
+	}
+	return pids
+}
+
 // Config exposes store configuration params.
 func (s *Store) Config() *StoreConfig {
 	return s.config
@@ -0,0 +1,114 @@
+package peers
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/peerdata"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+)
+
+// Reputation holds the scoring data of a peer which is kept across restarts of the node.
+type Reputation struct {
+	PeerID           peer.ID `json:"peer_id"`
+	Address          string  `json:"address,omitempty"`
+	BadResponses     int     `json:"bad_responses,omitempty"`
+	ProcessedBlocks  uint64  `json:"processed_blocks,omitempty"`
+	GossipScore      float64 `json:"gossip_score,omitempty"`
+	BehaviourPenalty float64 `json:"behaviour_penalty,omitempty"`
+	ValidationError  string  `json:"validation_error,omitempty"`
+}
+
+// Chain state validation errors which are restored as such, so that the peers which were banned
+// because of them stay banned.
+var restoredValidationErrors = []error{
+	p2ptypes.ErrWrongForkDigestVersion,
+}
+
+// Reputations returns the reputation of the known peers which have been scored.
+func (p *Status) Reputations() []*Reputation {
+	p.store.RLock()
+	defer p.store.RUnlock()
+
+	reputations := make([]*Reputation, 0)
+	for pid, peerData := range p.store.Peers() {
+		r := &Reputation{
+			PeerID:           pid,
+			BadResponses:     peerData.BadResponses,
+			ProcessedBlocks:  peerData.ProcessedBlocks,
+			GossipScore:      peerData.GossipScore,
+			BehaviourPenalty: peerData.BehaviourPenalty,
+		}
+		if peerData.ChainStateValidationError != nil {
+			r.ValidationError = peerData.ChainStateValidationError.Error()
+		}
+		if r.BadResponses == 0 && r.ProcessedBlocks == 0 && r.GossipScore == 0 && r.BehaviourPenalty == 0 &&
+			r.ValidationError == "" {
+			continue
+		}
+		if peerData.Address != nil {
+			r.Address = peerData.Address.String()
+		}
+		reputations = append(reputations, r)
+	}
+	sort.Slice(reputations, func(i, j int) bool {
+		return reputations[i].PeerID < reputations[j].PeerID
+	})
+	return reputations
+}
+
+// RestoreReputations adds the peers of the given reputations as disconnected peers. The bad
+// responses and processed blocks are decayed as if the node had been running for the elapsed
+// time since the reputations were saved. Peers which are already known are left untouched.
+func (p *Status) RestoreReputations(reputations []*Reputation, elapsed time.Duration) {
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	badResponsesParams := p.scorers.BadResponsesScorer().Params()
+	badResponsesDecay := int(elapsed / badResponsesParams.DecayInterval)
+	blockProviderParams := p.scorers.BlockProviderScorer().Params()
+	processedBlocksDecay := uint64(elapsed/blockProviderParams.DecayInterval) * blockProviderParams.Decay
+
+	p.store.Lock()
+	defer p.store.Unlock()
+
+	for _, r := range reputations {
+		if _, ok := p.store.PeerData(r.PeerID); ok {
+			continue
+		}
+		peerData := &peerdata.PeerData{
+			ConnState:        PeerDisconnected,
+			GossipScore:      r.GossipScore,
+			BehaviourPenalty: r.BehaviourPenalty,
+		}
+		if r.Address != "" {
+			addr, err := ma.NewMultiaddr(r.Address)
+			if err == nil {
+				peerData.Address = addr
+			}
+		}
+		if r.BadResponses > badResponsesDecay {
+			peerData.BadResponses = r.BadResponses - badResponsesDecay
+		}
+		if r.ProcessedBlocks > processedBlocksDecay {
+			peerData.ProcessedBlocks = r.ProcessedBlocks - processedBlocksDecay
+		}
+		if r.ValidationError != "" {
+			peerData.ChainStateValidationError = restoreValidationError(r.ValidationError)
+		}
+		p.store.SetPeerData(r.PeerID, peerData)
+	}
+	p.tallyIPTracker()
+}
+
+func restoreValidationError(msg string) error {
+	for _, err := range restoredValidationErrors {
+		if err.Error() == msg {
+			return err
+		}
+	}
+	return errors.New(msg)
+}
@@ -0,0 +1,67 @@
+package peers_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
+	p2ptypes "github.com/prysmaticlabs/prysm/beacon-chain/p2p/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStatus_Reputations(t *testing.T) {
+	newStatus := func() *peers.Status {
+		return peers.NewStatus(context.Background(), &peers.StatusConfig{
+			PeerLimit: 30,
+			ScorerParams: &scorers.Config{
+				BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+					Threshold:     3,
+					DecayInterval: time.Hour,
+				},
+			},
+		})
+	}
+	p := newStatus()
+	address, err := ma.NewMultiaddr("/ip4/213.202.254.180/tcp/13000")
+	require.NoError(t, err)
+	badPeer := peer.ID("bad")
+	forkPeer := peer.ID("fork")
+	neutralPeer := peer.ID("neutral")
+	for _, pid := range []peer.ID{badPeer, forkPeer, neutralPeer} {
+		p.Add(nil, pid, address, network.DirInbound)
+	}
+	for i := 0; i < 4; i++ {
+		p.Scorers().BadResponsesScorer().Increment(badPeer)
+	}
+	p.Scorers().PeerStatusScorer().SetPeerStatus(forkPeer, nil, p2ptypes.ErrWrongForkDigestVersion)
+
+	reputations := p.Reputations()
+	require.Equal(t, 2, len(reputations), "Neutral peer should not be saved")
+	assert.Equal(t, badPeer, reputations[0].PeerID)
+	assert.Equal(t, address.String(), reputations[0].Address)
+	assert.Equal(t, 4, reputations[0].BadResponses)
+
+	restored := newStatus()
+	restored.RestoreReputations(reputations, 0)
+	assert.Equal(t, true, restored.IsBad(badPeer))
+	assert.Equal(t, true, restored.IsBad(forkPeer), "Wrong fork digest ban was not restored")
+	assert.Equal(t, false, restored.IsActive(badPeer))
+	assert.Equal(t, 0, len(restored.Active()))
+	resAddress, err := restored.Address(badPeer)
+	require.NoError(t, err)
+	assert.Equal(t, address.String(), resAddress.String())
+
+	// Two hours of decay make the bad peer good again.
+	restored = newStatus()
+	restored.RestoreReputations(reputations, 2*time.Hour)
+	assert.Equal(t, false, restored.IsBad(badPeer))
+	count, err := restored.Scorers().BadResponsesScorer().Count(badPeer)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+}
@@ -95,11 +95,14 @@ func (s *BadResponsesScorer) count(pid peer.ID) (int, error) {
 }
 
 // Increment increments the number of bad responses we have received from the given remote peer.
-// If peer doesn't exist this method is no-op.
+// If peer doesn't exist this method is no-op. Trusted peers are never down-scored.
 func (s *BadResponsesScorer) Increment(pid peer.ID) {
 	s.store.Lock()
 	defer s.store.Unlock()
 
+	if s.store.IsTrustedPeer(pid) {
+		return
+	}
 	peerData, ok := s.store.PeerData(pid)
 	if !ok {
 		s.store.SetPeerData(pid, &peerdata.PeerData{
@@ -120,6 +123,9 @@ func (s *BadResponsesScorer) IsBadPeer(pid peer.ID) bool {
 
 // isBadPeer is lock-free version of IsBadPeer.
 func (s *BadResponsesScorer) isBadPeer(pid peer.ID) bool {
+	if s.store.IsTrustedPeer(pid) {
+		return false
+	}
 	if peerData, ok := s.store.PeerData(pid); ok {
 		return peerData.BadResponses >= s.config.Threshold
 	}
@@ -54,6 +54,9 @@ func (s *GossipScorer) IsBadPeer(pid peer.ID) bool {
 
 // isBadPeer is lock-free version of IsBadPeer.
 func (s *GossipScorer) isBadPeer(pid peer.ID) bool {
+	if s.store.IsTrustedPeer(pid) {
+		return false
+	}
 	peerData, ok := s.store.PeerData(pid)
 	if !ok {
 		return false
@@ -75,6 +75,9 @@ func (s *PeerStatusScorer) IsBadPeer(pid peer.ID) bool {
 
 // isBadPeer is lock-free version of IsBadPeer.
 func (s *PeerStatusScorer) isBadPeer(pid peer.ID) bool {
+	if s.store.IsTrustedPeer(pid) {
+		return false
+	}
 	peerData, ok := s.store.PeerData(pid)
 	if !ok {
 		return false
@@ -80,6 +80,7 @@ type Status struct {
 	scorers   *scorers.Service
 	store     *peerdata.Store
 	ipTracker map[string]uint64
+	allowed   map[peer.ID]bool
 	rand      *rand.Rand
 }
 
@@ -101,6 +102,7 @@ func NewStatus(ctx context.Context, config *StatusConfig) *Status {
 		store:     store,
 		scorers:   scorers.NewService(ctx, store, config.ScorerParams),
 		ipTracker: map[string]uint64{},
+		allowed:   map[peer.ID]bool{},
 		// Random generator used to calculate dial backoff period.
 		// It is ok to use deterministic generator, no need for true entropy.
 		rand: rand.NewDeterministicGenerator(),
@@ -211,9 +213,9 @@ func (p *Status) IsAboveInboundLimit() bool {
 	p.store.RLock()
 	defer p.store.RUnlock()
 	totalInbound := 0
-	for _, peerData := range p.store.Peers() {
+	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.store.IsTrustedPeer(pid) {
 			totalInbound += 1
 		}
 	}
@@ -325,10 +327,67 @@ func (p *Status) ChainStateLastUpdated(pid peer.ID) (time.Time, error) {
 
 // IsBad states if the peer is to be considered bad (by *any* of the registered scorers).
 // If the peer is unknown this will return `false`, which makes using this function easier than returning an error.
+// Allowed peers are never considered bad.
 func (p *Status) IsBad(pid peer.ID) bool {
+	if p.IsAllowed(pid) || p.IsTrusted(pid) {
+		return false
+	}
 	return p.isfromBadIP(pid) || p.scorers.IsBadPeer(pid)
 }
 
+// SetAllowed sets whether the peer is allowed, regardless of its score.
+func (p *Status) SetAllowed(pid peer.ID, allowed bool) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	if allowed {
+		p.allowed[pid] = true
+	} else {
+		delete(p.allowed, pid)
+	}
+}
+
+// IsAllowed returns true if the peer is allowed regardless of its score.
+func (p *Status) IsAllowed(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.allowed[pid]
+}
+
+// SetTrustedPeers sets the trusted peers. Trusted peers are never considered bad, down-scored or
+// pruned, and are not counted towards the peer limits.
+func (p *Status) SetTrustedPeers(pids []peer.ID) {
+	p.store.Lock()
+	defer p.store.Unlock()
+	p.store.SetTrustedPeers(pids)
+}
+
+// IsTrusted returns true if the peer is trusted.
+func (p *Status) IsTrusted(pid peer.ID) bool {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.IsTrustedPeer(pid)
+}
+
+// Trusted returns the trusted peers.
+func (p *Status) Trusted() []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	return p.store.TrustedPeers()
+}
+
+// WithoutTrusted returns the given peers without the trusted peers.
+func (p *Status) WithoutTrusted(pids []peer.ID) []peer.ID {
+	p.store.RLock()
+	defer p.store.RUnlock()
+	untrusted := make([]peer.ID, 0, len(pids))
+	for _, pid := range pids {
+		if !p.store.IsTrustedPeer(pid) {
+			untrusted = append(untrusted, pid)
+		}
+	}
+	return untrusted
+}
+
 // NextValidTime gets the earliest possible time it is to contact/dial
 // a peer again. This is used to back-off from peers in the event
 // they are 'full' or have banned us.
@@ -550,7 +609,7 @@ func (p *Status) Prune() {
 	peersToPrune := make([]*peerResp, 0)
 	// Select disconnected peers with a smaller bad response count.
 	for pid, peerData := range p.store.Peers() {
-		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) {
+		if peerData.ConnState == PeerDisconnected && notBadPeer(peerData) && !p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -689,8 +748,9 @@ func (p *Status) BestNonFinalized(minPeers int, ourHeadEpoch types.Epoch) (types
 func (p *Status) PeersToPrune() []peer.ID {
 	connLimit := p.ConnectedPeerLimit()
 	inBoundLimit := p.InboundLimit()
-	activePeers := p.Active()
-	numInboundPeers := len(p.InboundConnected())
+	// Trusted peers do not count towards the limits.
+	activePeers := p.WithoutTrusted(p.Active())
+	numInboundPeers := len(p.WithoutTrusted(p.InboundConnected()))
 	// Exit early if we are still below our max
 	// limit.
 	if len(activePeers) <= int(connLimit) {
@@ -707,7 +767,7 @@ func (p *Status) PeersToPrune() []peer.ID {
 	// Select connected and inbound peers to prune.
 	for pid, peerData := range p.store.Peers() {
 		if peerData.ConnState == PeerConnected &&
-			peerData.Direction == network.DirInbound {
+			peerData.Direction == network.DirInbound && !p.store.IsTrustedPeer(pid) {
 			peersToPrune = append(peersToPrune, &peerResp{
 				pid:     pid,
 				badResp: peerData.BadResponses,
@@ -720,6 +720,47 @@ func TestPrunePeers(t *testing.T) {
 	}
 }
 
+func TestStatus_TrustedPeers(t *testing.T) {
+	p := peers.NewStatus(context.Background(), &peers.StatusConfig{
+		PeerLimit: 30,
+		ScorerParams: &scorers.Config{
+			BadResponsesScorerConfig: &scorers.BadResponsesScorerConfig{
+				Threshold: 1,
+			},
+		},
+	})
+	for i := 0; i < 15; i++ {
+		createPeer(t, p, nil, network.DirOutbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	trusted := make([]peer.ID, 0)
+	for i := 0; i < 18; i++ {
+		pid := createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+		if i < 3 {
+			trusted = append(trusted, pid)
+		}
+	}
+	p.SetTrustedPeers(trusted)
+	assert.Equal(t, true, p.IsTrusted(trusted[0]))
+	assert.Equal(t, 3, len(p.Trusted()))
+	assert.Equal(t, 30, len(p.WithoutTrusted(p.Active())))
+	assert.Equal(t, 0, len(p.PeersToPrune()), "Trusted peers counted towards the limit")
+
+	// Trusted peers are never down-scored or pruned.
+	p.Scorers().BadResponsesScorer().Increment(trusted[0])
+	count, err := p.Scorers().BadResponsesScorer().Count(trusted[0])
+	require.NoError(t, err)
+	assert.Equal(t, 0, count)
+	assert.Equal(t, false, p.IsBad(trusted[0]))
+	for i := 0; i < 5; i++ {
+		createPeer(t, p, nil, network.DirInbound, peerdata.PeerConnectionState(ethpb.ConnectionState_CONNECTED))
+	}
+	peersToPrune := p.PeersToPrune()
+	assert.Equal(t, 5, len(peersToPrune))
+	for _, pid := range peersToPrune {
+		assert.Equal(t, false, p.IsTrusted(pid), "Trusted peer is pruned")
+	}
+}
+
 func TestStatus_BestPeer(t *testing.T) {
 	type peerConfig struct {
 		headSlot       types.Slot
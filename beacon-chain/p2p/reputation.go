@@ -0,0 +1,75 @@
+package p2p
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+const reputationPath = "peerReputation"
+
+// Interval at which the peer reputation is saved to the data directory.
+var reputationSaveInterval = 5 * time.Minute
+
+// savedReputation is the peer reputation and access list which are kept in the data directory
+// across restarts.
+type savedReputation struct {
+	SavedAt    time.Time           `json:"saved_at"`
+	Peers      []*peers.Reputation `json:"peers"`
+	AccessList *AccessList         `json:"access_list"`
+}
+
+// loadReputation restores the peer reputation and access list saved in the data directory, if any.
+func (s *Service) loadReputation() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	filePath := path.Join(s.cfg.DataDir, reputationPath)
+	if !fileutil.FileExists(filePath) {
+		return nil
+	}
+	enc, err := ioutil.ReadFile(filePath) // #nosec G304
+	if err != nil {
+		return errors.Wrap(err, "could not read peer reputation file")
+	}
+	saved := &savedReputation{}
+	if err := json.Unmarshal(enc, saved); err != nil {
+		return errors.Wrap(err, "could not decode peer reputation file")
+	}
+	if saved.AccessList != nil {
+		if err := s.addToAccessList(saved.AccessList); err != nil {
+			return errors.Wrap(err, "could not restore peer access list")
+		}
+	}
+	s.peers.RestoreReputations(saved.Peers, timeutils.Now().Sub(saved.SavedAt))
+	log.WithFields(logrus.Fields{
+		"peers":   len(saved.Peers),
+		"savedAt": saved.SavedAt,
+	}).Info("Restored peer reputation")
+	return nil
+}
+
+// saveReputation saves the peer reputation and access list to the data directory.
+func (s *Service) saveReputation() error {
+	if s.cfg.DataDir == "" {
+		return nil
+	}
+	s.reputationLock.Lock()
+	defer s.reputationLock.Unlock()
+	enc, err := json.Marshal(&savedReputation{
+		SavedAt:    timeutils.Now(),
+		Peers:      s.peers.Reputations(),
+		AccessList: s.AccessList(),
+	})
+	if err != nil {
+		return err
+	}
+	return fileutil.WriteFile(path.Join(s.cfg.DataDir, reputationPath), enc)
+}
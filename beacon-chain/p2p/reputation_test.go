@@ -0,0 +1,46 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_SaveLoadReputation(t *testing.T) {
+	cfg := &Config{DataDir: t.TempDir()}
+	s := newAccessListTestService(t, cfg)
+	badPeer, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	deniedPeer, err := peer.Decode("16Uiu2HAm8maLMjag1TAUM52zPfmLbVMGFdwUAWgoHu1HDQLR6e17")
+	require.NoError(t, err)
+	addr, err := ma.NewMultiaddr("/ip4/212.67.10.1/tcp/13000")
+	require.NoError(t, err)
+	s.peers.Add(nil, badPeer, addr, network.DirInbound)
+	for i := 0; i < 3; i++ {
+		s.peers.Scorers().BadResponsesScorer().Increment(badPeer)
+	}
+	require.NoError(t, s.AddToAccessList(&AccessList{
+		DeniedPeers: []peer.ID{deniedPeer},
+		DeniedCIDRs: []string{"10.0.0.0/8"},
+	}))
+	require.NoError(t, s.saveReputation())
+
+	restarted := newAccessListTestService(t, cfg)
+	require.NoError(t, restarted.loadReputation())
+	assert.Equal(t, true, restarted.peers.IsBad(badPeer), "Ban was not restored")
+	assert.Equal(t, false, restarted.peers.IsActive(badPeer))
+	assert.Equal(t, false, restarted.InterceptPeerDial(deniedPeer), "Denied peer was not restored")
+	privateAddr, err := ma.NewMultiaddr("/ip4/10.1.1.1/tcp/13000")
+	require.NoError(t, err)
+	assert.Equal(t, false, filterConnections(restarted.addrFilter, privateAddr), "Denied network was not restored")
+	assert.DeepEqual(t, s.AccessList(), restarted.AccessList())
+
+	// Nothing is restored without a data directory.
+	s = newAccessListTestService(t, &Config{})
+	require.NoError(t, s.loadReputation())
+	assert.Equal(t, false, s.peers.IsBad(badPeer))
+}
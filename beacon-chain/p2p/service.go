@@ -62,6 +62,9 @@ type Service struct {
 	cfg                   *Config
 	peers                 *peers.Status
 	addrFilter            *multiaddr.Filters
+	accessList            accessList
+	trustedPeers          []*trustedPeer
+	reputationLock        sync.Mutex
 	ipLimiter             *leakybucket.Collector
 	privKey               *ecdsa.PrivateKey
 	metaData              metadata.Metadata
@@ -119,6 +122,11 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 	s.ipLimiter = leakybucket.NewCollector(ipLimit, ipBurst, true /* deleteEmptyBuckets */)
+	s.trustedPeers, err = trustedPeersFromAddrs(s.cfg.TrustedPeers)
+	if err != nil {
+		log.WithError(err).Error("Failed to parse trusted peers")
+		return nil, err
+	}
 
 	opts := s.buildOptions(ipAddr, s.privKey)
 	h, err := libp2p.New(s.ctx, opts...)
@@ -166,6 +174,11 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 			},
 		},
 	})
+	s.peers.SetTrustedPeers(s.trustedPeerIDs())
+
+	if err := s.loadReputation(); err != nil {
+		log.WithError(err).Error("Could not restore peer reputation")
+	}
 
 	// Initialize Data maps.
 	types.InitializeDataMaps()
@@ -223,6 +236,7 @@ func (s *Service) Start() {
 		}
 		s.connectWithAllPeers(addrs)
 	}
+	go s.maintainTrustedPeers()
 	// Initialize metadata according to the
 	// current epoch.
 	s.RefreshENR()
@@ -232,6 +246,11 @@ func (s *Service) Start() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
 	runutil.RunEvery(s.ctx, 30*time.Minute, s.Peers().Prune)
+	runutil.RunEvery(s.ctx, reputationSaveInterval, func() {
+		if err := s.saveReputation(); err != nil {
+			log.WithError(err).Error("Could not save peer reputation")
+		}
+	})
 	runutil.RunEvery(s.ctx, params.BeaconNetworkConfig().RespTimeout, s.updateMetrics)
 	runutil.RunEvery(s.ctx, refreshRate, func() {
 		s.RefreshENR()
@@ -266,6 +285,9 @@ func (s *Service) Start() {
 func (s *Service) Stop() error {
 	defer s.cancel()
 	s.started = false
+	if err := s.saveReputation(); err != nil {
+		log.WithError(err).Error("Could not save peer reputation")
+	}
 	if s.dv5Listener != nil {
 		s.dv5Listener.Close()
 	}
@@ -2,14 +2,21 @@ package p2p
 
 import (
 	"context"
+	"math/big"
+	"math/bits"
 	"strings"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
 	"go.opencensus.io/trace"
 
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -96,6 +103,13 @@ func (s *Service) filterPeerForAttSubnet(index uint64) func(node *enode.Node) bo
 		if err != nil {
 			return false
 		}
+		if flags.Get().SubscribeToNodeIDSubnets {
+			// The record of the peer might not be refreshed yet for the current epoch, so
+			// its node ID derived subnets are predicted as well.
+			if predicted, ok := s.predictedAttSubnets(node, subnets); ok {
+				subnets = append(subnets, predicted...)
+			}
+		}
 		indExists := false
 		for _, comIdx := range subnets {
 			if comIdx == index {
@@ -128,6 +142,91 @@ func (s *Service) filterPeerForSyncSubnet(index uint64) func(node *enode.Node) b
 	}
 }
 
+// Predicts the node ID derived attestation subnets of a peer for the current epoch. The
+// prediction is only used for peers which advertise the node ID derived subnets of the current
+// or of the previous epoch in their record, as other peers do not derive their subnets from
+// their node ID.
+func (s *Service) predictedAttSubnets(node *enode.Node, advertised []uint64) ([]uint64, bool) {
+	currEpoch := helpers.SlotToEpoch(helpers.CurrentSlot(uint64(s.genesisTime.Unix())))
+	predicted, err := ComputeSubscribedSubnets(node.ID(), currEpoch)
+	if err != nil {
+		log.WithError(err).Debug("Could not compute subscribed subnets of peer")
+		return nil, false
+	}
+	if containsSubnets(advertised, predicted) {
+		return predicted, true
+	}
+	if currEpoch == 0 {
+		return nil, false
+	}
+	previous, err := ComputeSubscribedSubnets(node.ID(), currEpoch-1)
+	if err != nil {
+		log.WithError(err).Debug("Could not compute subscribed subnets of peer")
+		return nil, false
+	}
+	if !containsSubnets(advertised, previous) {
+		return nil, false
+	}
+	return predicted, true
+}
+
+// ComputeSubscribedSubnets returns the long-lived attestation subnets a node subscribes to during
+// the epoch. The subnets are derived from the node ID, so that they can be computed by any peer.
+//
+// Spec pseudocode definition:
+//   def compute_subscribed_subnet(node_id: NodeID, epoch: Epoch, index: int) -> SubnetID:
+//    node_id_prefix = node_id >> (NODE_ID_BITS - ATTESTATION_SUBNET_PREFIX_BITS)
+//    node_offset = node_id % EPOCHS_PER_SUBNET_SUBSCRIPTION
+//    permutation_seed = hash(uint_to_bytes(uint64((epoch + node_offset) // EPOCHS_PER_SUBNET_SUBSCRIPTION)))
+//    permutated_prefix = compute_shuffled_index(
+//        node_id_prefix,
+//        1 << ATTESTATION_SUBNET_PREFIX_BITS,
+//        permutation_seed,
+//    )
+//    return SubnetID((permutated_prefix + index) % ATTESTATION_SUBNET_COUNT)
+//
+//   def compute_subscribed_subnets(node_id: NodeID, epoch: Epoch) -> Sequence[SubnetID]:
+//    return [compute_subscribed_subnet(node_id, epoch, index) for index in range(SUBNETS_PER_NODE)]
+func ComputeSubscribedSubnets(nodeID enode.ID, epoch types.Epoch) ([]uint64, error) {
+	cfg := params.BeaconNetworkConfig()
+	if cfg.EpochsPerSubnetSubscription == 0 {
+		return nil, errors.New("epochs per subnet subscription is zero")
+	}
+	prefixBits := uint64(bits.Len64(cfg.AttestationSubnetCount-1)) + cfg.AttestationSubnetExtraBits
+	id := new(big.Int).SetBytes(nodeID[:])
+	nodeIDPrefix := new(big.Int).Rsh(id, uint(len(nodeID)*8)-uint(prefixBits)).Uint64()
+	nodeOffset := new(big.Int).Mod(id, new(big.Int).SetUint64(cfg.EpochsPerSubnetSubscription)).Uint64()
+	permutationSeed := hashutil.Hash(bytesutil.Bytes8((uint64(epoch) + nodeOffset) / cfg.EpochsPerSubnetSubscription))
+	permutatedPrefix, err := helpers.ComputeShuffledIndex(
+		types.ValidatorIndex(nodeIDPrefix), 1<<prefixBits, permutationSeed, true, /* shuffle */
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not shuffle node id prefix")
+	}
+	subnets := make([]uint64, 0, cfg.SubnetsPerNode)
+	for i := uint64(0); i < cfg.SubnetsPerNode; i++ {
+		subnets = append(subnets, (uint64(permutatedPrefix)+i)%cfg.AttestationSubnetCount)
+	}
+	return subnets, nil
+}
+
+// Determines whether all the wanted subnets are part of the provided subnets.
+func containsSubnets(subnets, wanted []uint64) bool {
+	for _, w := range wanted {
+		found := false
+		for _, idx := range subnets {
+			if idx == w {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
 // lower threshold to broadcast object compared to searching
 // for a subnet. So that even in the event of poor peer
 // connectivity, we can still broadcast an attestation.
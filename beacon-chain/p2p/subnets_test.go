@@ -11,13 +11,16 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/p2p/enr"
 	"github.com/libp2p/go-libp2p-core/crypto"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/go-bitfield"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -485,3 +488,148 @@ func Test_SyncSubnets(t *testing.T) {
 		})
 	}
 }
+
+func TestComputeSubscribedSubnets(t *testing.T) {
+	nodeID := enode.ID{0xfc, 0x01}
+	nodeID[31] = 0x10 // node offset of 16 epochs
+	subnets, err := ComputeSubscribedSubnets(nodeID, 0)
+	require.NoError(t, err)
+	require.Equal(t, params.BeaconNetworkConfig().SubnetsPerNode, uint64(len(subnets)))
+	count := params.BeaconNetworkConfig().AttestationSubnetCount
+	for i, subnet := range subnets {
+		assert.Equal(t, true, subnet < count, "Subnet %d out of range", subnet)
+		assert.Equal(t, (subnets[0]+uint64(i))%count, subnet)
+	}
+
+	// The subnets are kept until the subscription period of the node ends, which is offset by
+	// its node ID.
+	period := types.Epoch(params.BeaconNetworkConfig().EpochsPerSubnetSubscription)
+	kept, err := ComputeSubscribedSubnets(nodeID, period-16-1)
+	require.NoError(t, err)
+	assert.DeepEqual(t, subnets, kept)
+
+	// Other nodes compute the same subnets.
+	again, err := ComputeSubscribedSubnets(nodeID, 0)
+	require.NoError(t, err)
+	assert.DeepEqual(t, subnets, again)
+
+	// The subnets of the nodes rotate over the subscription periods.
+	rotated := false
+	for period := types.Epoch(1); period < 10; period++ {
+		next, err := ComputeSubscribedSubnets(nodeID, period*types.Epoch(params.BeaconNetworkConfig().EpochsPerSubnetSubscription))
+		require.NoError(t, err)
+		if next[0] != subnets[0] {
+			rotated = true
+			break
+		}
+	}
+	assert.Equal(t, true, rotated, "Subnets did not rotate")
+}
+
+func TestRefreshENR_NodeIDSubnets(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{
+		SubscribeToNodeIDSubnets: true,
+	})
+	defer func() {
+		flags.Init(resetFlags)
+	}()
+	defer cache.SubnetIDs.EmptyAllCaches()
+	// Subnets of validators are not advertised.
+	cache.SubnetIDs.AddPersistentCommittee([]byte{'A'}, []uint64{1, 2, 3, 23}, 0)
+
+	ipAddr, pkey := createAddrAndPrivKey(t)
+	s := &Service{
+		genesisTime:           time.Now(),
+		genesisValidatorsRoot: bytesutil.PadTo([]byte{'A'}, 32),
+		cfg:                   &Config{UDPPort: 2000},
+	}
+	listener, err := s.createListener(ipAddr, pkey)
+	require.NoError(t, err)
+	defer listener.Close()
+	s.dv5Listener = listener
+	s.metaData = wrapper.WrappedMetadataV0(new(pb.MetaDataV0))
+	s.RefreshENR()
+
+	subnets, err := ComputeSubscribedSubnets(listener.LocalNode().ID(), 0)
+	require.NoError(t, err)
+	wanted := bitfield.NewBitvector64()
+	for _, subnet := range subnets {
+		wanted.SetBitAt(subnet, true)
+	}
+	assert.DeepEqual(t, wanted, s.metaData.AttnetsBitfield())
+	advertised, err := attSubnets(listener.Self().Record())
+	require.NoError(t, err)
+	assert.Equal(t, true, containsSubnets(advertised, subnets))
+}
+
+func TestPredictedAttSubnets(t *testing.T) {
+	newNode := func(t *testing.T, subnets func(enode.ID) []uint64) *enode.Node {
+		db, err := enode.OpenDB("")
+		require.NoError(t, err)
+		priv, _, err := crypto.GenerateSecp256k1Key(rand.Reader)
+		require.NoError(t, err)
+		localNode := enode.NewLocalNode(db, convertFromInterfacePrivKey(priv))
+		bitV := bitfield.NewBitvector64()
+		for _, subnet := range subnets(localNode.ID()) {
+			bitV.SetBitAt(subnet, true)
+		}
+		localNode.Set(enr.WithEntry(attSubnetEnrKey, &bitV))
+		return localNode.Node()
+	}
+	// The current epoch is the first epoch of a subscription period of the node, which is offset
+	// by its node ID.
+	firstEpochOfPeriod := func(id enode.ID) types.Epoch {
+		epochsPerPeriod := types.Epoch(params.BeaconNetworkConfig().EpochsPerSubnetSubscription)
+		return 2*epochsPerPeriod - types.Epoch(id[31])%epochsPerPeriod
+	}
+
+	tests := []struct {
+		name    string
+		subnets func(id enode.ID) []uint64
+		ok      bool
+	}{
+		{
+			name:    "no subnets advertised",
+			subnets: func(enode.ID) []uint64 { return nil },
+			ok:      false,
+		},
+		{
+			name: "current subnets advertised",
+			subnets: func(id enode.ID) []uint64 {
+				subnets, err := ComputeSubscribedSubnets(id, firstEpochOfPeriod(id))
+				require.NoError(t, err)
+				return subnets
+			},
+			ok: true,
+		},
+		{
+			name: "previous subnets advertised",
+			subnets: func(id enode.ID) []uint64 {
+				subnets, err := ComputeSubscribedSubnets(id, firstEpochOfPeriod(id)-1)
+				require.NoError(t, err)
+				return subnets
+			},
+			ok: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			node := newNode(t, tt.subnets)
+			currEpoch := firstEpochOfPeriod(node.ID())
+			s := &Service{
+				genesisTime: time.Now().Add(-time.Duration(currEpoch)*oneEpochDuration() - oneEpochDuration()/2),
+			}
+			advertised, err := attSubnets(node.Record())
+			require.NoError(t, err)
+			predicted, ok := s.predictedAttSubnets(node, advertised)
+			require.Equal(t, tt.ok, ok)
+			if !ok {
+				return
+			}
+			wanted, err := ComputeSubscribedSubnets(node.ID(), currEpoch)
+			require.NoError(t, err)
+			assert.DeepEqual(t, wanted, predicted)
+		})
+	}
+}
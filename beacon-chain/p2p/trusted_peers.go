@@ -0,0 +1,125 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	manet "github.com/multiformats/go-multiaddr/net"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	// Interval at which the connections with the trusted peers are checked.
+	trustedPeersCheckInterval = 5 * time.Second
+	// Minimum and maximum back off before a trusted peer which could not be reached is dialed again.
+	trustedPeerMinBackOff = 5 * time.Second
+	trustedPeerMaxBackOff = 5 * time.Minute
+)
+
+// trustedPeer is a peer the node always keeps a connection with.
+type trustedPeer struct {
+	info     peer.AddrInfo
+	backOff  time.Duration
+	nextDial time.Time
+}
+
+// trustedPeersFromAddrs parses the addresses of the trusted peers.
+func trustedPeersFromAddrs(addrs []string) ([]*trustedPeer, error) {
+	if len(addrs) == 0 {
+		return nil, nil
+	}
+	multiAddrs, err := peersFromStringAddrs(addrs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not parse trusted peer address")
+	}
+	if len(multiAddrs) != len(addrs) {
+		return nil, errors.New("invalid trusted peer address")
+	}
+	infos, err := peer.AddrInfosFromP2pAddrs(multiAddrs...)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get trusted peer info")
+	}
+	trusted := make([]*trustedPeer, len(infos))
+	for i, info := range infos {
+		trusted[i] = &trustedPeer{info: info}
+	}
+	return trusted, nil
+}
+
+// trustedPeerIDs returns the IDs of the trusted peers.
+func (s *Service) trustedPeerIDs() []peer.ID {
+	pids := make([]peer.ID, len(s.trustedPeers))
+	for i, tp := range s.trustedPeers {
+		pids[i] = tp.info.ID
+	}
+	return pids
+}
+
+// isTrustedAddr returns true if the address has the IP address of one of the trusted peers.
+func (s *Service) isTrustedAddr(addr ma.Multiaddr) bool {
+	ip, err := manet.ToIP(addr)
+	if err != nil {
+		return false
+	}
+	for _, tp := range s.trustedPeers {
+		for _, trustedAddr := range tp.info.Addrs {
+			trustedIP, err := manet.ToIP(trustedAddr)
+			if err == nil && trustedIP.Equal(ip) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// maintainTrustedPeers keeps the node connected with the trusted peers until the service is stopped.
+func (s *Service) maintainTrustedPeers() {
+	if len(s.trustedPeers) == 0 {
+		return
+	}
+	ticker := time.NewTicker(trustedPeersCheckInterval)
+	defer ticker.Stop()
+	for {
+		s.connectWithTrustedPeers()
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// connectWithTrustedPeers dials the trusted peers which are not connected. Trusted peers which
+// cannot be reached are dialed again with an exponential back off.
+func (s *Service) connectWithTrustedPeers() {
+	for _, tp := range s.trustedPeers {
+		if s.host.Network().Connectedness(tp.info.ID) == network.Connected {
+			tp.backOff = 0
+			continue
+		}
+		if timeutils.Now().Before(tp.nextDial) {
+			continue
+		}
+		if err := connectWithTimeout(s.ctx, s.host, &tp.info); err != nil {
+			tp.backOff *= 2
+			if tp.backOff < trustedPeerMinBackOff {
+				tp.backOff = trustedPeerMinBackOff
+			}
+			if tp.backOff > trustedPeerMaxBackOff {
+				tp.backOff = trustedPeerMaxBackOff
+			}
+			tp.nextDial = timeutils.Now().Add(tp.backOff)
+			log.WithError(err).WithFields(logrus.Fields{
+				"peer":    tp.info.ID,
+				"backOff": tp.backOff,
+			}).Debug("Could not connect with trusted peer")
+			continue
+		}
+		tp.backOff = 0
+		log.WithField("peer", tp.info.ID).Debug("Connected with trusted peer")
+	}
+}
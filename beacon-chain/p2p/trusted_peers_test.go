@@ -0,0 +1,62 @@
+package p2p
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_TrustedPeers(t *testing.T) {
+	h1, _, _ := createHost(t, 34621)
+	h2, _, _ := createHost(t, 34622)
+	defer func() {
+		require.NoError(t, h1.Close())
+		require.NoError(t, h2.Close())
+	}()
+	unreachable, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+
+	cfg := &Config{
+		MaxPeers: 1,
+		TrustedPeers: []string{
+			fmt.Sprintf("%s/p2p/%s", h2.Addrs()[0], h2.ID()),
+			fmt.Sprintf("/ip4/127.0.0.1/tcp/34623/p2p/%s", unreachable),
+		},
+	}
+	s := newAccessListTestService(t, cfg)
+	s.ctx = context.Background()
+	s.host = h1
+	s.trustedPeers, err = trustedPeersFromAddrs(cfg.TrustedPeers)
+	require.NoError(t, err)
+	s.peers.SetTrustedPeers(s.trustedPeerIDs())
+
+	s.connectWithTrustedPeers()
+	assert.Equal(t, network.Connected, h1.Network().Connectedness(h2.ID()))
+	assert.Equal(t, trustedPeerMinBackOff, s.trustedPeers[1].backOff)
+	s.trustedPeers[1].nextDial = s.trustedPeers[1].nextDial.Add(-trustedPeerMinBackOff)
+	s.connectWithTrustedPeers()
+	assert.Equal(t, 2*trustedPeerMinBackOff, s.trustedPeers[1].backOff, "Back off was not increased")
+	assert.Equal(t, false, s.isPeerAtLimit(false /* inbound */), "Trusted peer counted towards the limit")
+
+	// Trusted peers are never down-scored.
+	for i := 0; i < 10; i++ {
+		s.peers.Scorers().BadResponsesScorer().Increment(unreachable)
+	}
+	assert.Equal(t, false, s.peers.IsBad(unreachable))
+
+	trustedAddr, err := ma.NewMultiaddr("/ip4/127.0.0.1/tcp/40000")
+	require.NoError(t, err)
+	otherAddr, err := ma.NewMultiaddr("/ip4/212.67.10.1/tcp/13000")
+	require.NoError(t, err)
+	assert.Equal(t, true, s.isTrustedAddr(trustedAddr))
+	assert.Equal(t, false, s.isTrustedAddr(otherAddr))
+
+	_, err = trustedPeersFromAddrs([]string{"/ip4/127.0.0.1/tcp/34623"})
+	assert.ErrorContains(t, "invalid trusted peer address", err)
+}
@@ -0,0 +1,104 @@
+package powchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync/atomic"
+
+	"github.com/pkg/errors"
+	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+	"github.com/sirupsen/logrus"
+)
+
+// DepositSnapshotFetcher retrieves the snapshot of the finalized deposit tree.
+type DepositSnapshotFetcher interface {
+	DepositSnapshot(ctx context.Context) (*protodb.DepositSnapshot, error)
+}
+
+// DepositSnapshot returns the snapshot of the finalized deposits. The snapshot ends with the last
+// eth1 block of which all the deposits are finalized, so that a node restoring its deposit trie
+// from the snapshot fetches the deposit logs from the next block onwards.
+func (s *Service) DepositSnapshot(ctx context.Context) (*protodb.DepositSnapshot, error) {
+	finalizedDeposits := s.cfg.DepositCache.FinalizedDeposits(ctx)
+	baseSnapshot := s.cfg.DepositCache.DepositSnapshot(ctx)
+	ctrs := s.cfg.DepositCache.AllDepositContainers(ctx)
+
+	count, blockHeight := uint64(0), uint64(0)
+	if baseSnapshot != nil {
+		count, blockHeight = baseSnapshot.DepositCount, baseSnapshot.ExecutionBlockHeight
+	}
+	for i, c := range ctrs {
+		if c.Index > finalizedDeposits.MerkleTrieIndex {
+			break
+		}
+		// The next deposit is in the same block, so this deposit does not end a block.
+		if i+1 < len(ctrs) && ctrs[i+1].Eth1BlockHeight == c.Eth1BlockHeight {
+			continue
+		}
+		count, blockHeight = uint64(c.Index)+1, c.Eth1BlockHeight
+	}
+	if count == 0 {
+		return nil, errors.New("no finalized deposits")
+	}
+	if baseSnapshot != nil && count == baseSnapshot.DepositCount {
+		return baseSnapshot, nil
+	}
+
+	finalized, err := finalizedDeposits.Deposits.FinalizedBranch(int(count))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get finalized branch of deposit trie")
+	}
+	depositTrie, err := trieutil.NewTrieFromFinalizedBranch(finalized, count, params.BeaconConfig().DepositContractTreeDepth)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not restore deposit trie from finalized branch")
+	}
+	blockHash, err := s.BlockHashByHeight(ctx, new(big.Int).SetUint64(blockHeight))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get eth1 block hash")
+	}
+	depositRoot := depositTrie.HashTreeRoot()
+	return &protodb.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          depositRoot[:],
+		DepositCount:         count,
+		ExecutionBlockHash:   blockHash.Bytes(),
+		ExecutionBlockHeight: blockHeight,
+	}, nil
+}
+
+// importDepositSnapshot restores the deposit trie from the deposit snapshot, so that only the
+// deposit logs after the snapshot are fetched. The snapshot is ignored once deposits have been
+// processed.
+func (s *Service) importDepositSnapshot(ctx context.Context, snapshot *protodb.DepositSnapshot) error {
+	if s.lastReceivedMerkleIndex >= 0 {
+		log.Info("Deposits have already been processed, ignoring deposit snapshot")
+		return nil
+	}
+	if !s.chainStartData.Chainstarted {
+		return errors.New("a genesis state is required to restore the deposits from a snapshot")
+	}
+	// The deposit cache verifies that the finalized branch matches the deposit root.
+	if err := s.cfg.DepositCache.InsertDepositSnapshot(ctx, snapshot); err != nil {
+		return err
+	}
+	depositTrie, err := trieutil.NewTrieFromFinalizedBranch(
+		snapshot.Finalized, snapshot.DepositCount, params.BeaconConfig().DepositContractTreeDepth,
+	)
+	if err != nil {
+		return errors.Wrap(err, "could not restore deposit trie from snapshot")
+	}
+	s.depositTrie = depositTrie
+	s.lastReceivedMerkleIndex = int64(snapshot.DepositCount) - 1
+	s.latestEth1Data.LastRequestedBlock = snapshot.ExecutionBlockHeight
+	atomic.StoreUint64(&s.latestDepositBlock, snapshot.ExecutionBlockHeight)
+	log.WithFields(logrus.Fields{
+		"deposits":    snapshot.DepositCount,
+		"eth1Block":   snapshot.ExecutionBlockHeight,
+		"eth1Hash":    fmt.Sprintf("%#x", snapshot.ExecutionBlockHash),
+		"depositRoot": fmt.Sprintf("%#x", snapshot.DepositRoot),
+	}).Info("Restored deposit trie from snapshot")
+	return s.savePowchainData(ctx)
+}
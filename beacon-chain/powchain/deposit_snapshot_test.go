@@ -0,0 +1,105 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/cache/depositcache"
+	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/trieutil"
+)
+
+func TestService_DepositSnapshot_ExportAndImport(t *testing.T) {
+	ctx := context.Background()
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	blocks := []uint64{10, 10, 11, 12, 12}
+	deposits := make([]*ethpb.Deposit, len(blocks))
+	roots := make([][]byte, len(blocks))
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{
+			Proof: [][]byte{{'p'}},
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				WithdrawalCredentials: make([]byte, 32),
+				Signature:             make([]byte, 96),
+			},
+		}
+		root, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = root[:]
+	}
+	fullTrie, err := trieutil.GenerateTrieFromItems(roots, depth)
+	require.NoError(t, err)
+
+	exportCache, err := depositcache.New()
+	require.NoError(t, err)
+	for i, d := range deposits {
+		require.NoError(t, exportCache.InsertDeposit(ctx, d, blocks[i], int64(i), [32]byte{}))
+	}
+	exporter := &Service{
+		cfg:         &Web3ServiceConfig{DepositCache: exportCache},
+		headerCache: newHeaderCache(),
+	}
+	header := &gethTypes.Header{Number: big.NewInt(11)}
+	require.NoError(t, exporter.headerCache.AddHeader(header))
+
+	_, err = exporter.DepositSnapshot(ctx)
+	assert.ErrorContains(t, "no finalized deposits", err)
+
+	// The last finalized deposit shares its block with a deposit which is not finalized yet,
+	// so the snapshot ends with the block before.
+	exportCache.InsertFinalizedDeposits(ctx, 3)
+	snapshot, err := exporter.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(3), snapshot.DepositCount)
+	assert.Equal(t, uint64(11), snapshot.ExecutionBlockHeight)
+	assert.DeepEqual(t, header.Hash().Bytes(), snapshot.ExecutionBlockHash)
+	snapshotTrie, err := trieutil.GenerateTrieFromItems(roots[:3], depth)
+	require.NoError(t, err)
+	wantedRoot := snapshotTrie.HashTreeRoot()
+	assert.DeepEqual(t, wantedRoot[:], snapshot.DepositRoot)
+
+	beaconDB := dbutil.SetupDB(t)
+	genState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveGenesisData(ctx, genState))
+	importCache, err := depositcache.New()
+	require.NoError(t, err)
+	importer, err := NewService(ctx, &Web3ServiceConfig{
+		BeaconDB:        beaconDB,
+		DepositCache:    importCache,
+		DepositSnapshot: snapshot,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), importer.lastReceivedMerkleIndex)
+	assert.Equal(t, uint64(11), importer.latestEth1Data.LastRequestedBlock)
+	assert.Equal(t, wantedRoot, importer.depositTrie.HashTreeRoot())
+
+	// The deposits after the snapshot extend the restored trie.
+	for i := 3; i < len(roots); i++ {
+		importer.depositTrie.Insert(roots[i], i)
+	}
+	assert.Equal(t, fullTrie.HashTreeRoot(), importer.depositTrie.HashTreeRoot())
+
+	// The snapshot is persisted along with the powchain data.
+	eth1Data, err := beaconDB.PowchainData(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, snapshot, eth1Data.DepositSnapshot)
+
+	// A node which restored its deposits from a snapshot serves the same snapshot.
+	exported, err := importer.DepositSnapshot(ctx)
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, snapshot, exported)
+
+	// The snapshot is ignored once deposits have been processed.
+	require.NoError(t, importer.importDepositSnapshot(ctx, snapshot))
+	assert.Equal(t, int64(2), importer.lastReceivedMerkleIndex)
+}
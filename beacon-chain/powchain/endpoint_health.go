@@ -0,0 +1,306 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prysmaticlabs/prysm/shared/httputils"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/sirupsen/logrus"
+)
+
+var (
+	endpointHealthyGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_healthy",
+		Help: "Boolean indicating whether the eth1 endpoint passed its latest health check: 0=false, 1=true.",
+	}, []string{"endpoint"})
+	endpointActiveGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_active",
+		Help: "Boolean indicating whether the eth1 endpoint is currently used: 0=false, 1=true.",
+	}, []string{"endpoint"})
+	endpointScoreGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_score",
+		Help: "The health score of the eth1 endpoint, between 0 and 1.",
+	}, []string{"endpoint"})
+	endpointBlocksBehindGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_blocks_behind",
+		Help: "The number of blocks the eth1 endpoint is behind the best configured endpoint.",
+	}, []string{"endpoint"})
+	endpointLatencyGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "powchain_endpoint_latency_seconds",
+		Help: "The response latency of the eth1 endpoint in its latest health check.",
+	}, []string{"endpoint"})
+)
+
+var (
+	// interval at which the health of the eth1 endpoints is checked.
+	endpointHealthCheckInterval = 30 * time.Second
+	// time after which a health check of an endpoint is aborted.
+	endpointHealthCheckTimeout = 10 * time.Second
+	// number of blocks an endpoint can be behind the best endpoint before it is considered unhealthy.
+	endpointMaxBlocksBehind = uint64(8)
+	// latency at which an endpoint gets the lowest latency score.
+	endpointMaxLatency = 2 * time.Second
+	// score difference within which the endpoints are preferred in the order they are configured in.
+	endpointScoreMargin = 0.1
+	// error when the eth1 endpoint reports another chain id.
+	errWrongChainID = errors.New("eth1 endpoint using incorrect chain id")
+	// error when the eth1 endpoint returns no logs for a block with deposits.
+	errMissingDepositLogs = errors.New("eth1 endpoint is missing deposit contract logs")
+	// error when the eth1 endpoint is too far behind the other endpoints.
+	errBehindEndpoints = errors.Errorf("eth1 endpoint is more than %d blocks behind the best endpoint", endpointMaxBlocksBehind)
+)
+
+// EndpointHealthFetcher retrieves the health of the configured eth1 endpoints.
+type EndpointHealthFetcher interface {
+	EndpointHealth() []*EndpointHealth
+}
+
+// EndpointHealth is the result of the latest health check of an eth1 endpoint.
+type EndpointHealth struct {
+	// Endpoint is the address of the endpoint, with its credentials masked.
+	Endpoint             string
+	Active               bool
+	Healthy              bool
+	Score                float64
+	ChainID              uint64
+	HeadBlock            uint64
+	HeadTime             uint64
+	BlocksBehind         uint64
+	Latency              time.Duration
+	DepositLogsAvailable bool
+	Err                  error
+	LastChecked          time.Time
+}
+
+// endpointHealthStore keeps the latest health check of each eth1 endpoint, by url.
+type endpointHealthStore struct {
+	lock   sync.RWMutex
+	health map[string]*EndpointHealth
+}
+
+// EndpointHealth returns the health of the configured eth1 endpoints, in the order they are configured
+// in. Endpoints which have not been checked yet are reported as unhealthy.
+func (s *Service) EndpointHealth() []*EndpointHealth {
+	s.endpointHealth.lock.RLock()
+	defer s.endpointHealth.lock.RUnlock()
+	health := make([]*EndpointHealth, 0, len(s.httpEndpoints))
+	for _, endpoint := range s.httpEndpoints {
+		h := &EndpointHealth{
+			Endpoint: logutil.MaskCredentialsLogging(endpoint.Url),
+			Err:      errors.New("eth1 endpoint has not been checked yet"),
+		}
+		if checked, ok := s.endpointHealth.health[endpoint.Url]; ok {
+			copied := *checked
+			h = &copied
+		}
+		h.Active = endpoint.Equals(s.currHttpEndpoint)
+		health = append(health, h)
+	}
+	return health
+}
+
+// monitorEndpointHealth checks the health of the eth1 endpoints periodically.
+func (s *Service) monitorEndpointHealth() {
+	s.checkEndpointsHealth()
+	runutil.RunEvery(s.ctx, endpointHealthCheckInterval, s.checkEndpointsHealth)
+}
+
+// checkEndpointsHealth checks all the eth1 endpoints concurrently and scores them against each other.
+func (s *Service) checkEndpointsHealth() {
+	endpoints := make([]httputils.Endpoint, len(s.httpEndpoints))
+	copy(endpoints, s.httpEndpoints)
+	health := make([]*EndpointHealth, len(endpoints))
+	var wg sync.WaitGroup
+	for i, endpoint := range endpoints {
+		wg.Add(1)
+		go func(i int, endpoint httputils.Endpoint) {
+			defer wg.Done()
+			health[i] = s.checkEndpointHealth(s.ctx, endpoint)
+		}(i, endpoint)
+	}
+	wg.Wait()
+	scoreEndpoints(health)
+
+	s.endpointHealth.lock.Lock()
+	for i, endpoint := range endpoints {
+		s.endpointHealth.health[endpoint.Url] = health[i]
+	}
+	s.endpointHealth.lock.Unlock()
+
+	for _, h := range s.EndpointHealth() {
+		updateEndpointMetrics(h)
+		if !h.Healthy {
+			log.WithError(h.Err).WithField("endpoint", h.Endpoint).Debug("Eth1 endpoint is unhealthy")
+		}
+	}
+}
+
+// checkEndpointHealth dials the endpoint and checks its chain id, sync status, head block, response
+// latency and whether it returns the logs of the deposit contract.
+func (s *Service) checkEndpointHealth(ctx context.Context, endpoint httputils.Endpoint) *EndpointHealth {
+	ctx, cancel := context.WithTimeout(ctx, endpointHealthCheckTimeout)
+	defer cancel()
+	h := &EndpointHealth{
+		Endpoint:    logutil.MaskCredentialsLogging(endpoint.Url),
+		LastChecked: timeutils.Now(),
+	}
+	rpcClient, err := newRPCClient(ctx, endpoint)
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not dial eth1 endpoint")
+		return h
+	}
+	defer rpcClient.Close()
+	client := ethclient.NewClient(rpcClient)
+
+	start := timeutils.Now()
+	head, err := client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not get latest header")
+		return h
+	}
+	h.Latency = timeutils.Now().Sub(start)
+	h.HeadBlock = head.Number.Uint64()
+	h.HeadTime = head.Time
+
+	chainID, err := client.ChainID(ctx)
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not get chain id")
+		return h
+	}
+	h.ChainID = chainID.Uint64()
+
+	logsErr := s.checkDepositLogs(ctx, client, h.HeadBlock)
+	h.DepositLogsAvailable = logsErr == nil
+
+	syncProg, err := client.SyncProgress(ctx)
+	switch {
+	case err != nil:
+		h.Err = errors.Wrap(err, "could not get sync status")
+	case h.ChainID != params.BeaconConfig().DepositChainID:
+		h.Err = errors.Wrapf(errWrongChainID, "%d != %d", h.ChainID, params.BeaconConfig().DepositChainID)
+	case syncProg != nil:
+		h.Err = errNotSynced
+	case eth1HeadIsBehind(h.HeadTime):
+		h.Err = errFarBehind
+	case logsErr != nil:
+		h.Err = logsErr
+	}
+	return h
+}
+
+// checkDepositLogs checks that the endpoint returns the deposit contract logs of the block
+// with the latest processed deposit. If no deposit has been processed yet, it only checks that
+// the endpoint serves logs of the deposit contract.
+func (s *Service) checkDepositLogs(ctx context.Context, client *ethclient.Client, headBlock uint64) error {
+	block := atomic.LoadUint64(&s.latestDepositBlock)
+	expectLogs := block != 0
+	if !expectLogs {
+		block = headBlock
+	}
+	logs, err := client.FilterLogs(ctx, ethereum.FilterQuery{
+		Addresses: []common.Address{s.cfg.DepositContract},
+		FromBlock: new(big.Int).SetUint64(block),
+		ToBlock:   new(big.Int).SetUint64(block),
+	})
+	if err != nil {
+		return errors.Wrap(err, "could not get deposit contract logs")
+	}
+	if expectLogs && len(logs) == 0 {
+		return errors.Wrapf(errMissingDepositLogs, "no logs in block %d", block)
+	}
+	return nil
+}
+
+// scoreEndpoints scores the endpoints between 0 and 1, based on how far behind the best endpoint they
+// are and on their latency. Endpoints which failed their health check get a score of 0.
+func scoreEndpoints(health []*EndpointHealth) {
+	bestHead := uint64(0)
+	for _, h := range health {
+		if h.Err == nil && h.HeadBlock > bestHead {
+			bestHead = h.HeadBlock
+		}
+	}
+	for _, h := range health {
+		h.BlocksBehind = 0
+		if h.HeadBlock < bestHead {
+			h.BlocksBehind = bestHead - h.HeadBlock
+		}
+		if h.Err == nil && h.BlocksBehind > endpointMaxBlocksBehind {
+			h.Err = errBehindEndpoints
+		}
+		h.Healthy = h.Err == nil
+		if !h.Healthy {
+			h.Score = 0
+			continue
+		}
+		latency := h.Latency
+		if latency > endpointMaxLatency {
+			latency = endpointMaxLatency
+		}
+		h.Score = 1 -
+			0.5*float64(h.BlocksBehind)/float64(endpointMaxBlocksBehind+1) -
+			0.25*float64(latency)/float64(endpointMaxLatency)
+	}
+}
+
+// bestEndpoint returns the endpoint the node should use, which is the first configured endpoint
+// whose score is within the margin of the best score. It returns false if no endpoint is healthy.
+func (s *Service) bestEndpoint() (httputils.Endpoint, bool) {
+	health := s.EndpointHealth()
+	bestScore := 0.0
+	for _, h := range health {
+		if h.Healthy && h.Score > bestScore {
+			bestScore = h.Score
+		}
+	}
+	for i, h := range health {
+		if h.Healthy && h.Score >= bestScore-endpointScoreMargin {
+			return s.httpEndpoints[i], true
+		}
+	}
+	return httputils.Endpoint{}, false
+}
+
+// switchToBestEndpoint reconnects the service to the best endpoint, if it is not the current one.
+func (s *Service) switchToBestEndpoint() {
+	best, ok := s.bestEndpoint()
+	if !ok || best.Equals(s.currHttpEndpoint) {
+		return
+	}
+	log.WithFields(logrus.Fields{
+		"from": logutil.MaskCredentialsLogging(s.currHttpEndpoint.Url),
+		"to":   logutil.MaskCredentialsLogging(best.Url),
+	}).Info("Switching to healthier eth1 endpoint")
+	// Close current active clients and let our main connection routine
+	// properly connect with the new endpoint.
+	s.closeClients()
+	s.updateCurrHttpEndpoint(best)
+	s.retryETH1Node(nil)
+}
+
+func updateEndpointMetrics(h *EndpointHealth) {
+	boolToFloat := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	endpointHealthyGauge.WithLabelValues(h.Endpoint).Set(boolToFloat(h.Healthy))
+	endpointActiveGauge.WithLabelValues(h.Endpoint).Set(boolToFloat(h.Active))
+	endpointScoreGauge.WithLabelValues(h.Endpoint).Set(h.Score)
+	endpointBlocksBehindGauge.WithLabelValues(h.Endpoint).Set(float64(h.BlocksBehind))
+	endpointLatencyGauge.WithLabelValues(h.Endpoint).Set(h.Latency.Seconds())
+}
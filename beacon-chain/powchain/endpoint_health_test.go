@@ -0,0 +1,137 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/shared/httputils"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+)
+
+// testEth1Endpoint serves the eth namespace methods used by the endpoint health checks.
+type testEth1Endpoint struct {
+	chainID uint64
+	head    *gethTypes.Header
+	logs    []gethTypes.Log
+}
+
+func (e *testEth1Endpoint) ChainId() *hexutil.Big {
+	return (*hexutil.Big)(new(big.Int).SetUint64(e.chainID))
+}
+
+func (e *testEth1Endpoint) Syncing() bool {
+	return false
+}
+
+func (e *testEth1Endpoint) GetBlockByNumber(_ string, _ bool) (*gethTypes.Header, error) {
+	return e.head, nil
+}
+
+func (e *testEth1Endpoint) GetLogs(_ map[string]interface{}) ([]gethTypes.Log, error) {
+	return e.logs, nil
+}
+
+func newTestEth1Endpoint(t *testing.T, e *testEth1Endpoint) httputils.Endpoint {
+	server := gethRPC.NewServer()
+	require.NoError(t, server.RegisterName("eth", e))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httputils.Endpoint{Url: httpServer.URL}
+}
+
+func TestService_EndpointHealth(t *testing.T) {
+	header := func(number uint64) *gethTypes.Header {
+		return &gethTypes.Header{
+			Number:     new(big.Int).SetUint64(number),
+			Difficulty: big.NewInt(1),
+			Time:       uint64(timeutils.Now().Unix()),
+		}
+	}
+	chainID := params.BeaconConfig().DepositChainID
+	depositLogs := []gethTypes.Log{{Topics: []common.Hash{}, BlockNumber: 90}}
+	lagging := newTestEth1Endpoint(t, &testEth1Endpoint{chainID: chainID, head: header(80), logs: depositLogs})
+	wrongChain := newTestEth1Endpoint(t, &testEth1Endpoint{chainID: chainID + 1, head: header(100), logs: depositLogs})
+	missingLogs := newTestEth1Endpoint(t, &testEth1Endpoint{chainID: chainID, head: header(100)})
+	good := newTestEth1Endpoint(t, &testEth1Endpoint{chainID: chainID, head: header(100), logs: depositLogs})
+
+	s := &Service{
+		ctx:                context.Background(),
+		cfg:                &Web3ServiceConfig{},
+		httpEndpoints:      []httputils.Endpoint{lagging, wrongChain, missingLogs, good},
+		currHttpEndpoint:   lagging,
+		endpointHealth:     endpointHealthStore{health: make(map[string]*EndpointHealth)},
+		latestDepositBlock: 90,
+	}
+	health := s.EndpointHealth()
+	require.Equal(t, 4, len(health))
+	assert.ErrorContains(t, "has not been checked yet", health[0].Err)
+
+	s.checkEndpointsHealth()
+	health = s.EndpointHealth()
+	require.Equal(t, 4, len(health))
+
+	assert.Equal(t, true, health[0].Active)
+	assert.Equal(t, false, health[0].Healthy)
+	assert.Equal(t, uint64(20), health[0].BlocksBehind)
+	assert.ErrorContains(t, errBehindEndpoints.Error(), health[0].Err)
+
+	assert.Equal(t, false, health[1].Healthy)
+	assert.Equal(t, chainID+1, health[1].ChainID)
+	assert.ErrorContains(t, errWrongChainID.Error(), health[1].Err)
+
+	assert.Equal(t, false, health[2].Healthy)
+	assert.Equal(t, false, health[2].DepositLogsAvailable)
+	assert.ErrorContains(t, errMissingDepositLogs.Error(), health[2].Err)
+
+	assert.Equal(t, true, health[3].Healthy)
+	assert.Equal(t, false, health[3].Active)
+	assert.Equal(t, true, health[3].DepositLogsAvailable)
+	assert.Equal(t, uint64(100), health[3].HeadBlock)
+	assert.Equal(t, true, health[3].Score > 0.5, "Unexpected score %f", health[3].Score)
+
+	best, ok := s.bestEndpoint()
+	require.Equal(t, true, ok)
+	assert.Equal(t, good.Url, best.Url)
+}
+
+func TestService_BestEndpoint(t *testing.T) {
+	s := &Service{
+		httpEndpoints:  []httputils.Endpoint{{Url: "A"}, {Url: "B"}, {Url: "C"}},
+		endpointHealth: endpointHealthStore{health: make(map[string]*EndpointHealth)},
+	}
+	_, ok := s.bestEndpoint()
+	assert.Equal(t, false, ok, "Unchecked endpoints were selected")
+
+	health := []*EndpointHealth{
+		{HeadBlock: 100, Latency: time.Second},
+		{HeadBlock: 100, Latency: 500 * time.Millisecond},
+		{HeadBlock: 100, Latency: 10 * time.Millisecond},
+	}
+	scoreEndpoints(health)
+	for i, endpoint := range s.httpEndpoints {
+		s.endpointHealth.health[endpoint.Url] = health[i]
+	}
+	// The second endpoint is within the margin of the best score, so it is preferred to the third one.
+	best, ok := s.bestEndpoint()
+	require.Equal(t, true, ok)
+	assert.Equal(t, "B", best.Url)
+
+	health[2].HeadBlock = 104
+	scoreEndpoints(health)
+	best, ok = s.bestEndpoint()
+	require.Equal(t, true, ok)
+	assert.Equal(t, "C", best.Url)
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"fmt"
 	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -57,9 +58,14 @@ func (s *Service) ProcessETH1Block(ctx context.Context, blkNum *big.Int) error {
 		FromBlock: blkNum,
 		ToBlock:   blkNum,
 	}
-	logs, err := s.httpLogger.FilterLogs(ctx, query)
-	if err != nil {
-		return err
+	// The logs received from the deposit logs subscription do not need to be queried.
+	logs, ok := s.subscribedLogs.take(blkNum.Uint64())
+	if !ok {
+		var err error
+		logs, err = s.httpLogger.FilterLogs(ctx, query)
+		if err != nil {
+			return err
+		}
 	}
 	for _, filterLog := range logs {
 		// ignore logs that are not of the required block number
@@ -119,6 +125,7 @@ func (s *Service) ProcessDepositLog(ctx context.Context, depositLog gethTypes.Lo
 		return errors.Errorf("received incorrect merkle index: wanted %d but got %d", s.lastReceivedMerkleIndex+1, index)
 	}
 	s.lastReceivedMerkleIndex = index
+	atomic.StoreUint64(&s.latestDepositBlock, depositLog.BlockNumber)
 
 	// We then decode the deposit input in order to create a deposit object
 	// we can store in our persistent DB.
@@ -499,6 +506,7 @@ func (s *Service) savePowchainData(ctx context.Context) error {
 		BeaconState:       pbState, // I promise not to mutate it!
 		Trie:              s.depositTrie.ToProto(),
 		DepositContainers: s.cfg.DepositCache.AllDepositContainers(ctx),
+		DepositSnapshot:   s.cfg.DepositCache.DepositSnapshot(ctx),
 	}
 	return s.cfg.BeaconDB.SavePowchainData(ctx, eth1Data)
 }
@@ -11,6 +11,7 @@ import (
 	"runtime/debug"
 	"sort"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ethereum/go-ethereum"
@@ -127,6 +128,7 @@ type RPCClient interface {
 // Validator Registration Contract on the ETH1.0 chain to kick off the beacon
 // chain's validator registration process.
 type Service struct {
+	latestDepositBlock      uint64 // Accessed atomically, block of the latest processed deposit.
 	connectedETH1           bool
 	isRunning               bool
 	processingLock          sync.RWMutex
@@ -136,9 +138,11 @@ type Service struct {
 	headTicker              *time.Ticker
 	httpEndpoints           []httputils.Endpoint
 	currHttpEndpoint        httputils.Endpoint
+	endpointHealth          endpointHealthStore
 	httpLogger              bind.ContractFilterer
 	eth1DataFetcher         RPCDataFetcher
 	rpcClient               RPCClient
+	subscribedLogs          subscribedLogs
 	headerCache             *headerCache // cache to store block hash/block height.
 	latestEth1Data          *protodb.LatestETH1Data
 	depositContractCaller   *contracts.DepositContractCaller
@@ -160,6 +164,7 @@ type Web3ServiceConfig struct {
 	StateGen               *stategen.State
 	Eth1HeaderReqLimit     uint64
 	BeaconNodeStatsUpdater BeaconNodeStatsUpdater
+	DepositSnapshot        *protodb.DepositSnapshot // Snapshot to restore the deposit trie from, if no deposits are processed yet.
 }
 
 // NewService sets up a new instance with an ethclient when
@@ -198,6 +203,9 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 		cfg:              config,
 		httpEndpoints:    endpoints,
 		currHttpEndpoint: currEndpoint,
+		endpointHealth: endpointHealthStore{
+			health: make(map[string]*EndpointHealth),
+		},
 		latestEth1Data: &protodb.LatestETH1Data{
 			BlockHeight:        0,
 			BlockTime:          0,
@@ -232,6 +240,11 @@ func NewService(ctx context.Context, config *Web3ServiceConfig) (*Service, error
 	if err := s.initializeEth1Data(ctx, eth1Data); err != nil {
 		return nil, err
 	}
+	if config.DepositSnapshot != nil {
+		if err := s.importDepositSnapshot(ctx, config.DepositSnapshot); err != nil {
+			return nil, errors.Wrap(err, "could not import deposit snapshot")
+		}
+	}
 
 	return s, nil
 }
@@ -256,6 +269,7 @@ func (s *Service) Start() {
 	if s.currHttpEndpoint.Url == "" {
 		return
 	}
+	go s.monitorEndpointHealth()
 	go func() {
 		s.isRunning = true
 		s.waitForConnection()
@@ -328,7 +342,9 @@ func (s *Service) updateBeaconNodeStats() {
 }
 
 func (s *Service) updateCurrHttpEndpoint(endpoint httputils.Endpoint) {
+	s.endpointHealth.lock.Lock()
 	s.currHttpEndpoint = endpoint
+	s.endpointHealth.lock.Unlock()
 	s.updateBeaconNodeStats()
 }
 
@@ -410,17 +426,27 @@ func (s *Service) connectToPowChain() error {
 	return nil
 }
 
-func (s *Service) dialETH1Nodes(endpoint httputils.Endpoint) (*ethclient.Client, *gethRPC.Client, error) {
-	httpRPCClient, err := gethRPC.Dial(endpoint.Url)
+// newRPCClient dials the endpoint and sets its authorization header, if any.
+func newRPCClient(ctx context.Context, endpoint httputils.Endpoint) (*gethRPC.Client, error) {
+	client, err := gethRPC.DialContext(ctx, endpoint.Url)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 	if endpoint.Auth.Method != authorizationmethod.None {
 		header, err := endpoint.Auth.ToHeaderValue()
 		if err != nil {
-			return nil, nil, err
+			client.Close()
+			return nil, err
 		}
-		httpRPCClient.SetHeader("Authorization", header)
+		client.SetHeader("Authorization", header)
+	}
+	return client, nil
+}
+
+func (s *Service) dialETH1Nodes(endpoint httputils.Endpoint) (*ethclient.Client, *gethRPC.Client, error) {
+	httpRPCClient, err := newRPCClient(s.ctx, endpoint)
+	if err != nil {
+		return nil, nil, err
 	}
 	httpClient := ethclient.NewClient(httpRPCClient)
 	// Add a method to clean-up and close clients in the event
@@ -588,6 +614,7 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 		return nil
 	}
 	s.cfg.DepositCache.InsertDepositContainers(ctx, ctrs)
+	atomic.StoreUint64(&s.latestDepositBlock, ctrs[len(ctrs)-1].Eth1BlockHeight)
 	if !s.chainStartData.Chainstarted {
 		// do not add to pending cache
 		// if no genesis state exists.
@@ -618,12 +645,13 @@ func (s *Service) initDepositCaches(ctx context.Context, ctrs []*protodb.Deposit
 		currIndex = fState.Eth1DepositIndex()
 	}
 	validDepositsCount.Add(float64(currIndex))
-	// Only add pending deposits if the container slice length
-	// is more than the current index in state.
-	if uint64(len(ctrs)) > currIndex {
-		for _, c := range ctrs[currIndex:] {
-			s.cfg.DepositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
+	// Only add pending deposits with an index which is at least the current index in state.
+	// The containers do not start with index 0 when the deposits were restored from a snapshot.
+	for _, c := range ctrs {
+		if uint64(c.Index) < currIndex {
+			continue
 		}
+		s.cfg.DepositCache.InsertPendingDeposit(ctx, c.Deposit, c.Eth1BlockHeight, c.Index, bytesutil.ToBytes32(c.DepositRoot))
 	}
 	return nil
 }
@@ -795,15 +823,24 @@ func (s *Service) run(done <-chan struct{}) {
 	chainstartTicker := time.NewTicker(logPeriod)
 	defer chainstartTicker.Stop()
 
+	// Websocket and IPC endpoints notify new heads and deposit logs, so that the eth1 head is
+	// only polled when there are no active subscriptions.
+	sub := s.maintainSubscriptions(nil)
+
 	for {
 		select {
 		case <-done:
+			s.dropSubscriptions(sub)
 			s.isRunning = false
 			s.runError = nil
 			s.updateConnectedETH1(false)
 			log.Debug("Context closed, exiting goroutine")
 			return
 		case <-s.headTicker.C:
+			sub = s.maintainSubscriptions(sub)
+			if sub != nil && !eth1HeadIsBehind(s.latestEth1Data.BlockTime) {
+				continue
+			}
 			head, err := s.eth1DataFetcher.HeaderByNumber(s.ctx, nil)
 			if err != nil {
 				log.WithError(err).Debug("Could not fetch latest eth1 header")
@@ -817,7 +854,18 @@ func (s *Service) run(done <-chan struct{}) {
 			}
 			s.processBlockHeader(head)
 			s.handleETH1FollowDistance()
-			s.checkDefaultEndpoint()
+			s.switchToBestEndpoint()
+		case head := <-sub.newHeads():
+			s.processSubscribedHead(sub, head)
+			sub = s.maintainSubscriptions(sub)
+		case depositLog := <-sub.depositLogs():
+			s.subscribedLogs.add(depositLog)
+		case err := <-sub.headErr():
+			s.handleSubscriptionError(sub, err)
+			sub = nil
+		case err := <-sub.logErr():
+			s.handleSubscriptionError(sub, err)
+			sub = nil
 		case <-chainstartTicker.C:
 			if s.chainStartData.Chainstarted {
 				chainstartTicker.Stop()
@@ -904,36 +952,6 @@ func (s *Service) determineEarliestVotingBlock(ctx context.Context, followBlock
 	return hdr.Number.Uint64(), nil
 }
 
-// This performs a health check on our primary endpoint, and if it
-// is ready to serve we connect to it again. This method is only
-// relevant if we are on our backup endpoint.
-func (s *Service) checkDefaultEndpoint() {
-	primaryEndpoint := s.httpEndpoints[0]
-	// Return early if we are running on our primary
-	// endpoint.
-	if s.currHttpEndpoint.Equals(primaryEndpoint) {
-		return
-	}
-
-	httpClient, rpcClient, err := s.dialETH1Nodes(primaryEndpoint)
-	if err != nil {
-		log.Debugf("Primary endpoint not ready: %v", err)
-		return
-	}
-	log.Info("Primary endpoint ready again, switching back to it")
-	// Close the clients and let our main connection routine
-	// properly connect with it.
-	httpClient.Close()
-	rpcClient.Close()
-	// Close current active clients.
-	s.closeClients()
-
-	// Switch back to primary endpoint and try connecting
-	// to it again.
-	s.updateCurrHttpEndpoint(primaryEndpoint)
-	s.retryETH1Node(nil)
-}
-
 // This is an inefficient way to search for the next endpoint, but given N is expected to be
 // small ( < 25), it is fine to search this way.
 func (s *Service) fallbackToNextEndpoint() {
@@ -977,6 +995,11 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *protodb.
 	s.latestEth1Data = eth1DataInDB.CurrentEth1Data
 	numOfItems := s.depositTrie.NumOfItems()
 	s.lastReceivedMerkleIndex = int64(numOfItems - 1)
+	if eth1DataInDB.DepositSnapshot != nil {
+		if err := s.cfg.DepositCache.InsertDepositSnapshot(ctx, eth1DataInDB.DepositSnapshot); err != nil {
+			return errors.Wrap(err, "could not initialize deposit cache from snapshot")
+		}
+	}
 	if err := s.initDepositCaches(ctx, eth1DataInDB.DepositContainers); err != nil {
 		return errors.Wrap(err, "could not initialize caches")
 	}
@@ -984,8 +1007,8 @@ func (s *Service) initializeEth1Data(ctx context.Context, eth1DataInDB *protodb.
 }
 
 // validates that all deposit containers are valid and have their relevant indices
-// in order.
-func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer) bool {
+// in order, starting with the given index.
+func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer, startIndex int64) bool {
 	ctrLen := len(ctrs)
 	// Exit for empty containers.
 	if ctrLen == 0 {
@@ -995,7 +1018,6 @@ func (s *Service) validateDepositContainers(ctrs []*protodb.DepositContainer) bo
 	sort.Slice(ctrs, func(i, j int) bool {
 		return ctrs[i].Index < ctrs[j].Index
 	})
-	startIndex := int64(0)
 	for _, c := range ctrs {
 		if c.Index != startIndex {
 			log.Info("Recovering missing deposit containers, node is re-requesting missing deposit data")
@@ -1021,7 +1043,7 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 	if err != nil {
 		return errors.Wrap(err, "unable to retrieve eth1 data")
 	}
-	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !s.validateDepositContainers(eth1Data.DepositContainers) {
+	if eth1Data == nil || !eth1Data.ChainstartData.Chainstarted || !s.validateDepositContainers(eth1Data.DepositContainers, int64(eth1Data.DepositSnapshot.GetDepositCount())) {
 		pbState, err := v1.ProtobufBeaconState(s.preGenesisState.InnerStateUnsafe())
 		if err != nil {
 			return err
@@ -1039,6 +1061,7 @@ func (s *Service) ensureValidPowchainData(ctx context.Context) error {
 			BeaconState:       pbState,
 			Trie:              s.depositTrie.ToProto(),
 			DepositContainers: s.cfg.DepositCache.AllDepositContainers(ctx),
+			DepositSnapshot:   s.cfg.DepositCache.DepositSnapshot(ctx),
 		}
 		return s.cfg.BeaconDB.SavePowchainData(ctx, eth1Data)
 	}
@@ -0,0 +1,266 @@
+package powchain
+
+import (
+	"context"
+	"net/url"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/logutil"
+)
+
+// Buffer size of the new heads and deposit logs subscription channels.
+const subscriptionBufferSize = 64
+
+var errSubscriptionsUnsupported = errors.New("eth1 client does not support subscriptions")
+
+// eth1Subscriber subscribes to new eth1 heads and logs, which is supported by websocket and IPC
+// endpoints.
+type eth1Subscriber interface {
+	SubscribeNewHead(ctx context.Context, ch chan<- *gethTypes.Header) (ethereum.Subscription, error)
+	SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error)
+}
+
+// eth1Subscriptions are the new heads and deposit contract logs subscriptions to the current eth1
+// endpoint. They replace the polling of the eth1 head while they are active.
+type eth1Subscriptions struct {
+	fetcher RPCDataFetcher // Client the subscriptions were made with.
+	heads   chan *gethTypes.Header
+	logs    chan gethTypes.Log
+	headSub ethereum.Subscription
+	logSub  ethereum.Subscription
+}
+
+// newHeads returns the channel of new heads, which is nil when there are no subscriptions.
+func (sub *eth1Subscriptions) newHeads() <-chan *gethTypes.Header {
+	if sub == nil {
+		return nil
+	}
+	return sub.heads
+}
+
+// depositLogs returns the channel of deposit logs, which is nil when there are no subscriptions.
+func (sub *eth1Subscriptions) depositLogs() <-chan gethTypes.Log {
+	if sub == nil {
+		return nil
+	}
+	return sub.logs
+}
+
+// headErr returns the error channel of the new heads subscription.
+func (sub *eth1Subscriptions) headErr() <-chan error {
+	if sub == nil {
+		return nil
+	}
+	return sub.headSub.Err()
+}
+
+// logErr returns the error channel of the deposit logs subscription.
+func (sub *eth1Subscriptions) logErr() <-chan error {
+	if sub == nil {
+		return nil
+	}
+	return sub.logSub.Err()
+}
+
+func (sub *eth1Subscriptions) unsubscribe() {
+	if sub.headSub != nil {
+		sub.headSub.Unsubscribe()
+	}
+	if sub.logSub != nil {
+		sub.logSub.Unsubscribe()
+	}
+}
+
+// subscribedLogs keeps the deposit logs received from the logs subscription until their block
+// reaches the follow distance, so that these blocks do not need to be queried for logs.
+type subscribedLogs struct {
+	lock        sync.Mutex
+	active      bool
+	coveredFrom uint64 // First block of which all the logs are received.
+	logs        map[uint64][]gethTypes.Log
+}
+
+// activate starts keeping the received logs, which are complete from the given block onwards.
+func (l *subscribedLogs) activate(coveredFrom uint64) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.active = true
+	l.coveredFrom = coveredFrom
+	l.logs = make(map[uint64][]gethTypes.Log)
+}
+
+// deactivate drops the received logs, as their removal in a reorg can no longer be observed.
+func (l *subscribedLogs) deactivate() {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.active = false
+	l.logs = nil
+}
+
+// add keeps the received log, or drops it if the log was removed by a reorg.
+func (l *subscribedLogs) add(depositLog gethTypes.Log) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if !l.active {
+		return
+	}
+	blockLogs := l.logs[depositLog.BlockNumber]
+	if depositLog.Removed {
+		kept := make([]gethTypes.Log, 0, len(blockLogs))
+		for _, blockLog := range blockLogs {
+			if blockLog.TxHash == depositLog.TxHash && blockLog.Index == depositLog.Index {
+				continue
+			}
+			kept = append(kept, blockLog)
+		}
+		if len(kept) == 0 {
+			delete(l.logs, depositLog.BlockNumber)
+			return
+		}
+		l.logs[depositLog.BlockNumber] = kept
+		return
+	}
+	l.logs[depositLog.BlockNumber] = append(blockLogs, depositLog)
+}
+
+// take returns the received logs of the given block, and whether all the logs of the block were
+// received. The logs of the block and of the blocks before it are no longer kept.
+func (l *subscribedLogs) take(blkNum uint64) ([]gethTypes.Log, bool) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	if !l.active || blkNum < l.coveredFrom {
+		return nil, false
+	}
+	blockLogs := l.logs[blkNum]
+	for num := range l.logs {
+		if num <= blkNum {
+			delete(l.logs, num)
+		}
+	}
+	return blockLogs, true
+}
+
+// supportsSubscriptions returns whether the endpoint is a websocket endpoint or an IPC path,
+// which support eth_subscribe.
+func supportsSubscriptions(endpoint string) bool {
+	if endpoint == "" {
+		return false
+	}
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return false
+	}
+	switch u.Scheme {
+	case "ws", "wss", "":
+		return true
+	default:
+		return false
+	}
+}
+
+// subscribe subscribes to the new heads and deposit contract logs of the current eth1 endpoint.
+func (s *Service) subscribe() (*eth1Subscriptions, error) {
+	subscriber, ok := s.eth1DataFetcher.(eth1Subscriber)
+	if !ok {
+		return nil, errSubscriptionsUnsupported
+	}
+	sub := &eth1Subscriptions{
+		fetcher: s.eth1DataFetcher,
+		heads:   make(chan *gethTypes.Header, subscriptionBufferSize),
+		logs:    make(chan gethTypes.Log, subscriptionBufferSize),
+	}
+	query := ethereum.FilterQuery{
+		Addresses: []common.Address{
+			s.cfg.DepositContract,
+		},
+	}
+	var err error
+	sub.logSub, err = subscriber.SubscribeFilterLogs(s.ctx, query, sub.logs)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not subscribe to deposit logs")
+	}
+	sub.headSub, err = subscriber.SubscribeNewHead(s.ctx, sub.heads)
+	if err != nil {
+		sub.unsubscribe()
+		return nil, errors.Wrap(err, "could not subscribe to new heads")
+	}
+	// The logs of the blocks after the current head are received by the logs subscription.
+	head, err := s.eth1DataFetcher.HeaderByNumber(s.ctx, nil)
+	if err != nil {
+		sub.unsubscribe()
+		return nil, errors.Wrap(err, "could not fetch latest eth1 header")
+	}
+	s.subscribedLogs.activate(head.Number.Uint64() + 1)
+	return sub, nil
+}
+
+// maintainSubscriptions replaces the subscriptions made with a client which is no longer used,
+// and subscribes to the current endpoint if it supports subscriptions. It returns the active
+// subscriptions, or nil if the eth1 head has to be polled.
+func (s *Service) maintainSubscriptions(sub *eth1Subscriptions) *eth1Subscriptions {
+	if sub != nil {
+		if sub.fetcher == s.eth1DataFetcher {
+			return sub
+		}
+		s.dropSubscriptions(sub)
+	}
+	if s.eth1DataFetcher == nil || !supportsSubscriptions(s.currHttpEndpoint.Url) {
+		return nil
+	}
+	sub, err := s.subscribe()
+	if err != nil {
+		log.WithError(err).Debug("Could not subscribe to eth1 endpoint, polling instead")
+		return nil
+	}
+	log.WithField(
+		"endpoint", logutil.MaskCredentialsLogging(s.currHttpEndpoint.Url),
+	).Info("Subscribed to new eth1 heads and deposit logs")
+	return sub
+}
+
+// dropSubscriptions unsubscribes and stops using the logs received from the subscription.
+func (s *Service) dropSubscriptions(sub *eth1Subscriptions) {
+	if sub == nil {
+		return
+	}
+	sub.unsubscribe()
+	s.subscribedLogs.deactivate()
+}
+
+// handleSubscriptionError drops the subscriptions after one of them ended, so that the eth1 head
+// is polled until the next subscription attempt. A nil error is received when the client was closed.
+func (s *Service) handleSubscriptionError(sub *eth1Subscriptions, err error) {
+	s.dropSubscriptions(sub)
+	if err == nil {
+		log.Debug("Eth1 subscription closed")
+		return
+	}
+	log.WithField(
+		"endpoint", logutil.MaskCredentialsLogging(s.currHttpEndpoint.Url),
+	).WithError(err).Warn("Eth1 subscription dropped, falling back to polling")
+}
+
+// processSubscribedHead processes a new head received from the subscription. The pending deposit
+// logs are received first, so that they are available when the follow distance is handled.
+func (s *Service) processSubscribedHead(sub *eth1Subscriptions, head *gethTypes.Header) {
+drain:
+	for {
+		select {
+		case depositLog := <-sub.logs:
+			s.subscribedLogs.add(depositLog)
+		default:
+			break drain
+		}
+	}
+	if eth1HeadIsBehind(head.Time) {
+		log.WithError(errFarBehind).Debug("Received an outdated eth1 header")
+		return
+	}
+	s.processBlockHeader(head)
+	s.handleETH1FollowDistance()
+	s.switchToBestEndpoint()
+}
@@ -0,0 +1,175 @@
+package powchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	gethRPC "github.com/ethereum/go-ethereum/rpc"
+	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// testSubscriptionEndpoint serves the eth namespace subscriptions to new heads and logs.
+type testSubscriptionEndpoint struct {
+	head  *gethTypes.Header
+	heads chan *gethTypes.Header
+	logs  chan gethTypes.Log
+}
+
+func (e *testSubscriptionEndpoint) GetBlockByNumber(_ string, _ bool) (*gethTypes.Header, error) {
+	return e.head, nil
+}
+
+func (e *testSubscriptionEndpoint) NewHeads(ctx context.Context) (*gethRPC.Subscription, error) {
+	return notifyFrom(ctx, func(notifier *gethRPC.Notifier, sub *gethRPC.Subscription) {
+		for {
+			select {
+			case h := <-e.heads:
+				if err := notifier.Notify(sub.ID, h); err != nil {
+					return
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	})
+}
+
+func (e *testSubscriptionEndpoint) Logs(ctx context.Context, _ map[string]interface{}) (*gethRPC.Subscription, error) {
+	return notifyFrom(ctx, func(notifier *gethRPC.Notifier, sub *gethRPC.Subscription) {
+		for {
+			select {
+			case l := <-e.logs:
+				if err := notifier.Notify(sub.ID, l); err != nil {
+					return
+				}
+			case <-sub.Err():
+				return
+			}
+		}
+	})
+}
+
+func notifyFrom(ctx context.Context, notify func(*gethRPC.Notifier, *gethRPC.Subscription)) (*gethRPC.Subscription, error) {
+	notifier, ok := gethRPC.NotifierFromContext(ctx)
+	if !ok {
+		return nil, gethRPC.ErrNotificationsUnsupported
+	}
+	sub := notifier.CreateSubscription()
+	go notify(notifier, sub)
+	return sub, nil
+}
+
+func TestSupportsSubscriptions(t *testing.T) {
+	tests := []struct {
+		endpoint string
+		want     bool
+	}{
+		{endpoint: "", want: false},
+		{endpoint: "http://localhost:8545", want: false},
+		{endpoint: "https://goerli.infura.io/v3/xxxx", want: false},
+		{endpoint: "ws://localhost:8546", want: true},
+		{endpoint: "wss://goerli.infura.io/ws/v3/xxxx", want: true},
+		{endpoint: "/home/user/.ethereum/geth.ipc", want: true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, supportsSubscriptions(tt.endpoint), tt.endpoint)
+	}
+}
+
+func TestSubscribedLogs(t *testing.T) {
+	l := &subscribedLogs{}
+	l.add(gethTypes.Log{BlockNumber: 10})
+	_, ok := l.take(10)
+	assert.Equal(t, false, ok, "Logs were taken from inactive subscription")
+
+	l.activate(10)
+	_, ok = l.take(9)
+	assert.Equal(t, false, ok, "Logs were taken for a block before the subscription")
+
+	l.add(gethTypes.Log{BlockNumber: 10, TxHash: common.Hash{'a'}, Index: 0})
+	l.add(gethTypes.Log{BlockNumber: 10, TxHash: common.Hash{'a'}, Index: 1})
+	l.add(gethTypes.Log{BlockNumber: 11, TxHash: common.Hash{'b'}})
+	l.add(gethTypes.Log{BlockNumber: 10, TxHash: common.Hash{'a'}, Index: 0, Removed: true})
+	l.add(gethTypes.Log{BlockNumber: 11, TxHash: common.Hash{'b'}, Removed: true})
+
+	logs, ok := l.take(11)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 0, len(logs), "Removed log was kept")
+	logs, ok = l.take(10)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 0, len(logs), "Logs of earlier block were kept")
+
+	l.add(gethTypes.Log{BlockNumber: 12})
+	l.deactivate()
+	_, ok = l.take(12)
+	assert.Equal(t, false, ok, "Logs were taken from dropped subscription")
+}
+
+func TestService_Subscribe(t *testing.T) {
+	endpoint := &testSubscriptionEndpoint{
+		head:  &gethTypes.Header{Number: big.NewInt(100), Difficulty: big.NewInt(1)},
+		heads: make(chan *gethTypes.Header),
+		logs:  make(chan gethTypes.Log),
+	}
+	server := gethRPC.NewServer()
+	require.NoError(t, server.RegisterName("eth", endpoint))
+	defer server.Stop()
+	client := ethclient.NewClient(gethRPC.DialInProc(server))
+
+	s := &Service{
+		ctx:              context.Background(),
+		cfg:              &Web3ServiceConfig{DepositContract: common.Address{'d'}},
+		currHttpEndpoint: HttpEndpoint("/tmp/geth.ipc"),
+		eth1DataFetcher:  client,
+		chainStartData:   &protodb.ChainStartData{Chainstarted: true},
+	}
+	sub := s.maintainSubscriptions(nil)
+	require.NotNil(t, sub)
+	assert.Equal(t, sub, s.maintainSubscriptions(sub), "Active subscriptions were replaced")
+
+	endpoint.heads <- &gethTypes.Header{Number: big.NewInt(101), Difficulty: big.NewInt(1)}
+	select {
+	case head := <-sub.newHeads():
+		assert.Equal(t, uint64(101), head.Number.Uint64())
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive new head")
+	}
+	endpoint.logs <- gethTypes.Log{BlockNumber: 101, Topics: []common.Hash{depositEventSignature}}
+	select {
+	case depositLog := <-sub.depositLogs():
+		s.subscribedLogs.add(depositLog)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Did not receive deposit log")
+	}
+	_, ok := s.subscribedLogs.take(100)
+	assert.Equal(t, false, ok, "Logs of the head at subscription time should be queried")
+	logs, ok := s.subscribedLogs.take(101)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 1, len(logs))
+
+	// Blocks after the subscription are processed without querying the logs.
+	require.NoError(t, s.ProcessETH1Block(context.Background(), big.NewInt(102)))
+
+	// Closing the client ends the subscriptions.
+	client.Close()
+	select {
+	case err := <-sub.headErr():
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("Closed client did not end the subscription")
+	}
+
+	// A replaced client drops the subscriptions.
+	s.eth1DataFetcher = ethclient.NewClient(gethRPC.DialInProc(server))
+	s.currHttpEndpoint = HttpEndpoint("http://localhost:8545")
+	assert.Equal(t, (*eth1Subscriptions)(nil), s.maintainSubscriptions(sub))
+	_, ok = s.subscribedLogs.take(103)
+	assert.Equal(t, false, ok, "Logs of dropped subscription were taken")
+}
@@ -215,6 +215,14 @@ func receiveEvents(eventChan <-chan *sse.Event, w http.ResponseWriter, req *http
 				data = &eventFinalizedCheckpointJson{}
 			case events.ChainReorgTopic:
 				data = &eventChainReorgJson{}
+			case events.SyncCommitteeContributionTopic:
+				data = &signedContributionAndProofJson{}
+			case events.SyncCommitteeMessageTopic:
+				data = &syncCommitteeMessageJson{}
+			case events.LightClientFinalityUpdateTopic:
+				data = &lightClientFinalityUpdateJson{}
+			case events.LightClientOptimisticUpdateTopic:
+				data = &lightClientOptimisticUpdateJson{}
 			case "error":
 				data = &eventErrorJson{}
 			default:
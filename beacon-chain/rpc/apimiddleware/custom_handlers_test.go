@@ -225,6 +225,49 @@ data: {"block":"0x666f6f","state":"0x666f6f","epoch":"1"}
 `, w.Body.String())
 }
 
+func TestReceiveEvents_LightClientOptimisticUpdate(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan *sse.Event)
+	w := httptest.NewRecorder()
+	w.Body = &bytes.Buffer{}
+	req := httptest.NewRequest("GET", "http://foo.example", &bytes.Buffer{})
+	req = req.WithContext(ctx)
+
+	go func() {
+		base64Val := "Zm9v"
+		data := &lightClientOptimisticUpdateJson{
+			AttestedHeader: &beaconBlockHeaderJson{
+				Slot:          "1",
+				ProposerIndex: "2",
+				ParentRoot:    base64Val,
+				StateRoot:     base64Val,
+				BodyRoot:      base64Val,
+			},
+			SyncAggregate: &syncAggregateJson{
+				SyncCommitteeBits:      base64Val,
+				SyncCommitteeSignature: base64Val,
+			},
+			SignatureSlot: "2",
+		}
+		bData, err := json.Marshal(data)
+		require.NoError(t, err)
+		msg := &sse.Event{
+			Data:  bData,
+			Event: []byte(events.LightClientOptimisticUpdateTopic),
+		}
+		ch <- msg
+		time.Sleep(time.Second)
+		cancel()
+	}()
+
+	errJson := receiveEvents(ch, w, req)
+	assert.Equal(t, true, errJson == nil)
+	assert.Equal(t, `event: light_client_optimistic_update
+data: {"attested_header":{"slot":"1","proposer_index":"2","parent_root":"0x666f6f","state_root":"0x666f6f","body_root":"0x666f6f"},"sync_aggregate":{"sync_committee_bits":"0x666f6f","sync_committee_signature":"0x666f6f"},"signature_slot":"2"}
+
+`, w.Body.String())
+}
+
 func TestWriteEvent(t *testing.T) {
 	base64Val := "Zm9v"
 	data := &eventFinalizedCheckpointJson{
@@ -111,6 +111,16 @@ type voluntaryExitsPoolResponseJson struct {
 	Data []*signedVoluntaryExitJson `json:"data"`
 }
 
+// lightClientBootstrapResponseJson is used in /beacon/light_client/bootstrap/{block_root} API endpoint.
+type lightClientBootstrapResponseJson struct {
+	Data *lightClientBootstrapJson `json:"data"`
+}
+
+// lightClientUpdatesByRangeResponseJson is used in /beacon/light_client/updates API endpoint.
+type lightClientUpdatesByRangeResponseJson struct {
+	Data []*lightClientUpdateJson `json:"data"`
+}
+
 // identityResponseJson is used in /node/identity API endpoint.
 type identityResponseJson struct {
 	Data *identityJson `json:"data"`
@@ -505,6 +515,58 @@ func (ssz *beaconStateSSZResponseJson) SSZData() string {
 	return ssz.Data
 }
 
+type syncAggregateJson struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits" hex:"true"`
+	SyncCommitteeSignature string `json:"sync_committee_signature" hex:"true"`
+}
+
+type syncCommitteeMessageJson struct {
+	Slot            string `json:"slot"`
+	BeaconBlockRoot string `json:"beacon_block_root" hex:"true"`
+	ValidatorIndex  string `json:"validator_index"`
+	Signature       string `json:"signature" hex:"true"`
+}
+
+type signedContributionAndProofJson struct {
+	Message   *contributionAndProofJson `json:"message"`
+	Signature string                    `json:"signature" hex:"true"`
+}
+
+type contributionAndProofJson struct {
+	AggregatorIndex string                         `json:"aggregator_index"`
+	Contribution    *syncCommitteeContributionJson `json:"contribution"`
+	SelectionProof  string                         `json:"selection_proof" hex:"true"`
+}
+
+type syncCommitteeContributionJson struct {
+	Slot              string `json:"slot"`
+	BeaconBlockRoot   string `json:"beacon_block_root" hex:"true"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+	AggregationBits   string `json:"aggregation_bits" hex:"true"`
+	Signature         string `json:"signature" hex:"true"`
+}
+
+type syncCommitteeJson struct {
+	Pubkeys         []string `json:"pubkeys" hex:"true"`
+	AggregatePubkey string   `json:"aggregate_pubkey" hex:"true"`
+}
+
+type lightClientBootstrapJson struct {
+	Header                     *beaconBlockHeaderJson `json:"header"`
+	CurrentSyncCommittee       *syncCommitteeJson     `json:"current_sync_committee"`
+	CurrentSyncCommitteeBranch []string               `json:"current_sync_committee_branch" hex:"true"`
+}
+
+type lightClientUpdateJson struct {
+	AttestedHeader          *beaconBlockHeaderJson `json:"attested_header"`
+	NextSyncCommittee       *syncCommitteeJson     `json:"next_sync_committee"`
+	NextSyncCommitteeBranch []string               `json:"next_sync_committee_branch" hex:"true"`
+	FinalizedHeader         *beaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch          []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate           *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot           string                 `json:"signature_slot"`
+}
+
 // TODO: Documentation
 // ---------------
 // Events.
@@ -544,6 +606,20 @@ type eventChainReorgJson struct {
 	Epoch        string `json:"epoch"`
 }
 
+type lightClientFinalityUpdateJson struct {
+	AttestedHeader  *beaconBlockHeaderJson `json:"attested_header"`
+	FinalizedHeader *beaconBlockHeaderJson `json:"finalized_header"`
+	FinalityBranch  []string               `json:"finality_branch" hex:"true"`
+	SyncAggregate   *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot   string                 `json:"signature_slot"`
+}
+
+type lightClientOptimisticUpdateJson struct {
+	AttestedHeader *beaconBlockHeaderJson `json:"attested_header"`
+	SyncAggregate  *syncAggregateJson     `json:"sync_aggregate"`
+	SignatureSlot  string                 `json:"signature_slot"`
+}
+
 // ---------------
 // Error handling.
 // ---------------
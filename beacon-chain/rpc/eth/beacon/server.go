@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/statefetcher"
@@ -32,4 +33,5 @@ type Server struct {
 	StateGenService    stategen.StateManager
 	StateFetcher       statefetcher.Fetcher
 	HeadFetcher        blockchain.HeadFetcher
+	SyncCommitteePool  synccommittee.Pool
 }
@@ -7,6 +7,8 @@ import (
 	"github.com/golang/protobuf/ptypes/empty"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/proto/eth/v2"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -45,9 +47,41 @@ func (bs *Server) ListSyncCommittees(ctx context.Context, req *eth.StateSyncComm
 	}, nil
 }
 
-// SubmitSyncCommitteeSignature --
-func (bs *Server) SubmitSyncCommitteeSignature(_ context.Context, _ *eth.SyncCommitteeMessage) (*empty.Empty, error) {
-	return nil, status.Error(codes.Unimplemented, "Unimplemented")
+// SubmitSyncCommitteeSignature submits a sync committee message to the node. The message is
+// broadcast to the subnets of the sync subcommittees of the validator and saved in the pool.
+func (bs *Server) SubmitSyncCommitteeSignature(ctx context.Context, msg *eth.SyncCommitteeMessage) (*empty.Empty, error) {
+	if msg == nil {
+		return nil, status.Error(codes.InvalidArgument, "Nil sync committee message")
+	}
+	v1alpha1Msg := &ethpb.SyncCommitteeMessage{
+		Slot:           msg.Slot,
+		BlockRoot:      msg.BeaconBlockRoot,
+		ValidatorIndex: msg.ValidatorIndex,
+		Signature:      msg.Signature,
+	}
+
+	indices, err := altair.SyncSubcommitteeIndices(ctx, bs.HeadFetcher, msg.ValidatorIndex, msg.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync subcommittee indices: %v", err)
+	}
+	if len(indices) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Validator %d is not in the sync committee at slot %d", msg.ValidatorIndex, msg.Slot)
+	}
+	for _, subnet := range altair.SyncSubnets(indices) {
+		if err := bs.Broadcaster.BroadcastSyncCommitteeMessage(ctx, subnet, v1alpha1Msg); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not broadcast sync committee message: %v", err)
+		}
+	}
+	if err := bs.SyncCommitteePool.SaveSyncCommitteeMessage(v1alpha1Msg); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save sync committee message: %v", err)
+	}
+	bs.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: operation.SyncCommitteeMessageReceived,
+		Data: &operation.SyncCommitteeMessageReceivedData{
+			Message: v1alpha1Msg,
+		},
+	})
+	return &empty.Empty{}, nil
 }
 
 func currentCommitteeIndicesFromState(st state.BeaconState) ([]types.ValidatorIndex, *ethpb.SyncCommittee, error) {
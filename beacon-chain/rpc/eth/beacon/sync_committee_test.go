@@ -5,6 +5,11 @@ import (
 	"testing"
 
 	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/testutil"
 	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	ethpbalpha "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -140,3 +145,49 @@ func TestListSyncCommittees(t *testing.T) {
 		}
 	}
 }
+
+func TestSubmitSyncCommitteeSignature(t *testing.T) {
+	root := bytesutil.PadTo([]byte{'a'}, 32)
+	msg := &ethpbv2.SyncCommitteeMessage{
+		Slot:            1,
+		BeaconBlockRoot: root,
+		ValidatorIndex:  2,
+		Signature:       make([]byte, 96),
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		broadcaster := &mockp2p.MockBroadcaster{}
+		s := &Server{
+			HeadFetcher:       &mock.ChainService{CurrentSyncCommitteeIndices: []types.CommitteeIndex{1}},
+			Broadcaster:       broadcaster,
+			SyncCommitteePool: synccommittee.NewPool(),
+			OperationNotifier: (&mock.ChainService{}).OperationNotifier(),
+		}
+		opChannel := make(chan *feed.Event, 1)
+		opSub := s.OperationNotifier.OperationFeed().Subscribe(opChannel)
+		defer opSub.Unsubscribe()
+		_, err := s.SubmitSyncCommitteeSignature(context.Background(), msg)
+		require.NoError(t, err)
+		assert.Equal(t, true, broadcaster.BroadcastCalled)
+		saved, err := s.SyncCommitteePool.SyncCommitteeMessages(1)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(saved))
+		assert.DeepEqual(t, root, saved[0].BlockRoot)
+		assert.Equal(t, types.ValidatorIndex(2), saved[0].ValidatorIndex)
+
+		event := <-opChannel
+		assert.Equal(t, operation.SyncCommitteeMessageReceived, int(event.Type))
+		data, ok := event.Data.(*operation.SyncCommitteeMessageReceivedData)
+		require.Equal(t, true, ok)
+		assert.DeepEqual(t, saved[0], data.Message)
+	})
+	t.Run("validator not in committee", func(t *testing.T) {
+		s := &Server{
+			HeadFetcher:       &mock.ChainService{},
+			Broadcaster:       &mockp2p.MockBroadcaster{},
+			SyncCommitteePool: synccommittee.NewPool(),
+		}
+		_, err := s.SubmitSyncCommitteeSignature(context.Background(), msg)
+		require.ErrorContains(t, "is not in the sync committee", err)
+	})
+}
@@ -9,6 +9,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -29,20 +30,32 @@ const (
 	FinalizedCheckpointTopic = "finalized_checkpoint"
 	// ChainReorgTopic represents a chain reorganization event topic.
 	ChainReorgTopic = "chain_reorg"
+	// SyncCommitteeContributionTopic represents a new sync committee contribution event topic.
+	SyncCommitteeContributionTopic = "contribution_and_proof"
+	// SyncCommitteeMessageTopic represents a new sync committee message event topic.
+	SyncCommitteeMessageTopic = "sync_committee_message"
+	// LightClientFinalityUpdateTopic represents a new light client finality update event topic.
+	LightClientFinalityUpdateTopic = "light_client_finality_update"
+	// LightClientOptimisticUpdateTopic represents a new light client optimistic update event topic.
+	LightClientOptimisticUpdateTopic = "light_client_optimistic_update"
 )
 
 var casesHandled = map[string]bool{
-	HeadTopic:                true,
-	BlockTopic:               true,
-	AttestationTopic:         true,
-	VoluntaryExitTopic:       true,
-	FinalizedCheckpointTopic: true,
-	ChainReorgTopic:          true,
+	HeadTopic:                        true,
+	BlockTopic:                       true,
+	AttestationTopic:                 true,
+	VoluntaryExitTopic:               true,
+	FinalizedCheckpointTopic:         true,
+	ChainReorgTopic:                  true,
+	SyncCommitteeContributionTopic:   true,
+	SyncCommitteeMessageTopic:        true,
+	LightClientFinalityUpdateTopic:   true,
+	LightClientOptimisticUpdateTopic: true,
 }
 
 // StreamEvents allows requesting all events from a set of topics defined in the Ethereum consensus API standard.
 // The topics supported include block events, attestations, chain reorgs, voluntary exits,
-// chain finality, and more.
+// chain finality, sync committee messages, light client updates, and more.
 func (s *Server) StreamEvents(
 	req *ethpb.StreamEventsRequest, stream ethpbservice.Events_StreamEventsServer,
 ) error {
@@ -159,6 +172,26 @@ func (s *Server) handleBlockOperationEvents(
 		}
 		v1Data := migration.V1Alpha1ExitToV1(exitData.Exit)
 		return s.streamData(stream, VoluntaryExitTopic, v1Data)
+	case operation.SyncCommitteeContributionReceived:
+		if _, ok := requestedTopics[SyncCommitteeContributionTopic]; !ok {
+			return nil
+		}
+		contributionData, ok := event.Data.(*operation.SyncCommitteeContributionReceivedData)
+		if !ok {
+			return nil
+		}
+		v2Data := migration.V1Alpha1SignedContributionAndProofToV2(contributionData.Contribution)
+		return s.streamData(stream, SyncCommitteeContributionTopic, v2Data)
+	case operation.SyncCommitteeMessageReceived:
+		if _, ok := requestedTopics[SyncCommitteeMessageTopic]; !ok {
+			return nil
+		}
+		msgData, ok := event.Data.(*operation.SyncCommitteeMessageReceivedData)
+		if !ok {
+			return nil
+		}
+		v2Data := migration.V1Alpha1SyncCommitteeMessageToV2(msgData.Message)
+		return s.streamData(stream, SyncCommitteeMessageTopic, v2Data)
 	default:
 		return nil
 	}
@@ -195,6 +228,24 @@ func (s *Server) handleStateEvents(
 			return nil
 		}
 		return s.streamData(stream, ChainReorgTopic, reorg)
+	case statefeed.LightClientFinalityUpdate:
+		if _, ok := requestedTopics[LightClientFinalityUpdateTopic]; !ok {
+			return nil
+		}
+		update, ok := event.Data.(*ethpbv2.LightClientFinalityUpdate)
+		if !ok {
+			return nil
+		}
+		return s.streamData(stream, LightClientFinalityUpdateTopic, update)
+	case statefeed.LightClientOptimisticUpdate:
+		if _, ok := requestedTopics[LightClientOptimisticUpdateTopic]; !ok {
+			return nil
+		}
+		update, ok := event.Data.(*ethpbv2.LightClientOptimisticUpdate)
+		if !ok {
+			return nil
+		}
+		return s.streamData(stream, LightClientOptimisticUpdateTopic, update)
 	default:
 		return nil
 	}
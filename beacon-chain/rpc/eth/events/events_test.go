@@ -6,12 +6,14 @@ import (
 
 	"github.com/golang/mock/gomock"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/proto/gateway"
+	"github.com/prysmaticlabs/go-bitfield"
 	mockChain "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
 	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	ethpb "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
 	"github.com/prysmaticlabs/prysm/proto/migration"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
@@ -185,6 +187,84 @@ func TestStreamEvents_OperationsEvents(t *testing.T) {
 			feed: srv.OperationNotifier.OperationFeed(),
 		})
 	})
+	t.Run(SyncCommitteeContributionTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedContributionV1alpha1 := &eth.SignedContributionAndProof{
+			Message: &eth.ContributionAndProof{
+				AggregatorIndex: 1,
+				Contribution: &eth.SyncCommitteeContribution{
+					Slot:              1,
+					BlockRoot:         make([]byte, 32),
+					SubcommitteeIndex: 1,
+					AggregationBits:   bitfield.NewBitvector128(),
+					Signature:         make([]byte, 96),
+				},
+				SelectionProof: make([]byte, 96),
+			},
+			Signature: make([]byte, 96),
+		}
+		wantedContribution := migration.V1Alpha1SignedContributionAndProofToV2(wantedContributionV1alpha1)
+		genericResponse, err := anypb.New(wantedContribution)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: SyncCommitteeContributionTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{SyncCommitteeContributionTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.SyncCommitteeContributionReceived,
+				Data: &operation.SyncCommitteeContributionReceivedData{
+					Contribution: wantedContributionV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
+	t.Run(SyncCommitteeMessageTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedMsgV1alpha1 := &eth.SyncCommitteeMessage{
+			Slot:           1,
+			BlockRoot:      make([]byte, 32),
+			ValidatorIndex: 1,
+			Signature:      make([]byte, 96),
+		}
+		wantedMsg := migration.V1Alpha1SyncCommitteeMessageToV2(wantedMsgV1alpha1)
+		genericResponse, err := anypb.New(wantedMsg)
+		require.NoError(t, err)
+
+		wantedMessage := &gateway.EventSource{
+			Event: SyncCommitteeMessageTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{SyncCommitteeMessageTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: operation.SyncCommitteeMessageReceived,
+				Data: &operation.SyncCommitteeMessageReceivedData{
+					Message: wantedMsgV1alpha1,
+				},
+			},
+			feed: srv.OperationNotifier.OperationFeed(),
+		})
+	})
 }
 
 func TestStreamEvents_StateEvents(t *testing.T) {
@@ -285,6 +365,89 @@ func TestStreamEvents_StateEvents(t *testing.T) {
 			feed: srv.StateNotifier.StateFeed(),
 		})
 	})
+	t.Run(LightClientFinalityUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &ethpbv2.LightClientFinalityUpdate{
+			AttestedHeader: &ethpb.BeaconBlockHeader{
+				Slot:       65,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				BodyRoot:   make([]byte, 32),
+			},
+			FinalizedHeader: &ethpb.BeaconBlockHeader{
+				Slot:       32,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				BodyRoot:   make([]byte, 32),
+			},
+			FinalityBranch: [][]byte{make([]byte, 32), make([]byte, 32)},
+			SyncAggregate: &ethpb.SyncAggregate{
+				SyncCommitteeBits:      bitfield.NewBitvector512(),
+				SyncCommitteeSignature: make([]byte, 96),
+			},
+			SignatureSlot: 66,
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientFinalityUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientFinalityUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientFinalityUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
+	t.Run(LightClientOptimisticUpdateTopic, func(t *testing.T) {
+		ctx := context.Background()
+		srv, ctrl, mockStream := setupServer(ctx, t)
+		defer ctrl.Finish()
+
+		wantedUpdate := &ethpbv2.LightClientOptimisticUpdate{
+			AttestedHeader: &ethpb.BeaconBlockHeader{
+				Slot:       65,
+				ParentRoot: make([]byte, 32),
+				StateRoot:  make([]byte, 32),
+				BodyRoot:   make([]byte, 32),
+			},
+			SyncAggregate: &ethpb.SyncAggregate{
+				SyncCommitteeBits:      bitfield.NewBitvector512(),
+				SyncCommitteeSignature: make([]byte, 96),
+			},
+			SignatureSlot: 66,
+		}
+		genericResponse, err := anypb.New(wantedUpdate)
+		require.NoError(t, err)
+		wantedMessage := &gateway.EventSource{
+			Event: LightClientOptimisticUpdateTopic,
+			Data:  genericResponse,
+		}
+
+		assertFeedSendAndReceive(ctx, &assertFeedArgs{
+			t:             t,
+			srv:           srv,
+			topics:        []string{LightClientOptimisticUpdateTopic},
+			stream:        mockStream,
+			shouldReceive: wantedMessage,
+			itemToSend: &feed.Event{
+				Type: statefeed.LightClientOptimisticUpdate,
+				Data: wantedUpdate,
+			},
+			feed: srv.StateNotifier.StateFeed(),
+		})
+	})
 }
 
 func setupServer(ctx context.Context, t testing.TB) (*Server, *gomock.Controller, *mock.MockEvents_StreamEventsServer) {
@@ -0,0 +1,95 @@
+package lightclient
+
+import (
+	"context"
+
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxRequestLightClientUpdates is the maximum number of light client updates served in a single request.
+const maxRequestLightClientUpdates = 128
+
+// GetLightClientBootstrap returns the light client bootstrap of the given trusted block root,
+// made of its header and the current sync committee in its state along with the Merkle branch
+// proving it.
+func (s *Server) GetLightClientBootstrap(ctx context.Context, req *ethpbv2.LightClientBootstrapRequest) (*ethpbv2.LightClientBootstrapResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "lightclient.GetLightClientBootstrap")
+	defer span.End()
+
+	if len(req.BlockRoot) != 32 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid block root length %d", len(req.BlockRoot))
+	}
+	blockRoot := bytesutil.ToBytes32(req.BlockRoot)
+	blk, err := s.BeaconDB.Block(ctx, blockRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block: %v", err)
+	}
+	if blk == nil || blk.IsNil() {
+		return nil, status.Errorf(codes.NotFound, "Could not find block with root %#x", blockRoot)
+	}
+	if blk.Version() == version.Phase0 {
+		return nil, status.Error(codes.InvalidArgument, "Light client bootstrap is not available before the Altair fork")
+	}
+	header, err := migration.BlockIfaceToV1BlockHeader(blk)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get block header: %v", err)
+	}
+
+	st, err := s.StateGenService.StateByRoot(ctx, blockRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get state: %v", err)
+	}
+	if st == nil || st.IsNil() {
+		return nil, status.Errorf(codes.NotFound, "Could not find state of block with root %#x", blockRoot)
+	}
+	committee, err := st.CurrentSyncCommittee()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get current sync committee: %v", err)
+	}
+	branch, err := st.CurrentSyncCommitteeProof(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get current sync committee proof: %v", err)
+	}
+
+	return &ethpbv2.LightClientBootstrapResponse{
+		Data: &ethpbv2.LightClientBootstrap{
+			Header: header.Message,
+			CurrentSyncCommittee: &ethpbv2.SyncCommittee{
+				Pubkeys:         committee.Pubkeys,
+				AggregatePubkey: committee.AggregatePubkey,
+			},
+			CurrentSyncCommitteeBranch: branch,
+		},
+	}, nil
+}
+
+// GetLightClientUpdatesByRange returns the best light client update known to the node for each
+// sync committee period of the requested range. Periods without an update are skipped.
+func (s *Server) GetLightClientUpdatesByRange(ctx context.Context, req *ethpbv2.LightClientUpdatesByRangeRequest) (*ethpbv2.LightClientUpdatesByRangeResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "lightclient.GetLightClientUpdatesByRange")
+	defer span.End()
+
+	if req.Count == 0 {
+		return nil, status.Error(codes.InvalidArgument, "Count must be greater than 0")
+	}
+	count := req.Count
+	if count > maxRequestLightClientUpdates {
+		count = maxRequestLightClientUpdates
+	}
+	endPeriod := req.StartPeriod + count - 1
+	if endPeriod < req.StartPeriod {
+		return nil, status.Error(codes.InvalidArgument, "Requested period range overflows")
+	}
+
+	updates, err := s.BeaconDB.LightClientUpdates(ctx, req.StartPeriod, endPeriod)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get light client updates: %v", err)
+	}
+	return &ethpbv2.LightClientUpdatesByRangeResponse{Data: updates}, nil
+}
@@ -0,0 +1,117 @@
+package lightclient
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestServer_GetLightClientBootstrap(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Server{
+		BeaconDB:        beaconDB,
+		StateGenService: stategen.New(beaconDB),
+	}
+
+	st, _ := testutil.DeterministicGenesisStateAltair(t, 64)
+	require.NoError(t, st.SetSlot(10))
+	stateRoot, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	blk := testutil.NewBeaconBlockAltair()
+	blk.Block.Slot = 10
+	blk.Block.StateRoot = stateRoot[:]
+	wsb, err := wrapper.WrappedAltairSignedBeaconBlock(blk)
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wsb))
+	root, err := blk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, st, root))
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := s.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: root[:]})
+		require.NoError(t, err)
+		assert.Equal(t, types.Slot(10), resp.Data.Header.Slot)
+		assert.DeepEqual(t, stateRoot[:], resp.Data.Header.StateRoot)
+		committee, err := st.CurrentSyncCommittee()
+		require.NoError(t, err)
+		assert.DeepEqual(t, committee.Pubkeys, resp.Data.CurrentSyncCommittee.Pubkeys)
+		assert.DeepEqual(t, committee.AggregatePubkey, resp.Data.CurrentSyncCommittee.AggregatePubkey)
+
+		// The branch proves the current sync committee against the state root of the header.
+		committeeRoot, err := committee.HashTreeRoot()
+		require.NoError(t, err)
+		require.Equal(t, 5, len(resp.Data.CurrentSyncCommitteeBranch))
+		gIndex := 54
+		node := committeeRoot[:]
+		for i, sibling := range resp.Data.CurrentSyncCommitteeBranch {
+			var h [32]byte
+			if (gIndex>>i)%2 == 1 {
+				h = hashutil.Hash(append(append([]byte{}, sibling...), node...))
+			} else {
+				h = hashutil.Hash(append(append([]byte{}, node...), sibling...))
+			}
+			node = h[:]
+		}
+		assert.DeepEqual(t, stateRoot[:], node)
+	})
+	t.Run("invalid block root", func(t *testing.T) {
+		_, err := s.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: []byte{'a'}})
+		assert.ErrorContains(t, "Invalid block root length 1", err)
+	})
+	t.Run("unknown block root", func(t *testing.T) {
+		_, err := s.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: make([]byte, 32)})
+		assert.ErrorContains(t, "Could not find block", err)
+	})
+	t.Run("phase 0 block", func(t *testing.T) {
+		phase0Blk := testutil.NewBeaconBlock()
+		phase0Blk.Block.Slot = 1
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(phase0Blk)))
+		phase0Root, err := phase0Blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		_, err = s.GetLightClientBootstrap(ctx, &ethpbv2.LightClientBootstrapRequest{BlockRoot: phase0Root[:]})
+		assert.ErrorContains(t, "not available before the Altair fork", err)
+	})
+}
+
+func TestServer_GetLightClientUpdatesByRange(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	s := &Server{BeaconDB: beaconDB}
+	for _, period := range []uint64{0, 1, 3, 200} {
+		require.NoError(t, beaconDB.SaveLightClientUpdate(ctx, period, &ethpbv2.LightClientUpdate{
+			AttestedHeader: &ethpbv1.BeaconBlockHeader{Slot: types.Slot(period)},
+		}))
+	}
+
+	t.Run("OK", func(t *testing.T) {
+		resp, err := s.GetLightClientUpdatesByRange(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 1, Count: 3})
+		require.NoError(t, err)
+		require.Equal(t, 2, len(resp.Data))
+		assert.Equal(t, types.Slot(1), resp.Data[0].AttestedHeader.Slot)
+		assert.Equal(t, types.Slot(3), resp.Data[1].AttestedHeader.Slot)
+	})
+	t.Run("count is capped", func(t *testing.T) {
+		resp, err := s.GetLightClientUpdatesByRange(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 0, Count: 1000})
+		require.NoError(t, err)
+		assert.Equal(t, 3, len(resp.Data))
+	})
+	t.Run("zero count", func(t *testing.T) {
+		_, err := s.GetLightClientUpdatesByRange(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: 0})
+		assert.ErrorContains(t, "Count must be greater than 0", err)
+	})
+	t.Run("overflow", func(t *testing.T) {
+		_, err := s.GetLightClientUpdatesByRange(ctx, &ethpbv2.LightClientUpdatesByRangeRequest{StartPeriod: ^uint64(0), Count: 2})
+		assert.ErrorContains(t, "Requested period range overflows", err)
+	})
+}
@@ -0,0 +1,15 @@
+// Package lightclient defines a gRPC light client service implementation,
+// serving the data light clients need to follow the chain through its sync committees.
+package lightclient
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
+)
+
+// Server defines a server implementation of the gRPC Beacon Light Client service,
+// providing RPC endpoints to bootstrap light clients and serve light client updates.
+type Server struct {
+	BeaconDB        db.ReadOnlyDatabase
+	StateGenService stategen.StateManager
+}
@@ -11,16 +11,25 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db/filters"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/pagination"
 	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/version"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// blockContainer represents an instance of a block along with its relevant metadata.
+type blockContainer struct {
+	blk         block.SignedBeaconBlock
+	root        [32]byte
+	isCanonical bool
+}
+
 // ListBlocks retrieves blocks by root, slot, or epoch.
 //
 // The server may return multiple blocks in the case that a slot or epoch is
@@ -30,171 +39,159 @@ import (
 func (bs *Server) ListBlocks(
 	ctx context.Context, req *ethpb.ListBlocksRequest,
 ) (*ethpb.ListBlocksResponse, error) {
-	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
-		return nil, status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
-			req.PageSize, cmd.Get().MaxRPCPageSize)
+	ctrs, numBlks, nextPageToken, err := bs.listBlocks(ctx, req)
+	if err != nil {
+		return nil, err
 	}
-
-	switch q := req.QueryFilter.(type) {
-	case *ethpb.ListBlocksRequest_Epoch:
-		blks, _, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(q.Epoch).SetEndEpoch(q.Epoch))
+	containers := make([]*ethpb.BeaconBlockContainer, len(ctrs))
+	for i := range ctrs {
+		c := ctrs[i]
+		phBlk, err := c.blk.PbPhase0Block()
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not get blocks: %v", err)
+			return nil, status.Errorf(codes.Internal, "Could not get phase 0 block: %v", err)
 		}
-
-		numBlks := len(blks)
-		if numBlks == 0 {
-			return &ethpb.ListBlocksResponse{
-				BlockContainers: make([]*ethpb.BeaconBlockContainer, 0),
-				TotalSize:       0,
-				NextPageToken:   strconv.Itoa(0),
-			}, nil
+		containers[i] = &ethpb.BeaconBlockContainer{
+			Block:     phBlk,
+			BlockRoot: c.root[:],
+			Canonical: c.isCanonical,
 		}
+	}
+	return &ethpb.ListBlocksResponse{
+		BlockContainers: containers,
+		TotalSize:       int32(numBlks),
+		NextPageToken:   nextPageToken,
+	}, nil
+}
 
-		start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), numBlks)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not paginate blocks: %v", err)
+// ListBlocksAltair retrieves blocks by root, slot, or epoch in the same way as ListBlocks.
+// Blocks of every fork are returned, each wrapped in the container field matching its fork.
+func (bs *Server) ListBlocksAltair(
+	ctx context.Context, req *ethpb.ListBlocksRequest,
+) (*ethpb.ListBlocksResponseAltair, error) {
+	ctrs, numBlks, nextPageToken, err := bs.listBlocks(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	containers := make([]*ethpb.BeaconBlockContainerAltair, len(ctrs))
+	for i := range ctrs {
+		c := ctrs[i]
+		container := &ethpb.BeaconBlockContainerAltair{
+			BlockRoot: c.root[:],
+			Canonical: c.isCanonical,
 		}
-
-		returnedBlks := blks[start:end]
-		containers := make([]*ethpb.BeaconBlockContainer, len(returnedBlks))
-		for i, b := range returnedBlks {
-			root, err := b.Block().HashTreeRoot()
-			if err != nil {
-				return nil, err
-			}
-			canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, root)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
-			}
-			phBlk, err := b.PbPhase0Block()
+		switch c.blk.Version() {
+		case version.Phase0:
+			phBlk, err := c.blk.PbPhase0Block()
 			if err != nil {
 				return nil, status.Errorf(codes.Internal, "Could not get phase 0 block: %v", err)
 			}
-			containers[i] = &ethpb.BeaconBlockContainer{
-				Block:     phBlk,
-				BlockRoot: root[:],
-				Canonical: canonical,
+			container.Block = &ethpb.BeaconBlockContainerAltair_Phase0Block{Phase0Block: phBlk}
+		case version.Altair:
+			altBlk, err := c.blk.PbAltairBlock()
+			if err != nil {
+				return nil, status.Errorf(codes.Internal, "Could not get altair block: %v", err)
 			}
+			container.Block = &ethpb.BeaconBlockContainerAltair_AltairBlock{AltairBlock: altBlk}
+		default:
+			return nil, status.Errorf(codes.Internal, "Unsupported block version %d", c.blk.Version())
 		}
+		containers[i] = container
+	}
+	return &ethpb.ListBlocksResponseAltair{
+		BlockContainers: containers,
+		TotalSize:       int32(numBlks),
+		NextPageToken:   nextPageToken,
+	}, nil
+}
+
+// listBlocks retrieves the blocks matching the filter criteria of the request, along with the
+// total number of matching blocks and the next page token.
+func (bs *Server) listBlocks(ctx context.Context, req *ethpb.ListBlocksRequest) ([]blockContainer, int, string, error) {
+	if int(req.PageSize) > cmd.Get().MaxRPCPageSize {
+		return nil, 0, "", status.Errorf(codes.InvalidArgument, "Requested page size %d can not be greater than max size %d",
+			req.PageSize, cmd.Get().MaxRPCPageSize)
+	}
 
-		return &ethpb.ListBlocksResponse{
-			BlockContainers: containers,
-			TotalSize:       int32(numBlks),
-			NextPageToken:   nextPageToken,
-		}, nil
+	switch q := req.QueryFilter.(type) {
+	case *ethpb.ListBlocksRequest_Epoch:
+		blks, _, err := bs.BeaconDB.Blocks(ctx, filters.NewFilter().SetStartEpoch(q.Epoch).SetEndEpoch(q.Epoch))
+		if err != nil {
+			return nil, 0, "", status.Errorf(codes.Internal, "Could not get blocks: %v", err)
+		}
+		return bs.paginateBlocks(ctx, req, blks)
 	case *ethpb.ListBlocksRequest_Root:
 		blk, err := bs.BeaconDB.Block(ctx, bytesutil.ToBytes32(q.Root))
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
+			return nil, 0, "", status.Errorf(codes.Internal, "Could not retrieve block: %v", err)
 		}
 		if blk == nil || blk.IsNil() {
-			return &ethpb.ListBlocksResponse{
-				BlockContainers: make([]*ethpb.BeaconBlockContainer, 0),
-				TotalSize:       0,
-				NextPageToken:   strconv.Itoa(0),
-			}, nil
+			return make([]blockContainer, 0), 0, strconv.Itoa(0), nil
 		}
 		root, err := blk.Block().HashTreeRoot()
 		if err != nil {
-			return nil, err
+			return nil, 0, "", err
 		}
 		canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, root)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
-		}
-		phBlk, err := blk.PbPhase0Block()
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not determine if block is phase 0 block: %v", err)
+			return nil, 0, "", status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
 		}
-		return &ethpb.ListBlocksResponse{
-			BlockContainers: []*ethpb.BeaconBlockContainer{{
-				Block:     phBlk,
-				BlockRoot: root[:],
-				Canonical: canonical},
-			},
-			TotalSize: 1,
-		}, nil
-
+		return []blockContainer{{blk: blk, root: root, isCanonical: canonical}}, 1, "", nil
 	case *ethpb.ListBlocksRequest_Slot:
 		hasBlocks, blks, err := bs.BeaconDB.BlocksBySlot(ctx, q.Slot)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", q.Slot, err)
+			return nil, 0, "", status.Errorf(codes.Internal, "Could not retrieve blocks for slot %d: %v", q.Slot, err)
 		}
 		if !hasBlocks {
-			return &ethpb.ListBlocksResponse{
-				BlockContainers: make([]*ethpb.BeaconBlockContainer, 0),
-				TotalSize:       0,
-				NextPageToken:   strconv.Itoa(0),
-			}, nil
+			return make([]blockContainer, 0), 0, strconv.Itoa(0), nil
 		}
-
-		numBlks := len(blks)
-
-		start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), numBlks)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not paginate blocks: %v", err)
-		}
-
-		returnedBlks := blks[start:end]
-		containers := make([]*ethpb.BeaconBlockContainer, len(returnedBlks))
-		for i, b := range returnedBlks {
-			root, err := b.Block().HashTreeRoot()
-			if err != nil {
-				return nil, err
-			}
-			canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, root)
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
-			}
-			phBlk, err := b.PbPhase0Block()
-			if err != nil {
-				return nil, status.Errorf(codes.Internal, "Could not determine if block is phase 0 block: %v", err)
-			}
-			containers[i] = &ethpb.BeaconBlockContainer{
-				Block:     phBlk,
-				BlockRoot: root[:],
-				Canonical: canonical,
-			}
-		}
-
-		return &ethpb.ListBlocksResponse{
-			BlockContainers: containers,
-			TotalSize:       int32(numBlks),
-			NextPageToken:   nextPageToken,
-		}, nil
+		return bs.paginateBlocks(ctx, req, blks)
 	case *ethpb.ListBlocksRequest_Genesis:
 		genBlk, err := bs.BeaconDB.GenesisBlock(ctx)
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not retrieve blocks for genesis slot: %v", err)
+			return nil, 0, "", status.Errorf(codes.Internal, "Could not retrieve blocks for genesis slot: %v", err)
 		}
 		if genBlk == nil || genBlk.IsNil() {
-			return nil, status.Error(codes.Internal, "Could not find genesis block")
+			return nil, 0, "", status.Error(codes.Internal, "Could not find genesis block")
 		}
 		root, err := genBlk.Block().HashTreeRoot()
 		if err != nil {
-			return nil, err
+			return nil, 0, "", err
 		}
-		phBlk, err := genBlk.PbPhase0Block()
+		return []blockContainer{{blk: genBlk, root: root, isCanonical: true}}, 1, strconv.Itoa(0), nil
+	}
+
+	return nil, 0, "", status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching blocks")
+}
+
+// paginateBlocks returns the requested page of the given blocks along with their roots and
+// whether they are canonical.
+func (bs *Server) paginateBlocks(
+	ctx context.Context, req *ethpb.ListBlocksRequest, blks []block.SignedBeaconBlock,
+) ([]blockContainer, int, string, error) {
+	numBlks := len(blks)
+	if numBlks == 0 {
+		return make([]blockContainer, 0), 0, strconv.Itoa(0), nil
+	}
+
+	start, end, nextPageToken, err := pagination.StartAndEndPage(req.PageToken, int(req.PageSize), numBlks)
+	if err != nil {
+		return nil, 0, "", status.Errorf(codes.Internal, "Could not paginate blocks: %v", err)
+	}
+
+	returnedBlks := blks[start:end]
+	ctrs := make([]blockContainer, len(returnedBlks))
+	for i, b := range returnedBlks {
+		root, err := b.Block().HashTreeRoot()
 		if err != nil {
-			return nil, status.Errorf(codes.Internal, "Could not determine if block is phase 0 block: %v", err)
+			return nil, 0, "", err
 		}
-		containers := []*ethpb.BeaconBlockContainer{
-			{
-				Block:     phBlk,
-				BlockRoot: root[:],
-				Canonical: true,
-			},
+		canonical, err := bs.CanonicalFetcher.IsCanonical(ctx, root)
+		if err != nil {
+			return nil, 0, "", status.Errorf(codes.Internal, "Could not determine if block is canonical: %v", err)
 		}
-
-		return &ethpb.ListBlocksResponse{
-			BlockContainers: containers,
-			TotalSize:       int32(1),
-			NextPageToken:   strconv.Itoa(0),
-		}, nil
+		ctrs[i] = blockContainer{blk: b, root: root, isCanonical: canonical}
 	}
-
-	return nil, status.Error(codes.InvalidArgument, "Must specify a filter criteria for fetching blocks")
+	return ctrs, numBlks, nextPageToken, nil
 }
 
 // GetChainHead retrieves information about the head of the beacon chain from
@@ -153,6 +153,67 @@ func TestServer_ListBlocks_Genesis_MultiBlocks(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestServer_ListBlocksAltair(t *testing.T) {
+	db := dbTest.SetupDB(t)
+	ctx := context.Background()
+
+	phBlk := testutil.NewBeaconBlock()
+	phBlk.Block.Slot = 1
+	phRoot, err := phBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(phBlk)))
+	altBlk := testutil.NewBeaconBlockAltair()
+	altBlk.Block.Slot = 2
+	altRoot, err := altBlk.Block.HashTreeRoot()
+	require.NoError(t, err)
+	wsb, err := wrapper.WrappedAltairSignedBeaconBlock(altBlk)
+	require.NoError(t, err)
+	require.NoError(t, db.SaveBlock(ctx, wsb))
+
+	bs := &Server{
+		BeaconDB:         db,
+		CanonicalFetcher: &chainMock.ChainService{CanonicalRoots: map[[32]byte]bool{altRoot: true}},
+	}
+	res, err := bs.ListBlocksAltair(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Slot{Slot: 1},
+	})
+	require.NoError(t, err)
+	wanted := &ethpb.ListBlocksResponseAltair{
+		BlockContainers: []*ethpb.BeaconBlockContainerAltair{
+			{
+				Block:     &ethpb.BeaconBlockContainerAltair_Phase0Block{Phase0Block: phBlk},
+				BlockRoot: phRoot[:],
+				Canonical: false,
+			},
+		},
+		TotalSize: 1,
+	}
+	if !proto.Equal(wanted, res) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+
+	res, err = bs.ListBlocksAltair(ctx, &ethpb.ListBlocksRequest{
+		QueryFilter: &ethpb.ListBlocksRequest_Root{Root: altRoot[:]},
+	})
+	require.NoError(t, err)
+	wanted = &ethpb.ListBlocksResponseAltair{
+		BlockContainers: []*ethpb.BeaconBlockContainerAltair{
+			{
+				Block:     &ethpb.BeaconBlockContainerAltair_AltairBlock{AltairBlock: altBlk},
+				BlockRoot: altRoot[:],
+				Canonical: true,
+			},
+		},
+		TotalSize: 1,
+	}
+	if !proto.Equal(wanted, res) {
+		t.Errorf("Wanted %v, received %v", wanted, res)
+	}
+
+	_, err = bs.ListBlocksAltair(ctx, &ethpb.ListBlocksRequest{})
+	assert.ErrorContains(t, "Must specify a filter criteria", err)
+}
+
 func TestServer_ListBlocks_Pagination(t *testing.T) {
 	params.UseMinimalConfig()
 	defer params.UseMainnetConfig()
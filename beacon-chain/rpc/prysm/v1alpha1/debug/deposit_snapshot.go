@@ -0,0 +1,29 @@
+package debug
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetDepositSnapshot returns the ssz-encoded snapshot of the finalized deposit tree, which can be
+// used by another node to restore its deposit trie without fetching the finalized deposit logs.
+func (ds *Server) GetDepositSnapshot(ctx context.Context, _ *empty.Empty) (*pbrpc.SSZResponse, error) {
+	if ds.DepositSnapshotFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Deposit snapshots are not available")
+	}
+	snapshot, err := ds.DepositSnapshotFetcher.DepositSnapshot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get deposit snapshot: %v", err)
+	}
+	encoded, err := snapshot.MarshalSSZ()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not marshal deposit snapshot: %v", err)
+	}
+	return &pbrpc.SSZResponse{
+		Encoded: encoded,
+	}, nil
+}
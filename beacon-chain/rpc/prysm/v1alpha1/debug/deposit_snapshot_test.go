@@ -0,0 +1,47 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+type mockDepositSnapshotFetcher struct {
+	snapshot *pbrpc.DepositSnapshot
+	err      error
+}
+
+func (m *mockDepositSnapshotFetcher) DepositSnapshot(_ context.Context) (*pbrpc.DepositSnapshot, error) {
+	return m.snapshot, m.err
+}
+
+func TestServer_GetDepositSnapshot(t *testing.T) {
+	ctx := context.Background()
+	snapshot := &pbrpc.DepositSnapshot{
+		Finalized:            [][]byte{bytesutil.PadTo([]byte{'a'}, 32), bytesutil.PadTo([]byte{'b'}, 32)},
+		DepositRoot:          bytesutil.PadTo([]byte{'r'}, 32),
+		DepositCount:         3,
+		ExecutionBlockHash:   bytesutil.PadTo([]byte{'h'}, 32),
+		ExecutionBlockHeight: 100,
+	}
+	ds := &Server{DepositSnapshotFetcher: &mockDepositSnapshotFetcher{snapshot: snapshot}}
+	res, err := ds.GetDepositSnapshot(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	decoded := &pbrpc.DepositSnapshot{}
+	require.NoError(t, decoded.UnmarshalSSZ(res.Encoded))
+	assert.DeepSSZEqual(t, snapshot, decoded)
+
+	ds = &Server{DepositSnapshotFetcher: &mockDepositSnapshotFetcher{err: errors.New("no finalized deposits")}}
+	_, err = ds.GetDepositSnapshot(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "no finalized deposits", err)
+
+	ds = &Server{}
+	_, err = ds.GetDepositSnapshot(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "not available", err)
+}
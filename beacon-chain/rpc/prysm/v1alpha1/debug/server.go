@@ -13,6 +13,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	pbrpc "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/sirupsen/logrus"
@@ -24,12 +25,13 @@ import (
 // providing RPC endpoints for runtime debugging of a node, this server is
 // gated behind the feature flag --enable-debug-rpc-endpoints.
 type Server struct {
-	BeaconDB           db.NoHeadAccessDatabase
-	GenesisTimeFetcher blockchain.TimeFetcher
-	StateGen           *stategen.State
-	HeadFetcher        blockchain.HeadFetcher
-	PeerManager        p2p.PeerManager
-	PeersFetcher       p2p.PeersProvider
+	BeaconDB               db.NoHeadAccessDatabase
+	GenesisTimeFetcher     blockchain.TimeFetcher
+	StateGen               *stategen.State
+	HeadFetcher            blockchain.HeadFetcher
+	PeerManager            p2p.PeerManager
+	PeersFetcher           p2p.PeersProvider
+	DepositSnapshotFetcher powchain.DepositSnapshotFetcher
 }
 
 // SetLoggingLevel of a beacon node according to a request type,
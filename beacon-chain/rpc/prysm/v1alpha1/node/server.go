@@ -16,6 +16,7 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
@@ -38,6 +39,8 @@ type Server struct {
 	BeaconDB             db.ReadOnlyDatabase
 	PeersFetcher         p2p.PeersProvider
 	PeerManager          p2p.PeerManager
+	AccessListManager    p2p.AccessListManager
+	Eth1HealthFetcher    powchain.EndpointHealthFetcher
 	GenesisTimeFetcher   blockchain.TimeFetcher
 	GenesisFetcher       blockchain.GenesisFetcher
 	BeaconMonitoringHost string
@@ -220,6 +223,133 @@ func (ns *Server) ListPeers(ctx context.Context, _ *empty.Empty) (*ethpb.Peers,
 	}, nil
 }
 
+// ListPeerBans lists the peers which are banned because of their score, or because they are in
+// the deny list.
+func (ns *Server) ListPeerBans(ctx context.Context, _ *empty.Empty) (*ethpb.PeerBans, error) {
+	peerStatus := ns.PeersFetcher.Peers()
+	reasons := make(map[peer.ID]string)
+	if ns.AccessListManager != nil {
+		for _, pid := range ns.AccessListManager.AccessList().DeniedPeers {
+			reasons[pid] = "in the deny list"
+		}
+	}
+	for _, pid := range peerStatus.All() {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if _, ok := reasons[pid]; ok || !peerStatus.IsBad(pid) {
+			continue
+		}
+		switch {
+		case peerStatus.Scorers().BadResponsesScorer().IsBadPeer(pid):
+			reasons[pid] = "too many bad responses"
+		case peerStatus.Scorers().PeerStatusScorer().IsBadPeer(pid):
+			reasons[pid] = fmt.Sprintf("invalid chain state: %v", peerStatus.Scorers().ValidationError(pid))
+		default:
+			reasons[pid] = "too many peers from the same IP address"
+		}
+	}
+
+	bans := make([]*ethpb.PeerBan, 0, len(reasons))
+	for pid, reason := range reasons {
+		ban := &ethpb.PeerBan{
+			PeerId: pid.String(),
+			Reason: reason,
+		}
+		if addr, err := peerStatus.Address(pid); err == nil && addr != nil {
+			ban.Address = fmt.Sprintf("%s/p2p/%s", addr.String(), pid.Pretty())
+		}
+		if count, err := peerStatus.Scorers().BadResponsesScorer().Count(pid); err == nil {
+			ban.BadResponses = uint64(count)
+		}
+		bans = append(bans, ban)
+	}
+	sort.Slice(bans, func(i, j int) bool {
+		return bans[i].PeerId < bans[j].PeerId
+	})
+	return &ethpb.PeerBans{Bans: bans}, nil
+}
+
+// GetPeerAccessList returns the peer ids and IP networks which were allowed or denied at runtime.
+func (ns *Server) GetPeerAccessList(_ context.Context, _ *empty.Empty) (*ethpb.PeerAccessList, error) {
+	if ns.AccessListManager == nil {
+		return nil, status.Error(codes.Unavailable, "Peer access list is not available")
+	}
+	list := ns.AccessListManager.AccessList()
+	res := &ethpb.PeerAccessList{
+		AllowedPeerIds: make([]string, len(list.AllowedPeers)),
+		DeniedPeerIds:  make([]string, len(list.DeniedPeers)),
+		AllowedCidrs:   list.AllowedCIDRs,
+		DeniedCidrs:    list.DeniedCIDRs,
+	}
+	for i, pid := range list.AllowedPeers {
+		res.AllowedPeerIds[i] = pid.String()
+	}
+	for i, pid := range list.DeniedPeers {
+		res.DeniedPeerIds[i] = pid.String()
+	}
+	return res, nil
+}
+
+// AddToPeerAccessList adds peer ids and IP networks to the allow list or the deny list.
+func (ns *Server) AddToPeerAccessList(_ context.Context, req *ethpb.PeerAccessList) (*empty.Empty, error) {
+	if ns.AccessListManager == nil {
+		return nil, status.Error(codes.Unavailable, "Peer access list is not available")
+	}
+	list, err := accessListFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := ns.AccessListManager.AddToAccessList(list); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not add to peer access list: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// RemoveFromPeerAccessList removes peer ids and IP networks from the allow list or the deny list.
+func (ns *Server) RemoveFromPeerAccessList(_ context.Context, req *ethpb.PeerAccessList) (*empty.Empty, error) {
+	if ns.AccessListManager == nil {
+		return nil, status.Error(codes.Unavailable, "Peer access list is not available")
+	}
+	list, err := accessListFromRequest(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := ns.AccessListManager.RemoveFromAccessList(list); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not remove from peer access list: %v", err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// ListETH1Endpoints retrieves the health of the configured eth1 endpoints.
+func (ns *Server) ListETH1Endpoints(_ context.Context, _ *empty.Empty) (*ethpb.ETH1Endpoints, error) {
+	if ns.Eth1HealthFetcher == nil {
+		return nil, status.Error(codes.Unavailable, "Eth1 endpoint health is not available")
+	}
+	health := ns.Eth1HealthFetcher.EndpointHealth()
+	endpoints := make([]*ethpb.ETH1Endpoint, len(health))
+	for i, h := range health {
+		endpoints[i] = &ethpb.ETH1Endpoint{
+			Address:              h.Endpoint,
+			Active:               h.Active,
+			Healthy:              h.Healthy,
+			Score:                h.Score,
+			ChainId:              h.ChainID,
+			HeadBlock:            h.HeadBlock,
+			BlocksBehind:         h.BlocksBehind,
+			LatencyMs:            uint64(h.Latency.Milliseconds()),
+			DepositLogsAvailable: h.DepositLogsAvailable,
+		}
+		if h.Err != nil {
+			endpoints[i].Error = h.Err.Error()
+		}
+		if !h.LastChecked.IsZero() {
+			endpoints[i].LastChecked = timestamppb.New(h.LastChecked)
+		}
+	}
+	return &ethpb.ETH1Endpoints{Endpoints: endpoints}, nil
+}
+
 // StreamBeaconLogs from the beacon node via a gRPC server-side stream.
 func (ns *Server) StreamBeaconLogs(_ *empty.Empty, stream pb.Health_StreamBeaconLogsServer) error {
 	ch := make(chan []byte, ns.StreamLogsBufferSize)
@@ -255,3 +385,31 @@ func (ns *Server) StreamBeaconLogs(_ *empty.Empty, stream pb.Health_StreamBeacon
 		}
 	}
 }
+
+func accessListFromRequest(req *ethpb.PeerAccessList) (*p2p.AccessList, error) {
+	decode := func(ids []string) ([]peer.ID, error) {
+		pids := make([]peer.ID, len(ids))
+		for i, id := range ids {
+			pid, err := peer.Decode(id)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "Unable to parse peer id %s: %v", id, err)
+			}
+			pids[i] = pid
+		}
+		return pids, nil
+	}
+	allowed, err := decode(req.AllowedPeerIds)
+	if err != nil {
+		return nil, err
+	}
+	denied, err := decode(req.DeniedPeerIds)
+	if err != nil {
+		return nil, err
+	}
+	return &p2p.AccessList{
+		AllowedPeers: allowed,
+		DeniedPeers:  denied,
+		AllowedCIDRs: req.AllowedCidrs,
+		DeniedCIDRs:  req.DeniedCidrs,
+	}, nil
+}
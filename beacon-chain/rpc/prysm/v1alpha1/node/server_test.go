@@ -2,16 +2,21 @@ package node
 
 import (
 	"context"
+	"errors"
 	"testing"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/libp2p/go-libp2p-core/network"
+	"github.com/libp2p/go-libp2p-core/peer"
+	ma "github.com/multiformats/go-multiaddr"
 	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
 	dbutil "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	mockP2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -147,3 +152,133 @@ func TestNodeServer_ListPeers(t *testing.T) {
 	assert.Equal(t, int(ethpb.PeerDirection_INBOUND), int(res.Peers[0].Direction))
 	assert.Equal(t, ethpb.PeerDirection_OUTBOUND, res.Peers[1].Direction)
 }
+
+type mockAccessListManager struct {
+	list *p2p.AccessList
+}
+
+func (m *mockAccessListManager) AccessList() *p2p.AccessList {
+	return m.list
+}
+
+func (m *mockAccessListManager) AddToAccessList(list *p2p.AccessList) error {
+	m.list.AllowedPeers = append(m.list.AllowedPeers, list.AllowedPeers...)
+	m.list.DeniedPeers = append(m.list.DeniedPeers, list.DeniedPeers...)
+	m.list.AllowedCIDRs = append(m.list.AllowedCIDRs, list.AllowedCIDRs...)
+	m.list.DeniedCIDRs = append(m.list.DeniedCIDRs, list.DeniedCIDRs...)
+	return nil
+}
+
+func (m *mockAccessListManager) RemoveFromAccessList(_ *p2p.AccessList) error {
+	m.list = &p2p.AccessList{}
+	return nil
+}
+
+func TestNodeServer_ListPeerBans(t *testing.T) {
+	badPeer, err := peer.Decode("16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR")
+	require.NoError(t, err)
+	deniedPeer, err := peer.Decode("16Uiu2HAm8maLMjag1TAUM52zPfmLbVMGFdwUAWgoHu1HDQLR6e17")
+	require.NoError(t, err)
+	p2pService := mockP2p.NewTestP2P(t)
+	addr, err := ma.NewMultiaddr("/ip4/212.67.10.1/tcp/13000")
+	require.NoError(t, err)
+	p2pService.Peers().Add(nil, badPeer, addr, network.DirInbound)
+	threshold := p2pService.Peers().Scorers().BadResponsesScorer().Params().Threshold
+	for i := 0; i < threshold; i++ {
+		p2pService.Peers().Scorers().BadResponsesScorer().Increment(badPeer)
+	}
+	ns := &Server{
+		PeersFetcher:      p2pService,
+		AccessListManager: &mockAccessListManager{list: &p2p.AccessList{DeniedPeers: []peer.ID{deniedPeer}}},
+	}
+
+	res, err := ns.ListPeerBans(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Bans))
+	bans := make(map[string]*ethpb.PeerBan)
+	for _, ban := range res.Bans {
+		bans[ban.PeerId] = ban
+	}
+	assert.Equal(t, "too many bad responses", bans[badPeer.String()].Reason)
+	assert.Equal(t, uint64(threshold), bans[badPeer.String()].BadResponses)
+	assert.Equal(t, "/ip4/212.67.10.1/tcp/13000/p2p/"+badPeer.String(), bans[badPeer.String()].Address)
+	assert.Equal(t, "in the deny list", bans[deniedPeer.String()].Reason)
+}
+
+func TestNodeServer_PeerAccessList(t *testing.T) {
+	ctx := context.Background()
+	ns := &Server{
+		AccessListManager: &mockAccessListManager{list: &p2p.AccessList{}},
+	}
+	pid := "16Uiu2HAkyWZ4Ni1TpvDS8dPxsozmHY85KaiFjodQuV6Tz5tkHVeR"
+	_, err := ns.AddToPeerAccessList(ctx, &ethpb.PeerAccessList{
+		DeniedPeerIds: []string{pid},
+		DeniedCidrs:   []string{"212.67.0.0/16"},
+	})
+	require.NoError(t, err)
+	res, err := ns.GetPeerAccessList(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.DeepEqual(t, []string{pid}, res.DeniedPeerIds)
+	assert.DeepEqual(t, []string{"212.67.0.0/16"}, res.DeniedCidrs)
+
+	_, err = ns.AddToPeerAccessList(ctx, &ethpb.PeerAccessList{AllowedPeerIds: []string{"foo"}})
+	assert.ErrorContains(t, "Unable to parse peer id foo", err)
+	_, err = ns.RemoveFromPeerAccessList(ctx, &ethpb.PeerAccessList{DeniedPeerIds: []string{pid}})
+	require.NoError(t, err)
+	res, err = ns.GetPeerAccessList(ctx, &emptypb.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(res.DeniedPeerIds))
+}
+
+type mockEth1HealthFetcher struct {
+	health []*powchain.EndpointHealth
+}
+
+func (m *mockEth1HealthFetcher) EndpointHealth() []*powchain.EndpointHealth {
+	return m.health
+}
+
+func TestNodeServer_ListETH1Endpoints(t *testing.T) {
+	ns := &Server{}
+	_, err := ns.ListETH1Endpoints(context.Background(), &emptypb.Empty{})
+	assert.ErrorContains(t, "Eth1 endpoint health is not available", err)
+
+	checked := time.Unix(1000, 0)
+	ns.Eth1HealthFetcher = &mockEth1HealthFetcher{health: []*powchain.EndpointHealth{
+		{
+			Endpoint:             "http://a.com",
+			Active:               true,
+			Healthy:              true,
+			Score:                0.9,
+			ChainID:              1,
+			HeadBlock:            100,
+			Latency:              150 * time.Millisecond,
+			DepositLogsAvailable: true,
+			LastChecked:          checked,
+		},
+		{
+			Endpoint:     "http://b.com",
+			HeadBlock:    90,
+			BlocksBehind: 10,
+			Err:          errors.New("lagging"),
+			LastChecked:  checked,
+		},
+	}}
+	res, err := ns.ListETH1Endpoints(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(res.Endpoints))
+	assert.DeepEqual(t, &ethpb.ETH1Endpoint{
+		Address:              "http://a.com",
+		Active:               true,
+		Healthy:              true,
+		Score:                0.9,
+		ChainId:              1,
+		HeadBlock:            100,
+		LatencyMs:            150,
+		DepositLogsAvailable: true,
+		LastChecked:          timestamppb.New(checked),
+	}, res.Endpoints[0])
+	assert.Equal(t, "lagging", res.Endpoints[1].Error)
+	assert.Equal(t, uint64(10), res.Endpoints[1].BlocksBehind)
+	assert.Equal(t, false, res.Endpoints[1].Healthy)
+}
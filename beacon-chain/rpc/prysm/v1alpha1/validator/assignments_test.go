@@ -14,6 +14,7 @@ import (
 	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/state"
 	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -348,6 +349,22 @@ func TestAssignValidatorToSubnet(t *testing.T) {
 	}
 }
 
+func TestAssignValidatorToSubnet_NodeIDSubnets(t *testing.T) {
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{
+		SubscribeToNodeIDSubnets: true,
+	})
+	defer func() {
+		flags.Init(resetFlags)
+	}()
+	k := pubKey(4)
+
+	vs := Server{}
+	vs.AssignValidatorToSubnet(k, ethpb.ValidatorStatus_ACTIVE)
+	_, ok, _ := cache.SubnetIDs.GetPersistentSubnets(k)
+	assert.Equal(t, false, ok, "Persistent subnets were assigned to validator")
+}
+
 func BenchmarkCommitteeAssignment(b *testing.B) {
 
 	genesis := testutil.NewBeaconBlock()
@@ -0,0 +1,107 @@
+package validator
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	blockfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/block"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// StreamBlocksAltair to clients every single time a block is received by the beacon node. Blocks
+// of every fork are sent, each wrapped in the response field matching its fork.
+func (vs *Server) StreamBlocksAltair(req *ethpb.StreamBlocksRequest, stream ethpb.BeaconNodeValidator_StreamBlocksAltairServer) error {
+	blocksChannel := make(chan *feed.Event, 1)
+	var blockSub event.Subscription
+	if req.VerifiedOnly {
+		blockSub = vs.StateNotifier.StateFeed().Subscribe(blocksChannel)
+	} else {
+		blockSub = vs.BlockNotifier.BlockFeed().Subscribe(blocksChannel)
+	}
+	defer blockSub.Unsubscribe()
+
+	for {
+		select {
+		case blockEvent := <-blocksChannel:
+			if req.VerifiedOnly {
+				if blockEvent.Type != statefeed.BlockProcessed {
+					continue
+				}
+				data, ok := blockEvent.Data.(*statefeed.BlockProcessedData)
+				if !ok || data == nil || data.SignedBlock == nil {
+					continue
+				}
+				if err := sendBlock(stream, data.SignedBlock); err != nil {
+					return err
+				}
+			} else {
+				if blockEvent.Type != blockfeed.ReceivedBlock {
+					continue
+				}
+				data, ok := blockEvent.Data.(*blockfeed.ReceivedBlockData)
+				if !ok || data == nil || data.SignedBlock == nil {
+					// One nil block shouldn't stop the stream.
+					continue
+				}
+				headState, err := vs.HeadFetcher.HeadState(vs.Ctx)
+				if err != nil {
+					log.WithError(err).WithField("blockSlot", data.SignedBlock.Block().Slot()).Error("Could not get head state")
+					continue
+				}
+				signed := data.SignedBlock
+				if err := blocks.VerifyBlockSignature(headState, signed.Block().ProposerIndex(), signed.Signature(), signed.Block().HashTreeRoot); err != nil {
+					log.WithError(err).WithField("blockSlot", data.SignedBlock.Block().Slot()).Error("Could not verify block signature")
+					continue
+				}
+				if err := sendBlock(stream, signed); err != nil {
+					return err
+				}
+			}
+		case <-blockSub.Err():
+			return status.Error(codes.Aborted, "Subscriber closed, exiting goroutine")
+		case <-vs.Ctx.Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		case <-stream.Context().Done():
+			return status.Error(codes.Canceled, "Context canceled")
+		}
+	}
+}
+
+// sendBlock sends the block over the stream in the response field matching its fork. Blocks which
+// cannot be converted are logged and skipped.
+func sendBlock(stream ethpb.BeaconNodeValidator_StreamBlocksAltairServer, blk block.SignedBeaconBlock) error {
+	res, err := streamBlocksResponse(blk)
+	if err != nil {
+		log.WithError(err).WithField("blockSlot", blk.Block().Slot()).Error("Could not convert block")
+		return nil
+	}
+	if err := stream.Send(res); err != nil {
+		return status.Errorf(codes.Unavailable, "Could not send over stream: %v", err)
+	}
+	return nil
+}
+
+func streamBlocksResponse(blk block.SignedBeaconBlock) (*ethpb.StreamBlocksResponse, error) {
+	switch blk.Version() {
+	case version.Phase0:
+		phBlk, err := blk.PbPhase0Block()
+		if err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_Phase0Block{Phase0Block: phBlk}}, nil
+	case version.Altair:
+		altBlk, err := blk.PbAltairBlock()
+		if err != nil {
+			return nil, err
+		}
+		return &ethpb.StreamBlocksResponse{Block: &ethpb.StreamBlocksResponse_AltairBlock{AltairBlock: altBlk}}, nil
+	default:
+		return nil, errors.Errorf("unsupported block version %d", blk.Version())
+	}
+}
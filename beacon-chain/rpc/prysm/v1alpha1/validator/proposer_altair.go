@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/state/interop"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bls/common"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetBlockAltair is called by a proposer during its assigned slot to request an Altair block to sign
+// by passing in the slot and the signed randao reveal of the slot. In addition to the contents of a
+// phase 0 block, the block carries the sync aggregate of the sync committee contributions for its parent.
+func (vs *Server) GetBlockAltair(ctx context.Context, req *ethpb.BlockRequest) (*ethpb.BeaconBlockAltair, error) {
+	ctx, span := trace.StartSpan(ctx, "ProposerServer.GetBlockAltair")
+	defer span.End()
+	span.AddAttributes(trace.Int64Attribute("slot", int64(req.Slot)))
+
+	if helpers.SlotToEpoch(req.Slot) < params.BeaconConfig().AltairForkEpoch {
+		return nil, status.Errorf(codes.InvalidArgument, "Slot %d is before the Altair fork epoch", req.Slot)
+	}
+
+	blkData, err := vs.buildPhase0BlockData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// The sync committee of the previous slot signs over the parent of the block.
+	syncAggregate, err := vs.getSyncAggregate(req.Slot-1, blkData.ParentRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync aggregate: %v", err)
+	}
+
+	blk := &ethpb.BeaconBlockAltair{
+		Slot:          req.Slot,
+		ParentRoot:    blkData.ParentRoot,
+		StateRoot:     params.BeaconConfig().ZeroHash[:],
+		ProposerIndex: blkData.ProposerIdx,
+		Body: &ethpb.BeaconBlockBodyAltair{
+			Eth1Data:          blkData.Eth1Data,
+			Deposits:          blkData.Deposits,
+			Attestations:      blkData.Attestations,
+			RandaoReveal:      req.RandaoReveal,
+			ProposerSlashings: blkData.ProposerSlashings,
+			AttesterSlashings: blkData.AttesterSlashings,
+			VoluntaryExits:    blkData.VoluntaryExits,
+			Graffiti:          blkData.Graffiti[:],
+			SyncAggregate:     syncAggregate,
+		},
+	}
+
+	// Compute state root with the newly constructed block.
+	wsb, err := wrapper.WrappedAltairSignedBeaconBlock(&ethpb.SignedBeaconBlockAltair{Block: blk, Signature: make([]byte, 96)})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not wrap block: %v", err)
+	}
+	stateRoot, err := vs.computeStateRoot(ctx, wsb)
+	if err != nil {
+		interop.WriteBlockToDisk(wsb, true /*failed*/)
+		return nil, status.Errorf(codes.Internal, "Could not compute state root: %v", err)
+	}
+	blk.StateRoot = stateRoot
+
+	return blk, nil
+}
+
+// ProposeBlockAltair is called by a proposer during its assigned slot to create an Altair block in an
+// attempt to get it processed by the beacon node as the canonical head.
+func (vs *Server) ProposeBlockAltair(ctx context.Context, rBlk *ethpb.SignedBeaconBlockAltair) (*ethpb.ProposeResponse, error) {
+	blk, err := wrapper.WrappedAltairSignedBeaconBlock(rBlk)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Could not wrap block: %v", err)
+	}
+	return vs.proposeGenericBeaconBlock(ctx, blk)
+}
+
+// getSyncAggregate builds the sync aggregate from the sync committee contributions in the pool for
+// the given slot and block root. For every subcommittee, the contributions are combined starting
+// from the one with the most participants, skipping any contribution which overlaps with the
+// participants already included.
+func (vs *Server) getSyncAggregate(slot types.Slot, root []byte) (*ethpb.SyncAggregate, error) {
+	contributions, err := vs.SyncCommitteePool.SyncCommitteeContributions(slot)
+	if err != nil {
+		return nil, err
+	}
+	subnetCount := params.BeaconConfig().SyncCommitteeSubnetCount
+	bySubnet := make([][]*ethpb.SyncCommitteeContribution, subnetCount)
+	for _, c := range contributions {
+		if c == nil || c.SubcommitteeIndex >= subnetCount || !bytes.Equal(c.BlockRoot, root) {
+			continue
+		}
+		bySubnet[c.SubcommitteeIndex] = append(bySubnet[c.SubcommitteeIndex], c)
+	}
+
+	var syncBits []byte
+	var sigs []bls.Signature
+	for _, cs := range bySubnet {
+		sort.SliceStable(cs, func(i, j int) bool {
+			return cs[i].AggregationBits.Count() > cs[j].AggregationBits.Count()
+		})
+		bits := ethpb.NewSyncCommitteeAggregationBits()
+		for _, c := range cs {
+			if len(c.AggregationBits) != len(bits) || overlaps(bits, c.AggregationBits) {
+				continue
+			}
+			sig, err := bls.SignatureFromBytes(c.Signature)
+			if err != nil {
+				continue
+			}
+			for i := range bits {
+				bits[i] |= c.AggregationBits[i]
+			}
+			sigs = append(sigs, sig)
+		}
+		syncBits = append(syncBits, bits...)
+	}
+
+	syncSig := common.InfiniteSignature
+	if len(sigs) > 0 {
+		copy(syncSig[:], bls.AggregateSignatures(sigs).Marshal())
+	}
+	return &ethpb.SyncAggregate{
+		SyncCommitteeBits:      syncBits,
+		SyncCommitteeSignature: syncSig[:],
+	}, nil
+}
+
+// overlaps returns true if the two equally sized bit vectors have any bit set in common.
+func overlaps(a, b []byte) bool {
+	for i := range a {
+		if a[i]&b[i] != 0 {
+			return true
+		}
+	}
+	return false
+}
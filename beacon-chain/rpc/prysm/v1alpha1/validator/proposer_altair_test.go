@@ -0,0 +1,61 @@
+package validator
+
+import (
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bls/common"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestProposer_GetSyncAggregate_OK(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	root := bytesutil.PadTo([]byte{'a'}, 32)
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign(root).Marshal()
+	pool := synccommittee.NewPool()
+
+	bits := func(indices ...uint64) []byte {
+		b := ethpb.NewSyncCommitteeAggregationBits()
+		for _, i := range indices {
+			b.SetBitAt(i, true)
+		}
+		return b
+	}
+	contributions := []*ethpb.SyncCommitteeContribution{
+		{Slot: 1, BlockRoot: root, SubcommitteeIndex: 0, AggregationBits: bits(0, 1), Signature: sig},
+		// Overlaps with the larger contribution above, so it is skipped.
+		{Slot: 1, BlockRoot: root, SubcommitteeIndex: 0, AggregationBits: bits(1), Signature: sig},
+		{Slot: 1, BlockRoot: root, SubcommitteeIndex: 0, AggregationBits: bits(2), Signature: sig},
+		{Slot: 1, BlockRoot: root, SubcommitteeIndex: 1, AggregationBits: bits(3), Signature: sig},
+		// Signs over a different block root, so it is skipped.
+		{Slot: 1, BlockRoot: bytesutil.PadTo([]byte{'b'}, 32), SubcommitteeIndex: 2, AggregationBits: bits(0), Signature: sig},
+	}
+	for _, c := range contributions {
+		require.NoError(t, pool.SaveSyncCommitteeContribution(c))
+	}
+	server := &Server{SyncCommitteePool: pool}
+
+	aggregate, err := server.getSyncAggregate(1, root)
+	require.NoError(t, err)
+	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	require.Equal(t, params.BeaconConfig().SyncCommitteeSize/8, uint64(len(aggregate.SyncCommitteeBits)))
+	for i := uint64(0); i < params.BeaconConfig().SyncCommitteeSize; i++ {
+		set := aggregate.SyncCommitteeBits[i/8]&(1<<(i%8)) != 0
+		want := i == 0 || i == 1 || i == 2 || i == subCommitteeSize+3
+		assert.Equal(t, want, set, "Unexpected bit %d", i)
+	}
+	assert.DeepNotEqual(t, common.InfiniteSignature[:], aggregate.SyncCommitteeSignature)
+
+	// No contributions for the slot result in an empty aggregate.
+	aggregate, err = server.getSyncAggregate(2, root)
+	require.NoError(t, err)
+	assert.DeepEqual(t, common.InfiniteSignature[:], aggregate.SyncCommitteeSignature)
+}
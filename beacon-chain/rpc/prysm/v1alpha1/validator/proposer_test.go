@@ -2040,3 +2040,61 @@ func majorityVoteBoundaryTime(slot types.Slot) (uint64, uint64) {
 
 	return earliestValidTime, latestValidTime
 }
+
+func TestProposer_DepositTrie_RebuildTrieFromSnapshot(t *testing.T) {
+	ctx := context.Background()
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	var mockSig [96]byte
+	var mockCreds [32]byte
+	deposits := make([]*ethpb.Deposit, 5)
+	roots := make([][]byte, len(deposits))
+	for i := range deposits {
+		deposits[i] = &ethpb.Deposit{
+			Data: &ethpb.Deposit_Data{
+				PublicKey:             bytesutil.PadTo([]byte{byte(i)}, 48),
+				Signature:             mockSig[:],
+				WithdrawalCredentials: mockCreds[:],
+			},
+		}
+		root, err := deposits[i].Data.HashTreeRoot()
+		require.NoError(t, err)
+		roots[i] = root[:]
+	}
+	snapshotTrie, err := trieutil.GenerateTrieFromItems(roots[:3], depth)
+	require.NoError(t, err)
+	finalized, err := snapshotTrie.FinalizedBranch(3)
+	require.NoError(t, err)
+	snapshotRoot := snapshotTrie.HashTreeRoot()
+	fullTrie, err := trieutil.GenerateTrieFromItems(roots, depth)
+	require.NoError(t, err)
+
+	// The deposits of the snapshot are not in the deposit cache.
+	depositCache, err := depositcache.New()
+	require.NoError(t, err)
+	require.NoError(t, depositCache.InsertDepositSnapshot(ctx, &dbpb.DepositSnapshot{
+		Finalized:            finalized,
+		DepositRoot:          snapshotRoot[:],
+		DepositCount:         3,
+		ExecutionBlockHash:   make([]byte, 32),
+		ExecutionBlockHeight: 10,
+	}))
+	require.NoError(t, depositCache.InsertDeposit(ctx, deposits[3], 11, 3, [32]byte{'a'}))
+	require.NoError(t, depositCache.InsertDeposit(ctx, deposits[4], 12, 4, fullTrie.HashTreeRoot()))
+
+	bs := &Server{DepositFetcher: depositCache}
+	fullRoot := fullTrie.HashTreeRoot()
+	trie, err := bs.rebuildDepositTrie(ctx, &ethpb.Eth1Data{
+		DepositRoot:  fullRoot[:],
+		DepositCount: 5,
+	}, big.NewInt(12))
+	require.NoError(t, err)
+	assert.Equal(t, 5, trie.NumOfItems())
+	assert.Equal(t, fullRoot, trie.HashTreeRoot())
+
+	// Only the deposits up to the given height are added to the snapshot deposits.
+	trie, err = bs.rebuildDepositTrie(ctx, &ethpb.Eth1Data{}, big.NewInt(11))
+	require.NoError(t, err)
+	partialTrie, err := trieutil.GenerateTrieFromItems(roots[:4], depth)
+	require.NoError(t, err)
+	assert.Equal(t, partialTrie.HashTreeRoot(), trie.HashTreeRoot())
+}
@@ -0,0 +1,179 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/altair"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bls/common"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// GetSyncMessageBlockRoot retrieves the block root which sync committee members sign over for
+// the current slot, which is the root of the head block of the chain.
+func (vs *Server) GetSyncMessageBlockRoot(ctx context.Context, _ *emptypb.Empty) (*ethpb.SyncMessageBlockRootResponse, error) {
+	r, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
+	}
+	return &ethpb.SyncMessageBlockRootResponse{
+		Root: r,
+	}, nil
+}
+
+// SubmitSyncMessage broadcasts the sync committee message to the subnets of every subcommittee
+// the validator belongs to, and saves it in the pool for sync committee aggregators.
+func (vs *Server) SubmitSyncMessage(ctx context.Context, msg *ethpb.SyncCommitteeMessage) (*emptypb.Empty, error) {
+	indices, err := altair.SyncSubcommitteeIndices(ctx, vs.HeadFetcher, msg.ValidatorIndex, msg.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync subcommittee indices: %v", err)
+	}
+	if len(indices) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "Validator %d is not in the sync committee at slot %d", msg.ValidatorIndex, msg.Slot)
+	}
+
+	// Broadcast to every subnet in parallel, a failure on one subnet should not prevent the others.
+	errs, gctx := errgroup.WithContext(ctx)
+	for _, subnet := range altair.SyncSubnets(indices) {
+		subnet := subnet
+		errs.Go(func() error {
+			return vs.P2P.BroadcastSyncCommitteeMessage(gctx, subnet, msg)
+		})
+	}
+	if err := vs.SyncCommitteePool.SaveSyncCommitteeMessage(msg); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save sync committee message: %v", err)
+	}
+	vs.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.SyncCommitteeMessageReceived,
+		Data: &opfeed.SyncCommitteeMessageReceivedData{
+			Message: msg,
+		},
+	})
+	if err := errs.Wait(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast sync committee message: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSyncSubcommitteeIndex returns the positions of the validator in the sync committee of the
+// requested slot. Validators use them to determine their sync subcommittees for aggregation.
+func (vs *Server) GetSyncSubcommitteeIndex(
+	ctx context.Context, req *ethpb.SyncSubcommitteeIndexRequest,
+) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	index, exists := vs.HeadFetcher.HeadPublicKeyToValidatorIndex(ctx, bytesutil.ToBytes48(req.PublicKey))
+	if !exists {
+		return nil, status.Errorf(codes.NotFound, "Public key %#x does not exist in state", bytesutil.Trunc(req.PublicKey))
+	}
+	indices, err := altair.SyncSubcommitteeIndices(ctx, vs.HeadFetcher, index, req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync subcommittee indices: %v", err)
+	}
+	return &ethpb.SyncSubcommitteeIndexResponse{Indices: indices}, nil
+}
+
+// GetSyncCommitteeContribution is called by a sync committee aggregator to retrieve the aggregate
+// of the sync committee messages of its subcommittee for the slot and the current head block root.
+func (vs *Server) GetSyncCommitteeContribution(
+	ctx context.Context, req *ethpb.SyncCommitteeContributionRequest,
+) (*ethpb.SyncCommitteeContribution, error) {
+	if req.SubnetId >= params.BeaconConfig().SyncCommitteeSubnetCount {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid subnet id %d", req.SubnetId)
+	}
+	msgs, err := vs.SyncCommitteePool.SyncCommitteeMessages(req.Slot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not get sync committee messages: %v", err)
+	}
+	headRoot, err := vs.HeadFetcher.HeadRoot(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not retrieve head root: %v", err)
+	}
+	sig, bits, err := vs.aggregatedSigAndAggregationBits(ctx, msgs, req.Slot, req.SubnetId, headRoot)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not aggregate sync committee messages: %v", err)
+	}
+	return &ethpb.SyncCommitteeContribution{
+		Slot:              req.Slot,
+		BlockRoot:         headRoot,
+		SubcommitteeIndex: req.SubnetId,
+		AggregationBits:   bits,
+		Signature:         sig,
+	}, nil
+}
+
+// SubmitSignedContributionAndProof is called by a sync committee aggregator to broadcast its
+// signed contribution and proof, which is also saved in the pool for block proposals.
+func (vs *Server) SubmitSignedContributionAndProof(
+	ctx context.Context, s *ethpb.SignedContributionAndProof,
+) (*emptypb.Empty, error) {
+	if s == nil || s.Message == nil || s.Message.Contribution == nil {
+		return nil, status.Error(codes.InvalidArgument, "Nil contribution and proof")
+	}
+	errs, gctx := errgroup.WithContext(ctx)
+	errs.Go(func() error {
+		return vs.P2P.Broadcast(gctx, s)
+	})
+	if err := vs.SyncCommitteePool.SaveSyncCommitteeContribution(s.Message.Contribution); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not save sync committee contribution: %v", err)
+	}
+	vs.OperationNotifier.OperationFeed().Send(&feed.Event{
+		Type: opfeed.SyncCommitteeContributionReceived,
+		Data: &opfeed.SyncCommitteeContributionReceivedData{
+			Contribution: s,
+		},
+	})
+	if err := errs.Wait(); err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not broadcast contribution and proof: %v", err)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// aggregatedSigAndAggregationBits aggregates the signatures of the messages for the block root by
+// the members of the given subcommittee, returning the aggregate signature and participation bits.
+func (vs *Server) aggregatedSigAndAggregationBits(
+	ctx context.Context,
+	msgs []*ethpb.SyncCommitteeMessage,
+	slot types.Slot,
+	subnetID uint64,
+	blockRoot []byte,
+) ([]byte, []byte, error) {
+	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	sigs := make([]bls.Signature, 0, subCommitteeSize)
+	bits := ethpb.NewSyncCommitteeAggregationBits()
+	for _, msg := range msgs {
+		if !bytes.Equal(blockRoot, msg.BlockRoot) {
+			continue
+		}
+		indices, err := altair.SyncSubcommitteeIndices(ctx, vs.HeadFetcher, msg.ValidatorIndex, slot)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, index := range indices {
+			i := uint64(index)
+			if i/subCommitteeSize != subnetID || bits.BitAt(i%subCommitteeSize) {
+				continue
+			}
+			sig, err := bls.SignatureFromBytes(msg.Signature)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "could not unmarshal signature of validator %d", msg.ValidatorIndex)
+			}
+			bits.SetBitAt(i%subCommitteeSize, true)
+			sigs = append(sigs, sig)
+		}
+	}
+	aggregatedSig := common.InfiniteSignature
+	if len(sigs) > 0 {
+		copy(aggregatedSig[:], bls.AggregateSignatures(sigs).Marshal())
+	}
+	return aggregatedSig[:], bits, nil
+}
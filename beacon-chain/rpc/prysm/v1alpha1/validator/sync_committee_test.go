@@ -0,0 +1,176 @@
+package validator
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	opfeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/operation"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
+	mockp2p "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestGetSyncMessageBlockRoot_OK(t *testing.T) {
+	r := []byte{'a'}
+	server := &Server{
+		HeadFetcher: &mock.ChainService{Root: r},
+	}
+	res, err := server.GetSyncMessageBlockRoot(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	require.DeepEqual(t, r, res.Root)
+}
+
+func TestSubmitSyncMessage_OK(t *testing.T) {
+	broadcaster := &mockp2p.MockBroadcaster{}
+	server := &Server{
+		HeadFetcher:       &mock.ChainService{CurrentSyncCommitteeIndices: []types.CommitteeIndex{1}},
+		SyncCommitteePool: synccommittee.NewPool(),
+		P2P:               broadcaster,
+		OperationNotifier: (&mock.ChainService{}).OperationNotifier(),
+	}
+	opChannel := make(chan *feed.Event, 1)
+	opSub := server.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+	msg := &ethpb.SyncCommitteeMessage{
+		Slot:           1,
+		ValidatorIndex: 2,
+	}
+	_, err := server.SubmitSyncMessage(context.Background(), msg)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+	savedMsgs, err := server.SyncCommitteePool.SyncCommitteeMessages(1)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*ethpb.SyncCommitteeMessage{msg}, savedMsgs)
+
+	event := <-opChannel
+	assert.Equal(t, opfeed.SyncCommitteeMessageReceived, int(event.Type))
+	data, ok := event.Data.(*opfeed.SyncCommitteeMessageReceivedData)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, msg, data.Message)
+}
+
+func TestSubmitSyncMessage_NotInCommittee(t *testing.T) {
+	server := &Server{
+		HeadFetcher:       &mock.ChainService{},
+		SyncCommitteePool: synccommittee.NewPool(),
+		P2P:               &mockp2p.MockBroadcaster{},
+	}
+	_, err := server.SubmitSyncMessage(context.Background(), &ethpb.SyncCommitteeMessage{Slot: 1, ValidatorIndex: 2})
+	require.ErrorContains(t, "is not in the sync committee", err)
+}
+
+func TestGetSyncSubcommitteeIndex_Ok(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	params.OverrideBeaconConfig(params.MainnetConfig())
+	server := &Server{
+		HeadFetcher: &mock.ChainService{
+			CurrentSyncCommitteeIndices: []types.CommitteeIndex{0},
+			NextSyncCommitteeIndices:    []types.CommitteeIndex{1},
+		},
+	}
+	pubKey := [48]byte{}
+	// Request slot 0, should get the index 0 for validator 0.
+	res, err := server.GetSyncSubcommitteeIndex(context.Background(), &ethpb.SyncSubcommitteeIndexRequest{
+		PublicKey: pubKey[:], Slot: types.Slot(0),
+	})
+	require.NoError(t, err)
+	require.DeepEqual(t, []types.CommitteeIndex{0}, res.Indices)
+
+	// Request at the last slot of the period, should get the index 1 for validator 0.
+	periodSlots := types.Slot(params.BeaconConfig().EpochsPerSyncCommitteePeriod) * params.BeaconConfig().SlotsPerEpoch
+	res, err = server.GetSyncSubcommitteeIndex(context.Background(), &ethpb.SyncSubcommitteeIndexRequest{
+		PublicKey: pubKey[:], Slot: periodSlots - 1,
+	})
+	require.NoError(t, err)
+	require.DeepEqual(t, []types.CommitteeIndex{1}, res.Indices)
+}
+
+func TestGetSyncCommitteeContribution_OK(t *testing.T) {
+	root := bytesutil.PadTo([]byte{'a'}, 32)
+	pool := synccommittee.NewPool()
+	subCommitteeSize := params.BeaconConfig().SyncCommitteeSize / params.BeaconConfig().SyncCommitteeSubnetCount
+	priv, err := bls.RandKey()
+	require.NoError(t, err)
+	sig := priv.Sign(root).Marshal()
+	require.NoError(t, pool.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{
+		Slot:           1,
+		BlockRoot:      root,
+		ValidatorIndex: 0,
+		Signature:      sig,
+	}))
+	// Messages over a different block root are not aggregated.
+	require.NoError(t, pool.SaveSyncCommitteeMessage(&ethpb.SyncCommitteeMessage{
+		Slot:           1,
+		BlockRoot:      bytesutil.PadTo([]byte{'b'}, 32),
+		ValidatorIndex: 1,
+		Signature:      sig,
+	}))
+	server := &Server{
+		HeadFetcher: &mock.ChainService{
+			Root:                        root,
+			CurrentSyncCommitteeIndices: []types.CommitteeIndex{types.CommitteeIndex(subCommitteeSize + 3)},
+		},
+		SyncCommitteePool: pool,
+	}
+
+	contribution, err := server.GetSyncCommitteeContribution(context.Background(), &ethpb.SyncCommitteeContributionRequest{
+		Slot:     1,
+		SubnetId: 1,
+	})
+	require.NoError(t, err)
+	assert.DeepEqual(t, root, contribution.BlockRoot)
+	assert.Equal(t, uint64(1), contribution.AggregationBits.Count())
+	assert.Equal(t, true, contribution.AggregationBits.BitAt(3))
+	assert.DeepEqual(t, sig, contribution.Signature)
+
+	_, err = server.GetSyncCommitteeContribution(context.Background(), &ethpb.SyncCommitteeContributionRequest{
+		Slot:     1,
+		SubnetId: params.BeaconConfig().SyncCommitteeSubnetCount,
+	})
+	require.ErrorContains(t, "Invalid subnet id", err)
+}
+
+func TestSubmitSignedContributionAndProof_OK(t *testing.T) {
+	broadcaster := &mockp2p.MockBroadcaster{}
+	server := &Server{
+		HeadFetcher:       &mock.ChainService{},
+		SyncCommitteePool: synccommittee.NewPool(),
+		P2P:               broadcaster,
+		OperationNotifier: (&mock.ChainService{}).OperationNotifier(),
+	}
+	opChannel := make(chan *feed.Event, 1)
+	opSub := server.OperationNotifier.OperationFeed().Subscribe(opChannel)
+	defer opSub.Unsubscribe()
+	contribution := &ethpb.SignedContributionAndProof{
+		Message: &ethpb.ContributionAndProof{
+			Contribution: &ethpb.SyncCommitteeContribution{
+				Slot:              1,
+				SubcommitteeIndex: 2,
+			},
+		},
+	}
+	_, err := server.SubmitSignedContributionAndProof(context.Background(), contribution)
+	require.NoError(t, err)
+	assert.Equal(t, true, broadcaster.BroadcastCalled)
+	savedContributions, err := server.SyncCommitteePool.SyncCommitteeContributions(1)
+	require.NoError(t, err)
+	require.DeepEqual(t, []*ethpb.SyncCommitteeContribution{contribution.Message.Contribution}, savedContributions)
+
+	event := <-opChannel
+	assert.Equal(t, opfeed.SyncCommitteeContributionReceived, int(event.Type))
+	data, ok := event.Data.(*opfeed.SyncCommitteeContributionReceivedData)
+	require.Equal(t, true, ok)
+	assert.DeepEqual(t, contribution, data.Contribution)
+
+	_, err = server.SubmitSignedContributionAndProof(context.Background(), &ethpb.SignedContributionAndProof{})
+	require.ErrorContains(t, "Nil contribution and proof", err)
+}
@@ -22,12 +22,14 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/db"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/attestations"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/synccommittee"
 	"github.com/prysmaticlabs/prysm/beacon-chain/operations/voluntaryexits"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/beacon-chain/powchain"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/beacon"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/debug"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/events"
+	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/lightclient"
 	node "github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/node"
 	"github.com/prysmaticlabs/prysm/beacon-chain/rpc/eth/validator"
 	beaconv1alpha1 "github.com/prysmaticlabs/prysm/beacon-chain/rpc/prysm/v1alpha1/beacon"
@@ -94,10 +96,14 @@ type Config struct {
 	AttestationsPool        attestations.Pool
 	ExitPool                voluntaryexits.PoolManager
 	SlashingsPool           slashings.PoolManager
+	SyncCommitteeObjectPool synccommittee.Pool
 	SyncService             chainSync.Checker
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
 	PeerManager             p2p.PeerManager
+	AccessListManager       p2p.AccessListManager
+	Eth1HealthFetcher       powchain.EndpointHealthFetcher
+	DepositSnapshotFetcher  powchain.DepositSnapshotFetcher
 	MetadataProvider        p2p.MetadataProvider
 	DepositFetcher          depositcache.DepositFetcher
 	PendingDepositFetcher   depositcache.PendingDepositsFetcher
@@ -191,6 +197,7 @@ func (s *Service) Start() {
 		PendingDepositsFetcher: s.cfg.PendingDepositFetcher,
 		SlashingsPool:          s.cfg.SlashingsPool,
 		StateGen:               s.cfg.StateGen,
+		SyncCommitteePool:      s.cfg.SyncCommitteeObjectPool,
 	}
 	validatorServerV1 := &validator.Server{
 		HeadFetcher:      s.cfg.HeadFetcher,
@@ -211,6 +218,8 @@ func (s *Service) Start() {
 		GenesisTimeFetcher:   s.cfg.GenesisTimeFetcher,
 		PeersFetcher:         s.cfg.PeersFetcher,
 		PeerManager:          s.cfg.PeerManager,
+		AccessListManager:    s.cfg.AccessListManager,
+		Eth1HealthFetcher:    s.cfg.Eth1HealthFetcher,
 		GenesisFetcher:       s.cfg.GenesisFetcher,
 		BeaconMonitoringHost: s.cfg.BeaconMonitoringHost,
 		BeaconMonitoringPort: s.cfg.BeaconMonitoringPort,
@@ -267,6 +276,7 @@ func (s *Service) Start() {
 		},
 		HeadFetcher:        s.cfg.HeadFetcher,
 		VoluntaryExitsPool: s.cfg.ExitPool,
+		SyncCommitteePool:  s.cfg.SyncCommitteeObjectPool,
 	}
 	ethpbv1alpha1.RegisterNodeServer(s.grpcServer, nodeServer)
 	ethpbservice.RegisterBeaconNodeServer(s.grpcServer, nodeServerV1)
@@ -279,15 +289,22 @@ func (s *Service) Start() {
 		BlockNotifier:     s.cfg.BlockNotifier,
 		OperationNotifier: s.cfg.OperationNotifier,
 	})
+	if featureconfig.Get().EnableLightClientServer {
+		ethpbservice.RegisterBeaconLightClientServer(s.grpcServer, &lightclient.Server{
+			BeaconDB:        s.cfg.BeaconDB,
+			StateGenService: s.cfg.StateGen,
+		})
+	}
 	if s.cfg.EnableDebugRPCEndpoints {
 		log.Info("Enabled debug gRPC endpoints")
 		debugServer := &debugv1alpha1.Server{
-			GenesisTimeFetcher: s.cfg.GenesisTimeFetcher,
-			BeaconDB:           s.cfg.BeaconDB,
-			StateGen:           s.cfg.StateGen,
-			HeadFetcher:        s.cfg.HeadFetcher,
-			PeerManager:        s.cfg.PeerManager,
-			PeersFetcher:       s.cfg.PeersFetcher,
+			GenesisTimeFetcher:     s.cfg.GenesisTimeFetcher,
+			BeaconDB:               s.cfg.BeaconDB,
+			StateGen:               s.cfg.StateGen,
+			HeadFetcher:            s.cfg.HeadFetcher,
+			PeerManager:            s.cfg.PeerManager,
+			PeersFetcher:           s.cfg.PeersFetcher,
+			DepositSnapshotFetcher: s.cfg.DepositSnapshotFetcher,
 		}
 		debugServerV1 := &debug.Server{
 			BeaconDB:    s.cfg.BeaconDB,
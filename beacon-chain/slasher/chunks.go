@@ -0,0 +1,467 @@
+package slasher
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+// Chunker defines a struct which represents a slice containing a chunk for K different validator's
+// min or max spans used for surround vote detection in slasher. The interface defines methods used
+// to check if an attestation is slashable for a validator index based on the contents of
+// the chunk as well as the ability to update the data in the chunk with incoming information.
+type Chunker interface {
+	NeutralElement() uint16
+	Chunk() []uint16
+	CheckSlashable(
+		ctx context.Context,
+		slasherDB db.SlasherDatabase,
+		validatorIdx types.ValidatorIndex,
+		attestation *slashertypes.IndexedAttestationWrapper,
+	) (*ethpb.AttesterSlashing, error)
+	Update(
+		chunkIndex uint64,
+		currentEpoch types.Epoch,
+		validatorIdx types.ValidatorIndex,
+		startEpoch,
+		newTargetEpoch types.Epoch,
+	) (keepGoing bool, err error)
+	StartEpoch(sourceEpoch, currentEpoch types.Epoch) (epoch types.Epoch, exists bool)
+	NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch
+}
+
+// MinSpanChunksSlice represents a slice containing a chunk for K different validator's min spans.
+//
+// For a given epoch, e, and attestations a validator index has produced, atts,
+// min_spans[e] is defined as min((att.target.epoch - e) for att in attestations)
+// where att.source.epoch > e. That is, it is the minimum distance between the
+// specified epoch and all attestation target epochs a validator has created
+// where att.source.epoch > e.
+//
+// Under ideal network conditions, where every target epoch immediately follows its source,
+// min spans for a validator will look as follows:
+//
+//  min_spans = [2, 2, 2, ..., 2]
+//
+// Next, we can chunk this list of min spans into chunks of length C. For C = 2, for example:
+//
+//                       chunk0  chunk1       chunkN
+//                        {  }   {   }         {  }
+//  chunked_min_spans = [[2, 2], [2, 2], ..., [2, 2]]
+//
+// Finally, we can store each chunk index for K validators into a single flat slice. For K = 3:
+//
+//                                val0    val1    val2
+//                                {  }    {  }    {  }
+//  chunk_0_for_validators_0_to_2 = [2, 2, 2, 2, 2, 2]
+//
+//                                val0    val1    val2
+//                                {  }    {  }    {  }
+//  chunk_1_for_validators_0_to_2 = [2, 2, 2, 2, 2, 2]
+//
+//                                  ...
+//
+//                                val0    val1    val2
+//                                {  }    {  }    {  }
+//  chunk_N_for_validators_0_to_2 = [2, 2, 2, 2, 2, 2]
+type MinSpanChunksSlice struct {
+	params *Parameters
+	data   []uint16
+}
+
+// MaxSpanChunksSlice represents the same data structure as MinSpanChunksSlice however
+// keeps track of validator max spans for slashing detection instead.
+type MaxSpanChunksSlice struct {
+	params *Parameters
+	data   []uint16
+}
+
+// EmptyMinSpanChunksSlice initializes a min span chunk of length C*K for
+// C = chunkSize and K = validatorChunkSize filled with neutral elements.
+// For min spans, the neutral element is `undefined`, represented by MaxUint16.
+func EmptyMinSpanChunksSlice(params *Parameters) *MinSpanChunksSlice {
+	m := &MinSpanChunksSlice{
+		params: params,
+	}
+	data := make([]uint16, params.chunkSize*params.validatorChunkSize)
+	for i := 0; i < len(data); i++ {
+		data[i] = m.NeutralElement()
+	}
+	m.data = data
+	return m
+}
+
+// EmptyMaxSpanChunksSlice initializes a max span chunk of length C*K for
+// C = chunkSize and K = validatorChunkSize filled with neutral elements.
+// For max spans, the neutral element is 0.
+func EmptyMaxSpanChunksSlice(params *Parameters) *MaxSpanChunksSlice {
+	m := &MaxSpanChunksSlice{
+		params: params,
+	}
+	data := make([]uint16, params.chunkSize*params.validatorChunkSize)
+	for i := 0; i < len(data); i++ {
+		data[i] = m.NeutralElement()
+	}
+	m.data = data
+	return m
+}
+
+// MinChunkSpansSliceFrom initializes a min span chunks slice from a slice of uint16 values.
+// Returns an error if the slice is not of length C*K for C = chunkSize and K = validatorChunkSize.
+func MinChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MinSpanChunksSlice, error) {
+	requiredLen := params.chunkSize * params.validatorChunkSize
+	if uint64(len(chunk)) != requiredLen {
+		return nil, fmt.Errorf("chunk has wrong length, %d, expected %d", len(chunk), requiredLen)
+	}
+	return &MinSpanChunksSlice{
+		params: params,
+		data:   chunk,
+	}, nil
+}
+
+// MaxChunkSpansSliceFrom initializes a max span chunks slice from a slice of uint16 values.
+// Returns an error if the slice is not of length C*K for C = chunkSize and K = validatorChunkSize.
+func MaxChunkSpansSliceFrom(params *Parameters, chunk []uint16) (*MaxSpanChunksSlice, error) {
+	requiredLen := params.chunkSize * params.validatorChunkSize
+	if uint64(len(chunk)) != requiredLen {
+		return nil, fmt.Errorf("chunk has wrong length, %d, expected %d", len(chunk), requiredLen)
+	}
+	return &MaxSpanChunksSlice{
+		params: params,
+		data:   chunk,
+	}, nil
+}
+
+// NeutralElement for a min span chunks slice is undefined, in this case
+// using MaxUint16 as a sane value given it is impossible we reach it.
+func (_ *MinSpanChunksSlice) NeutralElement() uint16 {
+	return math.MaxUint16
+}
+
+// NeutralElement for a max span chunks slice is 0.
+func (_ *MaxSpanChunksSlice) NeutralElement() uint16 {
+	return 0
+}
+
+// Chunk returns the underlying slice of uint16's for the min chunks slice.
+func (m *MinSpanChunksSlice) Chunk() []uint16 {
+	return m.data
+}
+
+// Chunk returns the underlying slice of uint16's for the max chunks slice.
+func (m *MaxSpanChunksSlice) Chunk() []uint16 {
+	return m.data
+}
+
+// CheckSlashable takes in a validator index and an incoming attestation
+// and checks if the validator is slashable depending on the data
+// within the min span chunks slice. Recall that for an incoming attestation, B, and an
+// existing attestation, A:
+//
+//  B surrounds A if and only if B.target > min_spans[B.source]
+//
+// That is, this condition is sufficient to check if an incoming attestation
+// is surrounding a previous one. We also check if we indeed have an existing
+// attestation record in the database if the condition holds true in order
+// to be confident of a slashable offense.
+func (m *MinSpanChunksSlice) CheckSlashable(
+	ctx context.Context,
+	slasherDB db.SlasherDatabase,
+	validatorIdx types.ValidatorIndex,
+	attestation *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	sourceEpoch := attestation.IndexedAttestation.Data.Source.Epoch
+	targetEpoch := attestation.IndexedAttestation.Data.Target.Epoch
+	minTarget, err := chunkDataAtEpoch(m.params, m.data, validatorIdx, sourceEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "could not get min target for validator %d at epoch %d", validatorIdx, sourceEpoch,
+		)
+	}
+	if targetEpoch <= minTarget {
+		return nil, nil
+	}
+	existingAttRecord, err := slasherDB.AttestationRecordForValidator(ctx, validatorIdx, minTarget)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "could not get existing attestation record at target %d", minTarget,
+		)
+	}
+	if existingAttRecord == nil || sourceEpoch >= existingAttRecord.IndexedAttestation.Data.Source.Epoch {
+		return nil, nil
+	}
+	surroundingVotesTotal.Inc()
+	return &ethpb.AttesterSlashing{
+		Attestation_1: attestation.IndexedAttestation,
+		Attestation_2: existingAttRecord.IndexedAttestation,
+	}, nil
+}
+
+// CheckSlashable takes in a validator index and an incoming attestation
+// and checks if the validator is slashable depending on the data
+// within the max span chunks slice. Recall that for an incoming attestation, B, and an
+// existing attestation, A:
+//
+//  B is surrounded by A if and only if B.target < max_spans[B.source]
+//
+// That is, this condition is sufficient to check if an incoming attestation
+// is surrounded by a previous one. We also check if we indeed have an existing
+// attestation record in the database if the condition holds true in order
+// to be confident of a slashable offense.
+func (m *MaxSpanChunksSlice) CheckSlashable(
+	ctx context.Context,
+	slasherDB db.SlasherDatabase,
+	validatorIdx types.ValidatorIndex,
+	attestation *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	sourceEpoch := attestation.IndexedAttestation.Data.Source.Epoch
+	targetEpoch := attestation.IndexedAttestation.Data.Target.Epoch
+	maxTarget, err := chunkDataAtEpoch(m.params, m.data, validatorIdx, sourceEpoch)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "could not get max target for validator %d at epoch %d", validatorIdx, sourceEpoch,
+		)
+	}
+	if targetEpoch >= maxTarget {
+		return nil, nil
+	}
+	existingAttRecord, err := slasherDB.AttestationRecordForValidator(ctx, validatorIdx, maxTarget)
+	if err != nil {
+		return nil, errors.Wrapf(
+			err, "could not get existing attestation record at target %d", maxTarget,
+		)
+	}
+	if existingAttRecord == nil || existingAttRecord.IndexedAttestation.Data.Source.Epoch >= sourceEpoch {
+		return nil, nil
+	}
+	surroundedVotesTotal.Inc()
+	return &ethpb.AttesterSlashing{
+		Attestation_1: existingAttRecord.IndexedAttestation,
+		Attestation_2: attestation.IndexedAttestation,
+	}, nil
+}
+
+// Update a min span chunk for a validator index starting at the current epoch, e_c, then updating
+// down to e_c - H where H is the historyLength we keep for each span. This historical length
+// is typically the weak subjectivity period of a chain. For min spans, we keep updating
+// the span as long as the target of the new attestation is smaller than the existing value,
+// as the spans are monotonically increasing as we go down the epochs.
+//
+// For example, given min spans for epochs 0 to 3 of a validator which attested with
+// source 1 and target 2, then with source 3 and target 4:
+//
+//  min_spans = [2, 3, 2, -]
+//
+// An incoming attestation with source 3 and target 5 updates the spans starting at epoch 2,
+// which holds a target of 4. As 5 >= 4, the update stops right away and spans are unchanged.
+// An incoming attestation with source 2 and target 3 instead updates epoch 1 to a distance
+// of 2, and then stops at epoch 0 which already holds the target 2 < 3:
+//
+//  min_spans = [2, 2, 2, -]
+//
+// The function returns true if the update should continue in the previous chunk.
+func (m *MinSpanChunksSlice) Update(
+	chunkIndex uint64,
+	currentEpoch types.Epoch,
+	validatorIdx types.ValidatorIndex,
+	startEpoch,
+	newTargetEpoch types.Epoch,
+) (keepGoing bool, err error) {
+	minEpoch := minSpanEpoch(m.params, currentEpoch)
+	epochInChunk := startEpoch
+	// We go down the chunk for the validator, updating every value starting at startEpoch down to minEpoch.
+	// As long as the epoch, e, in the same chunk index and e >= minEpoch, we proceed with
+	// a for loop.
+	for m.params.chunkIndex(epochInChunk) == chunkIndex && epochInChunk >= minEpoch {
+		chunkTarget, err := chunkDataAtEpoch(m.params, m.data, validatorIdx, epochInChunk)
+		if err != nil {
+			return false, errors.Wrapf(err, "could not get chunk data at epoch %d", epochInChunk)
+		}
+		// If the newly incoming value is >= the chunk's current value, we can stop the loop
+		// as the existing spans further down are lower than the incoming target as well.
+		if newTargetEpoch >= chunkTarget {
+			return false, nil
+		}
+		if err := setChunkDataAtEpoch(m.params, m.data, validatorIdx, epochInChunk, newTargetEpoch); err != nil {
+			return false, err
+		}
+		if epochInChunk == 0 {
+			return false, nil
+		}
+		epochInChunk--
+	}
+	// If the epoch to update now lies beyond the min epoch, we stop.
+	return epochInChunk >= minEpoch, nil
+}
+
+// Update a max span chunk for a validator index starting at a given start epoch, e_c, then updating
+// up to the current epoch according to the definition of max spans. If we need to continue updating
+// a next chunk, this function returns a boolean letting the caller know it should keep updating.
+// For max spans, we keep updating the span as long as the target of the new attestation is larger
+// than the existing value.
+func (m *MaxSpanChunksSlice) Update(
+	chunkIndex uint64,
+	currentEpoch types.Epoch,
+	validatorIdx types.ValidatorIndex,
+	startEpoch,
+	newTargetEpoch types.Epoch,
+) (keepGoing bool, err error) {
+	epochInChunk := startEpoch
+	// We go up the chunk for the validator, updating every value starting at startEpoch up to
+	// and including the current epoch. As long as the epoch, e, is in the same chunk index and e <= currentEpoch,
+	// we proceed with a for loop.
+	for m.params.chunkIndex(epochInChunk) == chunkIndex && epochInChunk <= currentEpoch {
+		chunkTarget, err := chunkDataAtEpoch(m.params, m.data, validatorIdx, epochInChunk)
+		if err != nil {
+			return false, errors.Wrapf(err, "could not get chunk data at epoch %d", epochInChunk)
+		}
+		// If the newly incoming value is <= the chunk's current value, we can stop the loop
+		// as the existing spans further up are greater than the incoming target as well.
+		if newTargetEpoch <= chunkTarget {
+			return false, nil
+		}
+		if err := setChunkDataAtEpoch(m.params, m.data, validatorIdx, epochInChunk, newTargetEpoch); err != nil {
+			return false, err
+		}
+		epochInChunk++
+	}
+	// If the epoch to update now lies beyond the current epoch, we stop.
+	return epochInChunk <= currentEpoch, nil
+}
+
+// StartEpoch given a source epoch and current epoch, determines the start epoch of
+// a min span chunk for use in chunk updates. To compute this value, we look at the difference between
+// H = historyLength and the current epoch. Then, we check if the source epoch > difference. If so,
+// then the start epoch is source epoch - 1. Otherwise, we return to the caller a boolean signifying
+// the input arguments are invalid for the chunk and the start epoch does not exist.
+func (m *MinSpanChunksSlice) StartEpoch(
+	sourceEpoch, currentEpoch types.Epoch,
+) (epoch types.Epoch, exists bool) {
+	// Min spans only cover epochs below the source epoch, none exist for the genesis epoch.
+	if sourceEpoch == 0 {
+		return
+	}
+	if sourceEpoch-1 < minSpanEpoch(m.params, currentEpoch) {
+		return
+	}
+	return sourceEpoch - 1, true
+}
+
+// StartEpoch given a source epoch and current epoch, determines the start epoch of
+// a max span chunk for use in chunk updates. The source epoch cannot be >= the current epoch.
+func (_ *MaxSpanChunksSlice) StartEpoch(
+	sourceEpoch, currentEpoch types.Epoch,
+) (epoch types.Epoch, exists bool) {
+	if sourceEpoch >= currentEpoch {
+		return
+	}
+	return sourceEpoch + 1, true
+}
+
+// NextChunkStartEpoch given an epoch, determines the start epoch of the next chunk
+// to update for a min span. Min spans are updated going down the epochs, so the
+// next chunk to update starts at the last epoch of the previous chunk.
+//
+//    chunk0      chunk1     chunk2
+//       |          |          |
+//  [[-, -, -], [-, -, -], [-, -, -], ...]
+//         |        |
+//         |        -> start epoch 4
+//         -> next chunk start epoch 2
+func (m *MinSpanChunksSlice) NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch {
+	return startEpoch - types.Epoch(m.params.chunkOffset(startEpoch)) - 1
+}
+
+// NextChunkStartEpoch given an epoch, determines the start epoch of the next chunk
+// to update for a max span. Max spans are updated going up the epochs, so the
+// next chunk to update starts at the first epoch of the next chunk.
+//
+//    chunk0      chunk1     chunk2
+//       |          |          |
+//  [[-, -, -], [-, -, -], [-, -, -], ...]
+//               |            |
+//               |            -> next chunk start epoch 6
+//               -> start epoch 3
+func (m *MaxSpanChunksSlice) NextChunkStartEpoch(startEpoch types.Epoch) types.Epoch {
+	return startEpoch - types.Epoch(m.params.chunkOffset(startEpoch)) + types.Epoch(m.params.chunkSize)
+}
+
+// The lowest epoch which is kept in the min spans of a validator at the current epoch.
+func minSpanEpoch(params *Parameters, currentEpoch types.Epoch) types.Epoch {
+	if currentEpoch < params.historyLength {
+		return 0
+	}
+	return currentEpoch - params.historyLength + 1
+}
+
+// Given a validator index and epoch, retrieves the target epoch at its specific
+// index for the validator index and epoch in a min/max span chunk.
+func chunkDataAtEpoch(
+	params *Parameters, chunk []uint16, validatorIdx types.ValidatorIndex, epoch types.Epoch,
+) (target types.Epoch, err error) {
+	distance, err := chunkDistanceAtEpoch(params, chunk, validatorIdx, epoch)
+	if err != nil {
+		return 0, err
+	}
+	return epoch + types.Epoch(distance), nil
+}
+
+// Given a validator index and epoch, retrieves the raw distance stored at its specific
+// index for the validator index and epoch in a min/max span chunk.
+func chunkDistanceAtEpoch(
+	params *Parameters, chunk []uint16, validatorIdx types.ValidatorIndex, epoch types.Epoch,
+) (uint16, error) {
+	requiredLen := params.chunkSize * params.validatorChunkSize
+	if uint64(len(chunk)) != requiredLen {
+		return 0, fmt.Errorf("chunk has wrong length, %d, expected %d", len(chunk), requiredLen)
+	}
+	cellIdx := params.cellIndex(validatorIdx, epoch)
+	if cellIdx >= uint64(len(chunk)) {
+		return 0, fmt.Errorf("cell index %d out of bounds (len(chunk) = %d)", cellIdx, len(chunk))
+	}
+	return chunk[cellIdx], nil
+}
+
+// Updates the value at a specific index in a chunk for a validator index + epoch
+// pair given a target epoch. Recall that for min spans, each element in a chunk
+// is the minimum distance between the a given epoch, e, and all attestation target epochs
+// a validator has produced with att.source.epoch > e.
+func setChunkDataAtEpoch(
+	params *Parameters,
+	chunk []uint16,
+	validatorIdx types.ValidatorIndex,
+	epochInChunk,
+	targetEpoch types.Epoch,
+) error {
+	if targetEpoch < epochInChunk {
+		return fmt.Errorf("target epoch %d cannot be less than epoch %d", targetEpoch, epochInChunk)
+	}
+	distance := targetEpoch - epochInChunk
+	if distance > math.MaxUint16 {
+		return fmt.Errorf("distance %d between epochs does not fit in a chunk", distance)
+	}
+	return setChunkRawDistance(params, chunk, validatorIdx, epochInChunk, uint16(distance))
+}
+
+// Updates the value at a specific index in a chunk for a validator index and epoch
+// to a specified, raw distance value.
+func setChunkRawDistance(
+	params *Parameters,
+	chunk []uint16,
+	validatorIdx types.ValidatorIndex,
+	epochInChunk types.Epoch,
+	distance uint16,
+) error {
+	cellIdx := params.cellIndex(validatorIdx, epochInChunk)
+	if cellIdx >= uint64(len(chunk)) {
+		return fmt.Errorf("cell index %d out of bounds (len(chunk) = %d)", cellIdx, len(chunk))
+	}
+	chunk[cellIdx] = distance
+	return nil
+}
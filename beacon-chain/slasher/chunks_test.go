@@ -0,0 +1,219 @@
+package slasher
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var (
+	_ = Chunker(&MinSpanChunksSlice{})
+	_ = Chunker(&MaxSpanChunksSlice{})
+)
+
+func TestMinSpanChunksSlice_Chunk(t *testing.T) {
+	chunk := EmptyMinSpanChunksSlice(&Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+	})
+	wanted := []uint16{math.MaxUint16, math.MaxUint16, math.MaxUint16, math.MaxUint16}
+	require.DeepEqual(t, wanted, chunk.Chunk())
+}
+
+func TestMaxSpanChunksSlice_Chunk(t *testing.T) {
+	chunk := EmptyMaxSpanChunksSlice(&Parameters{
+		chunkSize:          2,
+		validatorChunkSize: 2,
+	})
+	wanted := []uint16{0, 0, 0, 0}
+	require.DeepEqual(t, wanted, chunk.Chunk())
+}
+
+func TestMinSpanChunksSlice_NeutralElement(t *testing.T) {
+	chunk := EmptyMinSpanChunksSlice(&Parameters{})
+	require.Equal(t, uint16(math.MaxUint16), chunk.NeutralElement())
+}
+
+func TestMaxSpanChunksSlice_NeutralElement(t *testing.T) {
+	chunk := EmptyMaxSpanChunksSlice(&Parameters{})
+	require.Equal(t, uint16(0), chunk.NeutralElement())
+}
+
+func TestChunkSpansSliceFrom_WrongLength(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+	}
+	_, err := MinChunkSpansSliceFrom(params, []uint16{})
+	require.ErrorContains(t, "chunk has wrong length", err)
+	_, err = MaxChunkSpansSliceFrom(params, []uint16{})
+	require.ErrorContains(t, "chunk has wrong length", err)
+
+	data := []uint16{2, 2, 2, 2, 2, 2}
+	chunk, err := MinChunkSpansSliceFrom(params, data)
+	require.NoError(t, err)
+	require.DeepEqual(t, data, chunk.Chunk())
+}
+
+func TestMinSpanChunksSlice_CheckSlashable(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 2,
+		historyLength:      3,
+	}
+	validatorIdx := types.ValidatorIndex(1)
+	source := types.Epoch(1)
+	target := types.Epoch(2)
+	att := createAttestationWrapper(source, target, nil, nil)
+
+	// A chunk with all neutral elements cannot lead to a slashing.
+	chunk := EmptyMinSpanChunksSlice(params)
+	slashing, err := chunk.CheckSlashable(ctx, slasherDB, validatorIdx, att)
+	require.NoError(t, err)
+	require.Equal(t, true, slashing == nil)
+
+	// An existing attestation with source 2 and target 3 is surrounded by an
+	// incoming attestation with source 1 and target 4, so the min span at epoch 1
+	// is set to a distance of 2.
+	existingAtt := createAttestationWrapper(2, 3, []uint64{uint64(validatorIdx)}, []byte{1})
+	require.NoError(t, setChunkDataAtEpoch(params, chunk.Chunk(), validatorIdx, source, 3))
+	require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(
+		ctx, []*slashertypes.IndexedAttestationWrapper{existingAtt},
+	))
+
+	// The incoming attestation does not surround the existing one.
+	slashing, err = chunk.CheckSlashable(ctx, slasherDB, validatorIdx, att)
+	require.NoError(t, err)
+	require.Equal(t, true, slashing == nil)
+
+	surroundingAtt := createAttestationWrapper(source, 4, []uint64{uint64(validatorIdx)}, []byte{2})
+	slashing, err = chunk.CheckSlashable(ctx, slasherDB, validatorIdx, surroundingAtt)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	assert.DeepEqual(t, surroundingAtt.IndexedAttestation, slashing.Attestation_1)
+	assert.DeepEqual(t, existingAtt.IndexedAttestation, slashing.Attestation_2)
+}
+
+func TestMaxSpanChunksSlice_CheckSlashable(t *testing.T) {
+	ctx := context.Background()
+	slasherDB := dbtest.SetupSlasherDB(t)
+	params := &Parameters{
+		chunkSize:          4,
+		validatorChunkSize: 2,
+		historyLength:      4,
+	}
+	validatorIdx := types.ValidatorIndex(1)
+	source := types.Epoch(1)
+	target := types.Epoch(2)
+	att := createAttestationWrapper(source, target, nil, nil)
+
+	// A chunk with all neutral elements cannot lead to a slashing.
+	chunk := EmptyMaxSpanChunksSlice(params)
+	slashing, err := chunk.CheckSlashable(ctx, slasherDB, validatorIdx, att)
+	require.NoError(t, err)
+	require.Equal(t, true, slashing == nil)
+
+	// An existing attestation with source 0 and target 3 surrounds an incoming
+	// attestation with source 1 and target 2, so the max span at epoch 1 is set
+	// to a distance of 2.
+	existingAtt := createAttestationWrapper(0, 3, []uint64{uint64(validatorIdx)}, []byte{1})
+	require.NoError(t, setChunkDataAtEpoch(params, chunk.Chunk(), validatorIdx, source, 3))
+	require.NoError(t, slasherDB.SaveAttestationRecordsForValidators(
+		ctx, []*slashertypes.IndexedAttestationWrapper{existingAtt},
+	))
+
+	surroundedAtt := createAttestationWrapper(source, target, []uint64{uint64(validatorIdx)}, []byte{2})
+	slashing, err = chunk.CheckSlashable(ctx, slasherDB, validatorIdx, surroundedAtt)
+	require.NoError(t, err)
+	require.NotNil(t, slashing)
+	assert.DeepEqual(t, existingAtt.IndexedAttestation, slashing.Attestation_1)
+	assert.DeepEqual(t, surroundedAtt.IndexedAttestation, slashing.Attestation_2)
+}
+
+func TestMinSpanChunksSlice_Update_MultipleChunks(t *testing.T) {
+	// Let's set H = historyLength = 6, meaning we keep 6 epochs of data,
+	// and C = chunkSize = 3 elements, giving us 2 chunks per validator.
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 1,
+		historyLength:      6,
+	}
+	validatorIdx := types.ValidatorIndex(0)
+	currentEpoch := types.Epoch(5)
+
+	// An attestation with source 4 and target 5 updates epoch 3 in chunk 1,
+	// and should continue updating chunk 0.
+	chunk1 := EmptyMinSpanChunksSlice(params)
+	startEpoch, exists := chunk1.StartEpoch(4, currentEpoch)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(3), startEpoch)
+	keepGoing, err := chunk1.Update(1, currentEpoch, validatorIdx, startEpoch, 5)
+	require.NoError(t, err)
+	require.Equal(t, true, keepGoing)
+	require.DeepEqual(t, []uint16{2, math.MaxUint16, math.MaxUint16}, chunk1.Chunk())
+
+	nextStart := chunk1.NextChunkStartEpoch(startEpoch)
+	require.Equal(t, types.Epoch(2), nextStart)
+	chunk0 := EmptyMinSpanChunksSlice(params)
+	keepGoing, err = chunk0.Update(0, currentEpoch, validatorIdx, nextStart, 5)
+	require.NoError(t, err)
+	require.Equal(t, false, keepGoing)
+	require.DeepEqual(t, []uint16{5, 4, 3}, chunk0.Chunk())
+}
+
+func TestMaxSpanChunksSlice_Update_MultipleChunks(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 1,
+		historyLength:      6,
+	}
+	validatorIdx := types.ValidatorIndex(0)
+	currentEpoch := types.Epoch(5)
+
+	// An attestation with source 1 and target 5 updates epochs 2 to 5.
+	chunk0 := EmptyMaxSpanChunksSlice(params)
+	startEpoch, exists := chunk0.StartEpoch(1, currentEpoch)
+	require.Equal(t, true, exists)
+	require.Equal(t, types.Epoch(2), startEpoch)
+	keepGoing, err := chunk0.Update(0, currentEpoch, validatorIdx, startEpoch, 5)
+	require.NoError(t, err)
+	require.Equal(t, true, keepGoing)
+	require.DeepEqual(t, []uint16{0, 0, 3}, chunk0.Chunk())
+
+	nextStart := chunk0.NextChunkStartEpoch(startEpoch)
+	require.Equal(t, types.Epoch(3), nextStart)
+	chunk1 := EmptyMaxSpanChunksSlice(params)
+	keepGoing, err = chunk1.Update(1, currentEpoch, validatorIdx, nextStart, 5)
+	require.NoError(t, err)
+	require.Equal(t, false, keepGoing)
+	require.DeepEqual(t, []uint16{2, 1, 0}, chunk1.Chunk())
+
+	// The max span cannot start at or after the current epoch.
+	_, exists = chunk1.StartEpoch(currentEpoch, currentEpoch)
+	require.Equal(t, false, exists)
+}
+
+func TestMinSpanChunksSlice_StartEpoch(t *testing.T) {
+	params := &Parameters{
+		chunkSize:          3,
+		validatorChunkSize: 1,
+		historyLength:      4,
+	}
+	chunk := EmptyMinSpanChunksSlice(params)
+	_, exists := chunk.StartEpoch(0, 2)
+	assert.Equal(t, false, exists, "No start epoch for a source at genesis")
+	// With the current epoch 10, the oldest epoch kept is 7.
+	_, exists = chunk.StartEpoch(7, 10)
+	assert.Equal(t, false, exists, "Start epoch should be outside of the history")
+	epoch, exists := chunk.StartEpoch(8, 10)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(7), epoch)
+}
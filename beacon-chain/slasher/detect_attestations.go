@@ -0,0 +1,310 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// Arguments shared by the span updates of a single validator chunk index.
+type chunkUpdateArgs struct {
+	kind                slashertypes.ChunkKind
+	validatorChunkIndex uint64
+	currentEpoch        types.Epoch
+}
+
+// Given a list of attestations all corresponding to a validator chunk index as well
+// as the current epoch in time, we perform slashing detection over the batch.
+// The process is as follows:
+//
+//  1. Check for double votes, within the batch and against the database.
+//  2. Save the attestation records to the database.
+//  3. Group the attestations by validator chunk index.
+//  4. Update the min and max spans for the attestations of each validator chunk index,
+//     checking for surround votes along the way.
+//  5. Save the updated chunks and the last epoch written for each validator to disk.
+func (s *Service) checkSlashableAttestations(
+	ctx context.Context, currentEpoch types.Epoch, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "Slasher.checkSlashableAttestations")
+	defer span.End()
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+
+	doubleVoteSlashings, err := s.checkDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check slashable double votes")
+	}
+	slashings = append(slashings, doubleVoteSlashings...)
+
+	if err := s.serviceCfg.Database.SaveAttestationRecordsForValidators(ctx, atts); err != nil {
+		return nil, errors.Wrap(err, "could not save attestation records to database")
+	}
+
+	groupedAtts := s.groupByValidatorChunkIndex(atts)
+	for validatorChunkIdx, batch := range groupedAtts {
+		surroundSlashings, err := s.detectSurroundVotes(ctx, validatorChunkIdx, currentEpoch, batch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not detect surround votes in validator chunk %d", validatorChunkIdx)
+		}
+		slashings = append(slashings, surroundSlashings...)
+		if err := s.serviceCfg.Database.SaveLastEpochWrittenForValidators(
+			ctx, s.params.validatorIndicesInChunk(validatorChunkIdx), currentEpoch,
+		); err != nil {
+			return nil, errors.Wrap(err, "could not save last epoch written for validators")
+		}
+	}
+	return slashings, nil
+}
+
+// Check for double votes in a batch of attestations. We first check for double votes
+// within the batch itself, as the attestation records of the batch are not yet
+// persisted, and then against the attestation records in the database.
+func (s *Service) checkDoubleVotes(
+	ctx context.Context, atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "Slasher.checkDoubleVotes")
+	defer span.End()
+	type attestationInfo struct {
+		validatorIdx types.ValidatorIndex
+		epoch        types.Epoch
+	}
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	seenAtts := make(map[attestationInfo]*slashertypes.IndexedAttestationWrapper)
+	// Validators which already have a double vote for a target epoch in this batch.
+	slashed := make(map[attestationInfo]bool)
+	for _, att := range atts {
+		for _, valIdx := range att.IndexedAttestation.AttestingIndices {
+			info := attestationInfo{
+				validatorIdx: types.ValidatorIndex(valIdx),
+				epoch:        att.IndexedAttestation.Data.Target.Epoch,
+			}
+			existingAtt, ok := seenAtts[info]
+			if !ok {
+				seenAtts[info] = att
+				continue
+			}
+			if existingAtt.SigningRoot == att.SigningRoot || slashed[info] {
+				continue
+			}
+			slashed[info] = true
+			doubleVotesTotal.Inc()
+			slashings = append(slashings, &ethpb.AttesterSlashing{
+				Attestation_1: existingAtt.IndexedAttestation,
+				Attestation_2: att.IndexedAttestation,
+			})
+		}
+	}
+
+	doubleVotes, err := s.serviceCfg.Database.CheckAttesterDoubleVotes(ctx, atts)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not retrieve potential double votes from disk")
+	}
+	for _, doubleVote := range doubleVotes {
+		info := attestationInfo{
+			validatorIdx: doubleVote.ValidatorIndex,
+			epoch:        doubleVote.Target,
+		}
+		if slashed[info] {
+			continue
+		}
+		slashed[info] = true
+		doubleVotesTotal.Inc()
+		slashings = append(slashings, &ethpb.AttesterSlashing{
+			Attestation_1: doubleVote.PrevAttestationWrapper.IndexedAttestation,
+			Attestation_2: doubleVote.AttestationWrapper.IndexedAttestation,
+		})
+	}
+	return slashings, nil
+}
+
+// Detects surround votes for a batch of attestations belonging to a validator chunk index,
+// updating the min and max spans of the validators involved. Both span kinds are
+// updated, as a surrounding vote is detected using min spans and a surrounded vote
+// is detected using max spans.
+func (s *Service) detectSurroundVotes(
+	ctx context.Context,
+	validatorChunkIdx uint64,
+	currentEpoch types.Epoch,
+	atts []*slashertypes.IndexedAttestationWrapper,
+) ([]*ethpb.AttesterSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "Slasher.detectSurroundVotes")
+	defer span.End()
+	validatorIndices := s.params.validatorIndicesInChunk(validatorChunkIdx)
+	lastEpochsWritten, err := s.serviceCfg.Database.LastEpochWrittenForValidators(ctx, validatorIndices)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get last epoch written for validators")
+	}
+	slashings := make([]*ethpb.AttesterSlashing, 0)
+	for _, kind := range []slashertypes.ChunkKind{slashertypes.MinSpan, slashertypes.MaxSpan} {
+		args := &chunkUpdateArgs{
+			kind:                kind,
+			validatorChunkIndex: validatorChunkIdx,
+			currentEpoch:        currentEpoch,
+		}
+		updatedChunks := make(map[uint64]Chunker)
+		if err := s.resetStaleSpans(ctx, args, updatedChunks, lastEpochsWritten); err != nil {
+			return nil, errors.Wrap(err, "could not reset stale spans")
+		}
+		for _, att := range atts {
+			for _, validatorIdx := range s.validatorIndicesInChunkForAttestation(validatorChunkIdx, att.IndexedAttestation) {
+				slashing, err := s.applyAttestationForValidator(ctx, args, validatorIdx, updatedChunks, att)
+				if err != nil {
+					return nil, errors.Wrapf(err, "could not apply attestation for validator %d", validatorIdx)
+				}
+				if slashing != nil {
+					slashings = append(slashings, slashing)
+				}
+			}
+		}
+		if err := s.saveUpdatedChunks(ctx, args, updatedChunks); err != nil {
+			return nil, err
+		}
+	}
+	return slashings, nil
+}
+
+// Chunks are indexed by epoch modulo the history length, meaning the cells of
+// epochs (lastEpochWritten, currentEpoch] of a validator still hold the spans of
+// epochs from a previous period of history. These cells are reset to the
+// neutral element before they are used again.
+func (s *Service) resetStaleSpans(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	updatedChunks map[uint64]Chunker,
+	lastEpochsWritten []*slashertypes.AttestedEpochForValidator,
+) error {
+	minEpoch := minSpanEpoch(s.params, args.currentEpoch)
+	for _, lastWritten := range lastEpochsWritten {
+		if lastWritten.Epoch >= args.currentEpoch {
+			continue
+		}
+		startEpoch := lastWritten.Epoch + 1
+		if startEpoch < minEpoch {
+			startEpoch = minEpoch
+		}
+		for epoch := startEpoch; epoch <= args.currentEpoch; epoch++ {
+			chunk, err := s.getChunk(ctx, args, updatedChunks, s.params.chunkIndex(epoch))
+			if err != nil {
+				return err
+			}
+			if err := setChunkRawDistance(
+				s.params, chunk.Chunk(), lastWritten.ValidatorIndex, epoch, chunk.NeutralElement(),
+			); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Checks if an incoming attestation is slashable for a validator index with respect to
+// its min or max spans, and if not, updates the spans of the validator. Updating may
+// span over several chunks, starting at the chunk which contains the start epoch of
+// the update.
+func (s *Service) applyAttestationForValidator(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	validatorIdx types.ValidatorIndex,
+	chunksByChunkIdx map[uint64]Chunker,
+	att *slashertypes.IndexedAttestationWrapper,
+) (*ethpb.AttesterSlashing, error) {
+	sourceEpoch := att.IndexedAttestation.Data.Source.Epoch
+	targetEpoch := att.IndexedAttestation.Data.Target.Epoch
+	chunk, err := s.getChunk(ctx, args, chunksByChunkIdx, s.params.chunkIndex(sourceEpoch))
+	if err != nil {
+		return nil, err
+	}
+	slashing, err := chunk.CheckSlashable(ctx, s.serviceCfg.Database, validatorIdx, att)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not check if attestation for validator %d is slashable", validatorIdx)
+	}
+	if slashing != nil {
+		return slashing, nil
+	}
+
+	startEpoch, exists := chunk.StartEpoch(sourceEpoch, args.currentEpoch)
+	if !exists {
+		return nil, nil
+	}
+	for {
+		chunkIdx := s.params.chunkIndex(startEpoch)
+		chunk, err := s.getChunk(ctx, args, chunksByChunkIdx, chunkIdx)
+		if err != nil {
+			return nil, err
+		}
+		keepGoing, err := chunk.Update(chunkIdx, args.currentEpoch, validatorIdx, startEpoch, targetEpoch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not update chunk at chunk index %d", chunkIdx)
+		}
+		if !keepGoing {
+			break
+		}
+		startEpoch = chunk.NextChunkStartEpoch(startEpoch)
+	}
+	return nil, nil
+}
+
+// Retrieves a chunk for the validator chunk index of the update arguments, loading it
+// from disk into the cache of updated chunks if it was not loaded already. If the chunk
+// does not exist on disk, an empty chunk is created.
+func (s *Service) getChunk(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	chunksByChunkIdx map[uint64]Chunker,
+	chunkIdx uint64,
+) (Chunker, error) {
+	if chunk, ok := chunksByChunkIdx[chunkIdx]; ok {
+		return chunk, nil
+	}
+	key := s.params.flatSliceID(args.validatorChunkIndex, chunkIdx)
+	rawChunks, exists, err := s.serviceCfg.Database.LoadSlasherChunks(ctx, args.kind, [][]byte{key})
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not load chunk at index %d", chunkIdx)
+	}
+	var chunk Chunker
+	switch args.kind {
+	case slashertypes.MinSpan:
+		if len(exists) == 1 && exists[0] {
+			chunk, err = MinChunkSpansSliceFrom(s.params, rawChunks[0])
+		} else {
+			chunk = EmptyMinSpanChunksSlice(s.params)
+		}
+	case slashertypes.MaxSpan:
+		if len(exists) == 1 && exists[0] {
+			chunk, err = MaxChunkSpansSliceFrom(s.params, rawChunks[0])
+		} else {
+			chunk = EmptyMaxSpanChunksSlice(s.params)
+		}
+	default:
+		return nil, errors.Errorf("unknown chunk kind %d", args.kind)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not initialize chunk at index %d", chunkIdx)
+	}
+	chunksByChunkIdx[chunkIdx] = chunk
+	return chunk, nil
+}
+
+// Saves updated chunks to disk given the required database schema.
+func (s *Service) saveUpdatedChunks(
+	ctx context.Context,
+	args *chunkUpdateArgs,
+	updatedChunksByChunkIdx map[uint64]Chunker,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Slasher.saveUpdatedChunks")
+	defer span.End()
+	chunkKeys := make([][]byte, 0, len(updatedChunksByChunkIdx))
+	chunks := make([][]uint16, 0, len(updatedChunksByChunkIdx))
+	for chunkIdx, chunk := range updatedChunksByChunkIdx {
+		chunkKeys = append(chunkKeys, s.params.flatSliceID(args.validatorChunkIndex, chunkIdx))
+		chunks = append(chunks, chunk.Chunk())
+	}
+	if err := s.serviceCfg.Database.SaveSlasherChunks(ctx, args.kind, chunkKeys, chunks); err != nil {
+		return errors.Wrap(err, "could not save slasher chunks")
+	}
+	return nil
+}
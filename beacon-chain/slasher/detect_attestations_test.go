@@ -0,0 +1,146 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_checkSlashableAttestations(t *testing.T) {
+	type batch struct {
+		currentEpoch types.Epoch
+		atts         []*slashertypes.IndexedAttestationWrapper
+	}
+	tests := []struct {
+		name    string
+		batches []batch
+		// Indices of the attestations, as (batch, attestation) pairs, expected in slashings.
+		wanted [][2][2]int
+	}{
+		{
+			name: "no slashings for consecutive votes",
+			batches: []batch{
+				{currentEpoch: 2, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(0, 1, []uint64{1, 2}, []byte{1}),
+					createAttestationWrapper(1, 2, []uint64{1, 2}, []byte{2}),
+				}},
+			},
+		},
+		{
+			name: "double vote within a batch",
+			batches: []batch{
+				{currentEpoch: 2, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(0, 1, []uint64{1, 2}, []byte{1}),
+					createAttestationWrapper(0, 1, []uint64{2}, []byte{2}),
+				}},
+			},
+			wanted: [][2][2]int{{{0, 0}, {0, 1}}},
+		},
+		{
+			name: "double vote across batches",
+			batches: []batch{
+				{currentEpoch: 2, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(0, 1, []uint64{1, 2}, []byte{1}),
+				}},
+				{currentEpoch: 3, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(0, 1, []uint64{2}, []byte{2}),
+				}},
+			},
+			wanted: [][2][2]int{{{0, 0}, {1, 0}}},
+		},
+		{
+			name: "surrounding vote across batches",
+			batches: []batch{
+				{currentEpoch: 3, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(2, 3, []uint64{1}, []byte{1}),
+				}},
+				{currentEpoch: 4, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(1, 4, []uint64{1}, []byte{2}),
+				}},
+			},
+			wanted: [][2][2]int{{{1, 0}, {0, 0}}},
+		},
+		{
+			name: "surrounded vote across batches",
+			batches: []batch{
+				{currentEpoch: 5, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(1, 5, []uint64{1}, []byte{1}),
+				}},
+				{currentEpoch: 5, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(2, 3, []uint64{1}, []byte{2}),
+				}},
+			},
+			wanted: [][2][2]int{{{0, 0}, {1, 0}}},
+		},
+		{
+			name: "surrounding vote spanning several chunks",
+			batches: []batch{
+				{currentEpoch: 9, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(6, 7, []uint64{3}, []byte{1}),
+				}},
+				{currentEpoch: 9, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(2, 9, []uint64{3}, []byte{2}),
+				}},
+			},
+			wanted: [][2][2]int{{{1, 0}, {0, 0}}},
+		},
+		{
+			name: "no surround vote for different validators",
+			batches: []batch{
+				{currentEpoch: 3, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(2, 3, []uint64{1}, []byte{1}),
+				}},
+				{currentEpoch: 4, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(1, 4, []uint64{2}, []byte{2}),
+				}},
+			},
+		},
+		{
+			name: "spans from a previous period of history are reset",
+			batches: []batch{
+				{currentEpoch: 3, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(2, 3, []uint64{1}, []byte{1}),
+				}},
+				// Epoch 2 + 8 maps to the same cell as epoch 2, and the vote
+				// from the previous period should not be surrounded.
+				{currentEpoch: 12, atts: []*slashertypes.IndexedAttestationWrapper{
+					createAttestationWrapper(9, 12, []uint64{1}, []byte{2}),
+				}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			s := &Service{
+				params: &Parameters{
+					chunkSize:          2,
+					validatorChunkSize: 2,
+					historyLength:      8,
+				},
+				serviceCfg: &ServiceConfig{
+					Database: dbtest.SetupSlasherDB(t),
+				},
+			}
+			slashings := make([]*ethpb.AttesterSlashing, 0)
+			for _, b := range tt.batches {
+				batchSlashings, err := s.checkSlashableAttestations(ctx, b.currentEpoch, b.atts)
+				require.NoError(t, err)
+				slashings = append(slashings, batchSlashings...)
+			}
+			require.Equal(t, len(tt.wanted), len(slashings))
+			for i, w := range tt.wanted {
+				att1 := tt.batches[w[0][0]].atts[w[0][1]]
+				att2 := tt.batches[w[1][0]].atts[w[1][1]]
+				assert.DeepEqual(t, att1.IndexedAttestation, slashings[i].Attestation_1)
+				assert.DeepEqual(t, att2.IndexedAttestation, slashings[i].Attestation_2)
+			}
+		})
+	}
+}
@@ -0,0 +1,71 @@
+package slasher
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"go.opencensus.io/trace"
+)
+
+// Detects double proposals in a batch of signed block headers. Proposals are first
+// checked against each other within the batch, and then against the proposal
+// records in the database. Afterwards, the batch is persisted to disk.
+func (s *Service) detectProposerSlashings(
+	ctx context.Context,
+	proposedBlocks []*slashertypes.SignedBlockHeaderWrapper,
+) ([]*ethpb.ProposerSlashing, error) {
+	ctx, span := trace.StartSpan(ctx, "Slasher.detectProposerSlashings")
+	defer span.End()
+	type proposalKey struct {
+		slot          types.Slot
+		proposerIndex types.ValidatorIndex
+	}
+	slashings := make([]*ethpb.ProposerSlashing, 0)
+	seenProposals := make(map[proposalKey]*slashertypes.SignedBlockHeaderWrapper)
+	slashed := make(map[proposalKey]bool)
+	for _, proposal := range proposedBlocks {
+		key := proposalKey{
+			slot:          proposal.SignedBeaconBlockHeader.Header.Slot,
+			proposerIndex: proposal.SignedBeaconBlockHeader.Header.ProposerIndex,
+		}
+		existingProposal, ok := seenProposals[key]
+		if !ok {
+			seenProposals[key] = proposal
+			continue
+		}
+		if !isDoubleProposal(proposal.SigningRoot, existingProposal.SigningRoot) || slashed[key] {
+			continue
+		}
+		slashed[key] = true
+		doubleProposalsTotal.Inc()
+		slashings = append(slashings, &ethpb.ProposerSlashing{
+			Header_1: existingProposal.SignedBeaconBlockHeader,
+			Header_2: proposal.SignedBeaconBlockHeader,
+		})
+	}
+
+	proposerSlashings, err := s.serviceCfg.Database.CheckDoubleBlockProposals(ctx, proposedBlocks)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not check for double proposals on disk")
+	}
+	for _, slashing := range proposerSlashings {
+		key := proposalKey{
+			slot:          slashing.Header_2.Header.Slot,
+			proposerIndex: slashing.Header_2.Header.ProposerIndex,
+		}
+		if slashed[key] {
+			continue
+		}
+		slashed[key] = true
+		doubleProposalsTotal.Inc()
+		slashings = append(slashings, slashing)
+	}
+
+	if err := s.serviceCfg.Database.SaveBlockProposals(ctx, proposedBlocks); err != nil {
+		return nil, errors.Wrap(err, "could not save safe proposals")
+	}
+	return slashings, nil
+}
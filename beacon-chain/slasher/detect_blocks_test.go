@@ -0,0 +1,40 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_detectProposerSlashings(t *testing.T) {
+	ctx := context.Background()
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database: dbtest.SetupSlasherDB(t),
+		},
+	}
+	first := createProposalWrapper(1, 1, []byte{1})
+	sameBlock := createProposalWrapper(1, 1, []byte{1})
+	otherProposer := createProposalWrapper(1, 2, []byte{2})
+	doubleProposal := createProposalWrapper(1, 1, []byte{3})
+	slashings, err := s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{
+		first, sameBlock, otherProposer, doubleProposal,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.DeepEqual(t, first.SignedBeaconBlockHeader, slashings[0].Header_1)
+	assert.DeepEqual(t, doubleProposal.SignedBeaconBlockHeader, slashings[0].Header_2)
+
+	// Proposals from a previous batch are checked from the database.
+	laterProposal := createProposalWrapper(1, 2, []byte{4})
+	slashings, err = s.detectProposerSlashings(ctx, []*slashertypes.SignedBlockHeaderWrapper{laterProposal})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(slashings))
+	assert.DeepEqual(t, otherProposer.SignedBeaconBlockHeader, slashings[0].Header_1)
+	assert.DeepEqual(t, laterProposal.SignedBeaconBlockHeader, slashings[0].Header_2)
+}
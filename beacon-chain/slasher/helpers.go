@@ -0,0 +1,91 @@
+package slasher
+
+import (
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+)
+
+// Group a list of attestations into batches by validator chunk index.
+// This way, we can detect on the batch of attestations for each validator chunk index
+// concurrently, and also allowing us to effectively use a single 2D chunk
+// for slashing detection through this logical grouping.
+func (s *Service) groupByValidatorChunkIndex(
+	attestations []*slashertypes.IndexedAttestationWrapper,
+) map[uint64][]*slashertypes.IndexedAttestationWrapper {
+	groupedAttestations := make(map[uint64][]*slashertypes.IndexedAttestationWrapper)
+	for _, att := range attestations {
+		validatorChunkIndices := make(map[uint64]bool)
+		for _, validatorIdx := range att.IndexedAttestation.AttestingIndices {
+			validatorChunkIndex := s.params.validatorChunkIndex(types.ValidatorIndex(validatorIdx))
+			validatorChunkIndices[validatorChunkIndex] = true
+		}
+		for validatorChunkIndex := range validatorChunkIndices {
+			groupedAttestations[validatorChunkIndex] = append(
+				groupedAttestations[validatorChunkIndex],
+				att,
+			)
+		}
+	}
+	return groupedAttestations
+}
+
+// Validates the attestation data integrity, ensuring we have no nil values for
+// source and target epochs, and that the source epoch of the attestation must
+// be less than the target epoch, which is a precondition for performing slashing
+// detection (except for the genesis epoch).
+func validateAttestationIntegrity(att *ethpb.IndexedAttestation) bool {
+	// If an attestation is malformed, we drop it.
+	if att == nil ||
+		att.Data == nil ||
+		att.Data.Source == nil ||
+		att.Data.Target == nil {
+		return false
+	}
+
+	sourceEpoch := att.Data.Source.Epoch
+	targetEpoch := att.Data.Target.Epoch
+
+	// The genesis epoch is a special case, since all attestations formed in it
+	// will have source and target 0, and they should be considered valid.
+	if sourceEpoch == 0 && targetEpoch == 0 {
+		return true
+	}
+	return sourceEpoch < targetEpoch
+}
+
+// Validates the signed beacon block header integrity, ensuring we have no nil values.
+func validateBlockHeaderIntegrity(header *ethpb.SignedBeaconBlockHeader) bool {
+	// If a signed block header is malformed, we drop it.
+	if header == nil ||
+		header.Header == nil ||
+		len(header.Signature) != params.BeaconConfig().BLSSignatureLength {
+		return false
+	}
+	return true
+}
+
+// Checks if two signed beacon block headers at the same slot and by the
+// same proposer are different, which is a double proposal.
+func isDoubleProposal(incomingSigningRoot, existingSigningRoot [32]byte) bool {
+	if existingSigningRoot == params.BeaconConfig().ZeroHash {
+		return false
+	}
+	return incomingSigningRoot != existingSigningRoot
+}
+
+// Returns the validator indices from an attestation which fall within the
+// specified validator chunk index.
+func (s *Service) validatorIndicesInChunkForAttestation(
+	validatorChunkIdx uint64, att *ethpb.IndexedAttestation,
+) []types.ValidatorIndex {
+	indices := make([]types.ValidatorIndex, 0, len(att.AttestingIndices))
+	for _, idx := range att.AttestingIndices {
+		validatorIdx := types.ValidatorIndex(idx)
+		if s.params.validatorChunkIndex(validatorIdx) == validatorChunkIdx {
+			indices = append(indices, validatorIdx)
+		}
+	}
+	return indices
+}
@@ -0,0 +1,100 @@
+package slasher
+
+import (
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+)
+
+func TestService_groupByValidatorChunkIndex(t *testing.T) {
+	s := &Service{params: &Parameters{chunkSize: 2, validatorChunkSize: 2, historyLength: 4}}
+	atts := []*slashertypes.IndexedAttestationWrapper{
+		createAttestationWrapper(0, 1, []uint64{0, 1}, nil),
+		createAttestationWrapper(0, 1, []uint64{1, 2}, nil),
+		createAttestationWrapper(0, 1, []uint64{4}, nil),
+	}
+	grouped := s.groupByValidatorChunkIndex(atts)
+	assert.Equal(t, 3, len(grouped))
+	assert.DeepEqual(t, atts[:2], grouped[0])
+	assert.DeepEqual(t, atts[1:2], grouped[1])
+	assert.DeepEqual(t, atts[2:], grouped[2])
+}
+
+func TestValidateAttestationIntegrity(t *testing.T) {
+	tests := []struct {
+		name string
+		att  *ethpb.IndexedAttestation
+		want bool
+	}{
+		{name: "nil attestation", att: nil, want: false},
+		{name: "nil data", att: &ethpb.IndexedAttestation{}, want: false},
+		{
+			name: "nil source",
+			att:  &ethpb.IndexedAttestation{Data: &ethpb.AttestationData{Target: &ethpb.Checkpoint{}}},
+			want: false,
+		},
+		{name: "genesis epoch", att: createAttestationWrapper(0, 0, nil, nil).IndexedAttestation, want: true},
+		{name: "source equals target", att: createAttestationWrapper(1, 1, nil, nil).IndexedAttestation, want: false},
+		{name: "source after target", att: createAttestationWrapper(2, 1, nil, nil).IndexedAttestation, want: false},
+		{name: "source before target", att: createAttestationWrapper(1, 2, nil, nil).IndexedAttestation, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, validateAttestationIntegrity(tt.att))
+		})
+	}
+}
+
+func TestValidateBlockHeaderIntegrity(t *testing.T) {
+	assert.Equal(t, false, validateBlockHeaderIntegrity(nil))
+	assert.Equal(t, false, validateBlockHeaderIntegrity(&ethpb.SignedBeaconBlockHeader{}))
+	assert.Equal(t, false, validateBlockHeaderIntegrity(&ethpb.SignedBeaconBlockHeader{
+		Header:    &ethpb.BeaconBlockHeader{},
+		Signature: []byte("too short"),
+	}))
+	assert.Equal(t, true, validateBlockHeaderIntegrity(createProposalWrapper(1, 1, nil).SignedBeaconBlockHeader))
+}
+
+func createAttestationWrapper(source, target types.Epoch, indices []uint64, signingRoot []byte) *slashertypes.IndexedAttestationWrapper {
+	data := &ethpb.AttestationData{
+		BeaconBlockRoot: params.BeaconConfig().ZeroHash[:],
+		Source: &ethpb.Checkpoint{
+			Epoch: source,
+			Root:  params.BeaconConfig().ZeroHash[:],
+		},
+		Target: &ethpb.Checkpoint{
+			Epoch: target,
+			Root:  params.BeaconConfig().ZeroHash[:],
+		},
+	}
+	return &slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: &ethpb.IndexedAttestation{
+			AttestingIndices: indices,
+			Data:             data,
+			Signature:        params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: bytesutil.ToBytes32(signingRoot),
+	}
+}
+
+func createProposalWrapper(slot types.Slot, proposerIndex types.ValidatorIndex, signingRoot []byte) *slashertypes.SignedBlockHeaderWrapper {
+	header := &ethpb.BeaconBlockHeader{
+		Slot:          slot,
+		ProposerIndex: proposerIndex,
+		ParentRoot:    params.BeaconConfig().ZeroHash[:],
+		StateRoot:     bytesutil.PadTo(signingRoot, 32),
+		BodyRoot:      params.BeaconConfig().ZeroHash[:],
+	}
+	return &slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header:    header,
+			Signature: params.BeaconConfig().EmptySignature[:],
+		},
+		SigningRoot: bytesutil.ToBytes32(signingRoot),
+	}
+}
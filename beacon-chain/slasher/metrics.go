@@ -0,0 +1,41 @@
+package slasher
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	attestationsReceivedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_received_total",
+		Help: "Total number of attestations received by slasher",
+	})
+	attestationsDroppedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_dropped_total",
+		Help: "Total number of attestations dropped by slasher because they were invalid or too old",
+	})
+	attestationsDeferredTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_attestations_deferred_total",
+		Help: "Total number of attestations deferred by slasher because their target epoch is in the future",
+	})
+	blocksReceivedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_blocks_received_total",
+		Help: "Total number of block headers received by slasher",
+	})
+	doubleProposalsTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_double_proposals_total",
+		Help: "Total number of double proposals detected by slasher",
+	})
+	doubleVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_double_votes_total",
+		Help: "Total number of attester double votes detected by slasher",
+	})
+	surroundingVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_surrounding_votes_total",
+		Help: "Total number of surrounding attester votes detected by slasher",
+	})
+	surroundedVotesTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "slasher_surrounded_votes_total",
+		Help: "Total number of surrounded attester votes detected by slasher",
+	})
+)
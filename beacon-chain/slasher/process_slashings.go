@@ -0,0 +1,54 @@
+package slasher
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+)
+
+// Inserts detected attester slashings into the beacon node's slashings pool,
+// which verifies them against the head state before they are included in a block.
+func (s *Service) processAttesterSlashings(ctx context.Context, slashings []*ethpb.AttesterSlashing) {
+	if len(slashings) == 0 {
+		return
+	}
+	beaconState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	for _, sl := range slashings {
+		log.WithFields(logrus.Fields{
+			"sourceEpoch1": sl.Attestation_1.Data.Source.Epoch,
+			"targetEpoch1": sl.Attestation_1.Data.Target.Epoch,
+			"sourceEpoch2": sl.Attestation_2.Data.Source.Epoch,
+			"targetEpoch2": sl.Attestation_2.Data.Target.Epoch,
+		}).Info("Attester slashing detected")
+		if err := s.serviceCfg.SlashingPoolInserter.InsertAttesterSlashing(ctx, beaconState, sl); err != nil {
+			log.WithError(err).Error("Could not insert attester slashing into operations pool")
+		}
+	}
+}
+
+// Inserts detected proposer slashings into the beacon node's slashings pool,
+// which verifies them against the head state before they are included in a block.
+func (s *Service) processProposerSlashings(ctx context.Context, slashings []*ethpb.ProposerSlashing) {
+	if len(slashings) == 0 {
+		return
+	}
+	beaconState, err := s.serviceCfg.HeadStateFetcher.HeadState(ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not get head state")
+		return
+	}
+	for _, sl := range slashings {
+		log.WithFields(logrus.Fields{
+			"slot":          sl.Header_1.Header.Slot,
+			"proposerIndex": sl.Header_1.Header.ProposerIndex,
+		}).Info("Proposer slashing detected")
+		if err := s.serviceCfg.SlashingPoolInserter.InsertProposerSlashing(ctx, beaconState, sl); err != nil {
+			log.WithError(err).Error("Could not insert proposer slashing into operations pool")
+		}
+	}
+}
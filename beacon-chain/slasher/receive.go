@@ -0,0 +1,193 @@
+package slasher
+
+import (
+	"context"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/sirupsen/logrus"
+)
+
+// Receive indexed attestations from some source event feed,
+// validating their integrity before appending them to an attestation queue
+// for batch processing in a separate routine.
+func (s *Service) receiveAttestations(
+	ctx context.Context, indexedAttsChan chan *slashertypes.IndexedAttestationWrapper, sub event.Subscription,
+) {
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case att := <-indexedAttsChan:
+			if !validateAttestationIntegrity(att.IndexedAttestation) {
+				attestationsDroppedTotal.Inc()
+				continue
+			}
+			attestationsReceivedTotal.Inc()
+			s.attsQueue.push(att)
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Receive beacon blocks from some source event feed,
+// validating their integrity before appending them to a block queue
+// for batch processing in a separate routine.
+func (s *Service) receiveBlocks(
+	ctx context.Context, beaconBlockHeadersChan chan *slashertypes.SignedBlockHeaderWrapper, sub event.Subscription,
+) {
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case blockHeader := <-beaconBlockHeadersChan:
+			if !validateBlockHeaderIntegrity(blockHeader.SignedBeaconBlockHeader) {
+				continue
+			}
+			blocksReceivedTotal.Inc()
+			s.blksQueue.push(blockHeader)
+		case err := <-sub.Err():
+			log.WithError(err).Debug("Subscriber closed with error")
+			return
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Process queued attestations once per epoch, at the start of every epoch. Attestations
+// received throughout the epoch are batched, filtered, and then checked for slashable
+// offenses. Attestations with a target epoch in the future are deferred to the next batch.
+func (s *Service) processQueuedAttestations(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			if !helpers.IsEpochStart(currentSlot) {
+				continue
+			}
+			attestations := s.attsQueue.dequeue()
+			currentEpoch := helpers.SlotToEpoch(currentSlot)
+			validAtts, validInFuture, numDropped := s.filterAttestations(attestations, currentEpoch)
+
+			// Deferred attestations are processed again in the next batch.
+			s.attsQueue.extend(validInFuture)
+			attestationsDeferredTotal.Add(float64(len(validInFuture)))
+			attestationsDroppedTotal.Add(float64(numDropped))
+
+			log.WithFields(logrus.Fields{
+				"currentSlot":     currentSlot,
+				"currentEpoch":    currentEpoch,
+				"numValidAtts":    len(validAtts),
+				"numDeferredAtts": len(validInFuture),
+				"numDroppedAtts":  numDropped,
+			}).Info("Processing queued attestations for slashing detection")
+
+			slashings, err := s.checkSlashableAttestations(ctx, currentEpoch, validAtts)
+			if err != nil {
+				log.WithError(err).Error("Could not check slashable attestations")
+				continue
+			}
+			s.processAttesterSlashings(ctx, slashings)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Process queued blocks every slot, checking them for double proposals.
+func (s *Service) processQueuedBlocks(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			blocks := s.blksQueue.dequeue()
+			if len(blocks) == 0 {
+				continue
+			}
+			log.WithFields(logrus.Fields{
+				"currentSlot": currentSlot,
+				"numBlocks":   len(blocks),
+			}).Debug("Processing queued blocks for slashing detection")
+
+			slashings, err := s.detectProposerSlashings(ctx, blocks)
+			if err != nil {
+				log.WithError(err).Error("Could not detect proposer slashings")
+				continue
+			}
+			s.processProposerSlashings(ctx, slashings)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Prunes slasher data on each epoch start, removing attestation and proposal
+// records which fall outside of the history length kept by slasher.
+func (s *Service) pruneSlasherData(ctx context.Context, slotTicker <-chan types.Slot) {
+	for {
+		select {
+		case currentSlot := <-slotTicker:
+			if !helpers.IsEpochStart(currentSlot) {
+				continue
+			}
+			currentEpoch := helpers.SlotToEpoch(currentSlot)
+			if currentEpoch <= s.params.historyLength {
+				continue
+			}
+			maxPruningEpoch := currentEpoch - s.params.historyLength
+			numPrunedAtts, err := s.serviceCfg.Database.PruneAttestationsAtEpoch(ctx, maxPruningEpoch)
+			if err != nil {
+				log.WithError(err).Error("Could not prune attestations")
+				continue
+			}
+			numPrunedProposals, err := s.serviceCfg.Database.PruneProposalsAtEpoch(ctx, maxPruningEpoch)
+			if err != nil {
+				log.WithError(err).Error("Could not prune proposals")
+				continue
+			}
+			if numPrunedAtts > 0 || numPrunedProposals > 0 {
+				log.WithFields(logrus.Fields{
+					"prunedAttestationRecords": numPrunedAtts,
+					"prunedProposalRecords":    numPrunedProposals,
+				}).Debug("Pruned old slasher data")
+			}
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// Filter attestations into those that are valid for slashing detection and those
+// which have a target epoch in the future, which should be deferred. Attestations
+// whose source epoch falls outside of the history length kept by slasher are dropped.
+func (s *Service) filterAttestations(
+	atts []*slashertypes.IndexedAttestationWrapper, currentEpoch types.Epoch,
+) (valid, validInFuture []*slashertypes.IndexedAttestationWrapper, numDropped int) {
+	valid = make([]*slashertypes.IndexedAttestationWrapper, 0, len(atts))
+	validInFuture = make([]*slashertypes.IndexedAttestationWrapper, 0)
+
+	for _, attWrapper := range atts {
+		if attWrapper == nil || !validateAttestationIntegrity(attWrapper.IndexedAttestation) {
+			numDropped++
+			continue
+		}
+
+		// If an attestation's source is epoch is older than the max history length
+		// we keep track of for slashing detection, we drop it.
+		if attWrapper.IndexedAttestation.Data.Source.Epoch+s.params.historyLength <= currentEpoch {
+			numDropped++
+			continue
+		}
+
+		// If an attestations's target epoch is in the future, we defer processing for later.
+		if attWrapper.IndexedAttestation.Data.Target.Epoch > currentEpoch {
+			validInFuture = append(validInFuture, attWrapper)
+		} else {
+			valid = append(valid, attWrapper)
+		}
+	}
+	return
+}
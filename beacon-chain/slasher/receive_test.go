@@ -0,0 +1,185 @@
+package slasher
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_receiveAttestations(t *testing.T) {
+	s := &Service{
+		params:    DefaultParams(),
+		attsQueue: newAttestationsQueue(),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	attFeed := new(event.Feed)
+	indexedAttsChan := make(chan *slashertypes.IndexedAttestationWrapper)
+	sub := attFeed.Subscribe(indexedAttsChan)
+	exitChan := make(chan struct{})
+	go func() {
+		s.receiveAttestations(ctx, indexedAttsChan, sub)
+		exitChan <- struct{}{}
+	}()
+	attFeed.Send(createAttestationWrapper(1, 2, []uint64{0, 1}, nil))
+	attFeed.Send(createAttestationWrapper(1, 2, []uint64{0, 1}, nil))
+	// An attestation with a source after its target is dropped.
+	attFeed.Send(createAttestationWrapper(2, 1, []uint64{0, 1}, nil))
+	cancel()
+	<-exitChan
+	assert.Equal(t, 2, s.attsQueue.size())
+}
+
+func TestService_receiveBlocks(t *testing.T) {
+	s := &Service{
+		params:    DefaultParams(),
+		blksQueue: newBlocksQueue(),
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	blockFeed := new(event.Feed)
+	blockHeadersChan := make(chan *slashertypes.SignedBlockHeaderWrapper)
+	sub := blockFeed.Subscribe(blockHeadersChan)
+	exitChan := make(chan struct{})
+	go func() {
+		s.receiveBlocks(ctx, blockHeadersChan, sub)
+		exitChan <- struct{}{}
+	}()
+	blockFeed.Send(createProposalWrapper(1, 1, []byte{1}))
+	// A block header without a signature is dropped.
+	invalid := createProposalWrapper(1, 1, []byte{2})
+	invalid.SignedBeaconBlockHeader.Signature = nil
+	blockFeed.Send(invalid)
+	cancel()
+	<-exitChan
+	assert.Equal(t, 1, s.blksQueue.size())
+}
+
+func TestService_filterAttestations(t *testing.T) {
+	s := &Service{
+		params: &Parameters{
+			chunkSize:          2,
+			validatorChunkSize: 2,
+			historyLength:      4,
+		},
+	}
+	currentEpoch := types.Epoch(5)
+	valid := createAttestationWrapper(2, 5, []uint64{1}, nil)
+	inFuture := createAttestationWrapper(5, 6, []uint64{1}, nil)
+	tooOld := createAttestationWrapper(1, 5, []uint64{1}, nil)
+	malformed := createAttestationWrapper(4, 3, []uint64{1}, nil)
+	gotValid, gotInFuture, numDropped := s.filterAttestations(
+		[]*slashertypes.IndexedAttestationWrapper{valid, inFuture, tooOld, malformed}, currentEpoch,
+	)
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{valid}, gotValid)
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{inFuture}, gotInFuture)
+	assert.Equal(t, 2, numDropped)
+}
+
+func TestService_processQueuedAttestations(t *testing.T) {
+	beaconState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	pool := &slashings.PoolMock{}
+	s := &Service{
+		params: &Parameters{
+			chunkSize:          2,
+			validatorChunkSize: 2,
+			historyLength:      8,
+		},
+		serviceCfg: &ServiceConfig{
+			Database:             dbtest.SetupSlasherDB(t),
+			SlashingPoolInserter: pool,
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+		},
+		attsQueue: newAttestationsQueue(),
+	}
+	surrounded := createAttestationWrapper(2, 3, []uint64{1}, []byte{1})
+	surrounding := createAttestationWrapper(1, 4, []uint64{1}, []byte{2})
+	deferred := createAttestationWrapper(4, 5, []uint64{1}, []byte{3})
+	s.attsQueue.extend([]*slashertypes.IndexedAttestationWrapper{surrounded, surrounding, deferred})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	tickerChan := make(chan types.Slot)
+	exitChan := make(chan struct{})
+	go func() {
+		s.processQueuedAttestations(ctx, tickerChan)
+		exitChan <- struct{}{}
+	}()
+	// Attestations are only processed at the start of an epoch.
+	tickerChan <- params.BeaconConfig().SlotsPerEpoch.Mul(4) + 1
+	tickerChan <- params.BeaconConfig().SlotsPerEpoch.Mul(4)
+	// Wait for the batch to be processed.
+	tickerChan <- params.BeaconConfig().SlotsPerEpoch.Mul(4) + 2
+	cancel()
+	<-exitChan
+
+	require.Equal(t, 1, len(pool.PendingAttSlashings))
+	assert.DeepEqual(t, surrounding.IndexedAttestation, pool.PendingAttSlashings[0].Attestation_1)
+	assert.DeepEqual(t, surrounded.IndexedAttestation, pool.PendingAttSlashings[0].Attestation_2)
+	assert.DeepEqual(t, []*slashertypes.IndexedAttestationWrapper{deferred}, s.attsQueue.dequeue())
+}
+
+func TestService_processQueuedBlocks(t *testing.T) {
+	beaconState, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	pool := &slashings.PoolMock{}
+	s := &Service{
+		params: DefaultParams(),
+		serviceCfg: &ServiceConfig{
+			Database:             dbtest.SetupSlasherDB(t),
+			SlashingPoolInserter: pool,
+			HeadStateFetcher:     &mock.ChainService{State: beaconState},
+		},
+		blksQueue: newBlocksQueue(),
+	}
+	s.blksQueue.extend([]*slashertypes.SignedBlockHeaderWrapper{
+		createProposalWrapper(4, 1, []byte{1}),
+		createProposalWrapper(4, 1, []byte{2}),
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	tickerChan := make(chan types.Slot)
+	exitChan := make(chan struct{})
+	go func() {
+		s.processQueuedBlocks(ctx, tickerChan)
+		exitChan <- struct{}{}
+	}()
+	tickerChan <- 4
+	// Wait for the batch to be processed.
+	tickerChan <- 5
+	cancel()
+	<-exitChan
+	require.Equal(t, 1, len(pool.PendingPropSlashings))
+	assert.Equal(t, 0, s.blksQueue.size())
+}
+
+func TestService_waitForSync(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Service{
+		ctx:        ctx,
+		serviceCfg: &ServiceConfig{SyncChecker: &mockSync.Sync{IsSyncing: true}},
+	}
+	done := make(chan bool)
+	go func() {
+		done <- s.waitForSync()
+	}()
+	cancel()
+	select {
+	case synced := <-done:
+		assert.Equal(t, false, synced)
+	case <-time.After(time.Second):
+		t.Fatal("Did not exit when the context was canceled")
+	}
+
+	s.serviceCfg.SyncChecker = &mockSync.Sync{}
+	assert.Equal(t, true, s.waitForSync())
+}
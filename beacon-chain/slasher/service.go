@@ -1,11 +1,179 @@
+// Package slasher implements slashing detection for eth2, able to catch slashable attestations
+// and proposals that it receives via the beacon node's gossip network. Detected slashings
+// are inserted into the beacon node's slashings pool to be included in a block.
 package slasher
 
 import (
-	"github.com/sirupsen/logrus"
+	"context"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/feed"
+	statefeed "github.com/prysmaticlabs/prysm/beacon-chain/core/feed/state"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/operations/slashings"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/slotutil"
 )
 
-var log = logrus.WithField("prefix", "slasher")
+var _ shared.Service = (*Service)(nil)
+
+// Polling interval used while waiting for the node to be synced.
+const syncCheckInterval = 12 * time.Second
 
+// ServiceConfig for the slasher service in the beacon node.
+// This struct allows us to specify required dependencies and
+// parameters for slasher to function as needed.
+type ServiceConfig struct {
+	IndexedAttestationsFeed *event.Feed
+	BeaconBlockHeadersFeed  *event.Feed
+	Database                db.SlasherDatabase
+	StateNotifier           statefeed.Notifier
+	SlashingPoolInserter    slashings.PoolManager
+	HeadStateFetcher        blockchain.HeadFetcher
+	SyncChecker             sync.Checker
+}
+
+// Service defining a slasher implementation as part of
+// the beacon node, able to detect eth2 slashable offenses.
 type Service struct {
-	params *Parameters
+	params            *Parameters
+	serviceCfg        *ServiceConfig
+	attsQueue         *attestationsQueue
+	blksQueue         *blocksQueue
+	ctx               context.Context
+	cancel            context.CancelFunc
+	genesisTimeChan   chan time.Time
+	attsSlotTicker    *slotutil.SlotTicker
+	blocksSlotTicker  *slotutil.SlotTicker
+	pruningSlotTicker *slotutil.SlotTicker
+}
+
+// New instantiates a new slasher from configuration values.
+func New(ctx context.Context, srvCfg *ServiceConfig) (*Service, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		params:          DefaultParams(),
+		serviceCfg:      srvCfg,
+		attsQueue:       newAttestationsQueue(),
+		blksQueue:       newBlocksQueue(),
+		ctx:             ctx,
+		cancel:          cancel,
+		genesisTimeChan: make(chan time.Time, 1),
+	}
+	// Subscribe to the state feed right away, as the initialized event
+	// may be sent before the service is started.
+	go s.waitForChainInitialization()
+	return s, nil
+}
+
+// Start listening for received indexed attestations and blocks
+// and perform slashing detection on them.
+func (s *Service) Start() {
+	go s.run()
+}
+
+func (s *Service) run() {
+	var genesisTime time.Time
+	select {
+	case genesisTime = <-s.genesisTimeChan:
+	case <-s.ctx.Done():
+		return
+	}
+	if !s.waitForSync() {
+		return
+	}
+
+	indexedAttsChan := make(chan *slashertypes.IndexedAttestationWrapper, 1)
+	attSub := s.serviceCfg.IndexedAttestationsFeed.Subscribe(indexedAttsChan)
+	beaconBlockHeadersChan := make(chan *slashertypes.SignedBlockHeaderWrapper, 1)
+	blockSub := s.serviceCfg.BeaconBlockHeadersFeed.Subscribe(beaconBlockHeadersChan)
+	go s.receiveAttestations(s.ctx, indexedAttsChan, attSub)
+	go s.receiveBlocks(s.ctx, beaconBlockHeadersChan, blockSub)
+
+	secondsPerSlot := params.BeaconConfig().SecondsPerSlot
+	s.attsSlotTicker = slotutil.NewSlotTicker(genesisTime, secondsPerSlot)
+	s.blocksSlotTicker = slotutil.NewSlotTicker(genesisTime, secondsPerSlot)
+	s.pruningSlotTicker = slotutil.NewSlotTicker(genesisTime, secondsPerSlot)
+	log.Info("Slasher is running")
+	go s.processQueuedAttestations(s.ctx, s.attsSlotTicker.C())
+	go s.processQueuedBlocks(s.ctx, s.blocksSlotTicker.C())
+	go s.pruneSlasherData(s.ctx, s.pruningSlotTicker.C())
+}
+
+// Stop the slasher service.
+func (s *Service) Stop() error {
+	s.cancel()
+	if s.attsSlotTicker != nil {
+		s.attsSlotTicker.Done()
+	}
+	if s.blocksSlotTicker != nil {
+		s.blocksSlotTicker.Done()
+	}
+	if s.pruningSlotTicker != nil {
+		s.pruningSlotTicker.Done()
+	}
+	return nil
+}
+
+// Status of the slasher service.
+func (s *Service) Status() error {
+	return nil
+}
+
+// Waits for the chain to be initialized, and passes the genesis time to the
+// main routine of the service.
+func (s *Service) waitForChainInitialization() {
+	stateChannel := make(chan *feed.Event, 1)
+	stateSub := s.serviceCfg.StateNotifier.StateFeed().Subscribe(stateChannel)
+	defer stateSub.Unsubscribe()
+	for {
+		select {
+		case stateEvent := <-stateChannel:
+			// Wait for us to receive the genesis time via a chain started notification.
+			if stateEvent.Type == statefeed.Initialized {
+				// Alternatively, if the chain has already started, we then read the genesis
+				// time value from this data.
+				data, ok := stateEvent.Data.(*statefeed.InitializedData)
+				if !ok {
+					log.Error("Could not receive chain start notification, want *statefeed.InitializedData")
+					return
+				}
+				log.WithField("genesisTime", data.StartTime).Info("Slasher received chain initialization event")
+				s.genesisTimeChan <- data.StartTime
+				return
+			}
+		case err := <-stateSub.Err():
+			log.WithError(err).Error("Slasher could not subscribe to state events")
+			return
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Waits until the node is synced, as slashing detection on historical
+// data received during initial sync is not performed. Returns false if
+// the service was stopped before that.
+func (s *Service) waitForSync() bool {
+	if !s.serviceCfg.SyncChecker.Syncing() {
+		return true
+	}
+	log.Info("Waiting for the node to be synced before starting slashing detection")
+	ticker := time.NewTicker(syncCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if !s.serviceCfg.SyncChecker.Syncing() {
+				return true
+			}
+		case <-s.ctx.Done():
+			return false
+		}
+	}
 }
@@ -0,0 +1,367 @@
+package stategen
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	v1 "github.com/prysmaticlabs/prysm/beacon-chain/state/v1"
+	v2 "github.com/prysmaticlabs/prysm/beacon-chain/state/v2"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/version"
+	"google.golang.org/protobuf/proto"
+)
+
+// stateFields points to the fields which the protobuf beacon states of all forks have in common,
+// so that state diffs are applied the same way to the states of every fork.
+type stateFields struct {
+	slot                        *types.Slot
+	fork                        **ethpb.Fork
+	latestBlockHeader           **ethpb.BeaconBlockHeader
+	blockRoots                  *[][]byte
+	stateRoots                  *[][]byte
+	historicalRoots             *[][]byte
+	eth1Data                    **ethpb.Eth1Data
+	eth1DataVotes               *[]*ethpb.Eth1Data
+	eth1DepositIndex            *uint64
+	validators                  *[]*ethpb.Validator
+	balances                    *[]uint64
+	randaoMixes                 *[][]byte
+	slashings                   *[]uint64
+	justificationBits           *bitfield.Bitvector4
+	previousJustifiedCheckpoint **ethpb.Checkpoint
+	currentJustifiedCheckpoint  **ethpb.Checkpoint
+	finalizedCheckpoint         **ethpb.Checkpoint
+}
+
+// computeStateDiff returns the diff of a state to the state of an earlier block, its base.
+// Both states have to be of the same fork.
+func computeStateDiff(base, target state.BeaconState) (*ethpb.StateDiff, error) {
+	if base.Version() != target.Version() {
+		return nil, errors.New("cannot compute the diff of states of different forks")
+	}
+	blockRoots, err := diffRoots(base.BlockRoots(), target.BlockRoots())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not diff block roots")
+	}
+	stateRoots, err := diffRoots(base.StateRoots(), target.StateRoots())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not diff state roots")
+	}
+	randaoMixes, err := diffRoots(base.RandaoMixes(), target.RandaoMixes())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not diff randao mixes")
+	}
+	diff := &ethpb.StateDiff{
+		Slot:                        target.Slot(),
+		Fork:                        target.Fork(),
+		LatestBlockHeader:           target.LatestBlockHeader(),
+		BlockRoots:                  blockRoots,
+		StateRoots:                  stateRoots,
+		Eth1Data:                    target.Eth1Data(),
+		Eth1DepositIndex:            target.Eth1DepositIndex(),
+		RandaoMixes:                 randaoMixes,
+		JustificationBits:           target.JustificationBits(),
+		PreviousJustifiedCheckpoint: target.PreviousJustifiedCheckpoint(),
+		CurrentJustifiedCheckpoint:  target.CurrentJustifiedCheckpoint(),
+		FinalizedCheckpoint:         target.FinalizedCheckpoint(),
+	}
+
+	// Historical roots are only ever appended.
+	baseHistoricalRoots, historicalRoots := base.HistoricalRoots(), target.HistoricalRoots()
+	if len(historicalRoots) < len(baseHistoricalRoots) {
+		return nil, errors.New("target state has less historical roots than its base state")
+	}
+	diff.AppendedHistoricalRoots = historicalRoots[len(baseHistoricalRoots):]
+
+	// Eth1 data votes are appended during a voting period, and reset at the start of the next one.
+	baseVotes, votes := base.Eth1DataVotes(), target.Eth1DataVotes()
+	if len(votes) >= len(baseVotes) && eth1DataVotesEqual(baseVotes, votes[:len(baseVotes)]) {
+		diff.AppendedEth1DataVotes = votes[len(baseVotes):]
+	} else {
+		diff.Eth1DataVotesReset = true
+		diff.AppendedEth1DataVotes = votes
+	}
+
+	// Validators are never removed from the registry.
+	baseValidators, validators := base.Validators(), target.Validators()
+	if len(validators) < len(baseValidators) {
+		return nil, errors.New("target state has less validators than its base state")
+	}
+	for i, v := range baseValidators {
+		if !validatorsEqual(v, validators[i]) {
+			diff.ChangedValidatorIndices = append(diff.ChangedValidatorIndices, uint64(i))
+			diff.ChangedValidators = append(diff.ChangedValidators, validators[i])
+		}
+	}
+	diff.AppendedValidators = validators[len(baseValidators):]
+	diff.BalanceDeltas, err = deltas(base.Balances(), target.Balances())
+	if err != nil {
+		return nil, errors.Wrap(err, "could not diff balances")
+	}
+
+	baseSlashings, slashings := base.Slashings(), target.Slashings()
+	if len(baseSlashings) != len(slashings) {
+		return nil, errors.New("slashings of the target state and its base state differ in length")
+	}
+	for i, s := range slashings {
+		if s != baseSlashings[i] {
+			diff.ChangedSlashingIndices = append(diff.ChangedSlashingIndices, uint64(i))
+			diff.ChangedSlashings = append(diff.ChangedSlashings, s)
+		}
+	}
+
+	switch target.Version() {
+	case version.Phase0:
+		if diff.PreviousEpochAttestations, err = target.PreviousEpochAttestations(); err != nil {
+			return nil, err
+		}
+		if diff.CurrentEpochAttestations, err = target.CurrentEpochAttestations(); err != nil {
+			return nil, err
+		}
+	case version.Altair:
+		if diff.PreviousEpochParticipation, err = target.PreviousEpochParticipation(); err != nil {
+			return nil, err
+		}
+		if diff.CurrentEpochParticipation, err = target.CurrentEpochParticipation(); err != nil {
+			return nil, err
+		}
+		baseScores, err := base.InactivityScores()
+		if err != nil {
+			return nil, err
+		}
+		scores, err := target.InactivityScores()
+		if err != nil {
+			return nil, err
+		}
+		if diff.InactivityScoreDeltas, err = deltas(baseScores, scores); err != nil {
+			return nil, errors.Wrap(err, "could not diff inactivity scores")
+		}
+		if diff.CurrentSyncCommittee, err = changedSyncCommittee(base.CurrentSyncCommittee, target.CurrentSyncCommittee); err != nil {
+			return nil, err
+		}
+		if diff.NextSyncCommittee, err = changedSyncCommittee(base.NextSyncCommittee, target.NextSyncCommittee); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unsupported state version %d", target.Version())
+	}
+	return diff, nil
+}
+
+// applyStateDiffs applies state diffs, from oldest to newest, on top of a copy of their base state.
+func applyStateDiffs(base state.BeaconState, diffs []*ethpb.StateDiff) (state.BeaconState, error) {
+	switch st := base.CloneInnerState().(type) {
+	case *ethpb.BeaconState:
+		fields := &stateFields{
+			&st.Slot, &st.Fork, &st.LatestBlockHeader, &st.BlockRoots, &st.StateRoots, &st.HistoricalRoots,
+			&st.Eth1Data, &st.Eth1DataVotes, &st.Eth1DepositIndex, &st.Validators, &st.Balances, &st.RandaoMixes,
+			&st.Slashings, &st.JustificationBits, &st.PreviousJustifiedCheckpoint, &st.CurrentJustifiedCheckpoint,
+			&st.FinalizedCheckpoint,
+		}
+		for _, diff := range diffs {
+			if err := fields.apply(diff); err != nil {
+				return nil, err
+			}
+			st.PreviousEpochAttestations = diff.PreviousEpochAttestations
+			st.CurrentEpochAttestations = diff.CurrentEpochAttestations
+		}
+		return v1.InitializeFromProtoUnsafe(st)
+	case *ethpb.BeaconStateAltair:
+		fields := &stateFields{
+			&st.Slot, &st.Fork, &st.LatestBlockHeader, &st.BlockRoots, &st.StateRoots, &st.HistoricalRoots,
+			&st.Eth1Data, &st.Eth1DataVotes, &st.Eth1DepositIndex, &st.Validators, &st.Balances, &st.RandaoMixes,
+			&st.Slashings, &st.JustificationBits, &st.PreviousJustifiedCheckpoint, &st.CurrentJustifiedCheckpoint,
+			&st.FinalizedCheckpoint,
+		}
+		for _, diff := range diffs {
+			if err := fields.apply(diff); err != nil {
+				return nil, err
+			}
+			st.PreviousEpochParticipation = diff.PreviousEpochParticipation
+			st.CurrentEpochParticipation = diff.CurrentEpochParticipation
+			scores, err := applyDeltas(st.InactivityScores, diff.InactivityScoreDeltas)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not apply inactivity score deltas")
+			}
+			st.InactivityScores = scores
+			if diff.CurrentSyncCommittee != nil {
+				st.CurrentSyncCommittee = diff.CurrentSyncCommittee
+			}
+			if diff.NextSyncCommittee != nil {
+				st.NextSyncCommittee = diff.NextSyncCommittee
+			}
+		}
+		return v2.InitializeFromProtoUnsafe(st)
+	default:
+		return nil, fmt.Errorf("unsupported state type %T", st)
+	}
+}
+
+// apply applies the changes of a state diff to the common fields of a protobuf beacon state.
+func (f *stateFields) apply(diff *ethpb.StateDiff) error {
+	*f.slot = diff.Slot
+	*f.fork = diff.Fork
+	*f.latestBlockHeader = diff.LatestBlockHeader
+	if err := applyRoots(*f.blockRoots, diff.BlockRoots); err != nil {
+		return errors.Wrap(err, "could not apply block roots")
+	}
+	if err := applyRoots(*f.stateRoots, diff.StateRoots); err != nil {
+		return errors.Wrap(err, "could not apply state roots")
+	}
+	*f.historicalRoots = append(*f.historicalRoots, diff.AppendedHistoricalRoots...)
+	*f.eth1Data = diff.Eth1Data
+	if diff.Eth1DataVotesReset {
+		*f.eth1DataVotes = diff.AppendedEth1DataVotes
+	} else {
+		*f.eth1DataVotes = append(*f.eth1DataVotes, diff.AppendedEth1DataVotes...)
+	}
+	*f.eth1DepositIndex = diff.Eth1DepositIndex
+
+	validators := *f.validators
+	if len(diff.ChangedValidatorIndices) != len(diff.ChangedValidators) {
+		return errors.New("number of changed validator indices and changed validators differ")
+	}
+	for i, idx := range diff.ChangedValidatorIndices {
+		if idx >= uint64(len(validators)) {
+			return fmt.Errorf("changed validator index %d out of range", idx)
+		}
+		validators[idx] = diff.ChangedValidators[i]
+	}
+	*f.validators = append(validators, diff.AppendedValidators...)
+	balances, err := applyDeltas(*f.balances, diff.BalanceDeltas)
+	if err != nil {
+		return errors.Wrap(err, "could not apply balance deltas")
+	}
+	*f.balances = balances
+
+	if err := applyRoots(*f.randaoMixes, diff.RandaoMixes); err != nil {
+		return errors.Wrap(err, "could not apply randao mixes")
+	}
+	slashings := *f.slashings
+	if len(diff.ChangedSlashingIndices) != len(diff.ChangedSlashings) {
+		return errors.New("number of changed slashing indices and changed slashings differ")
+	}
+	for i, idx := range diff.ChangedSlashingIndices {
+		if idx >= uint64(len(slashings)) {
+			return fmt.Errorf("changed slashing index %d out of range", idx)
+		}
+		slashings[idx] = diff.ChangedSlashings[i]
+	}
+
+	*f.justificationBits = diff.JustificationBits
+	*f.previousJustifiedCheckpoint = diff.PreviousJustifiedCheckpoint
+	*f.currentJustifiedCheckpoint = diff.CurrentJustifiedCheckpoint
+	*f.finalizedCheckpoint = diff.FinalizedCheckpoint
+	return nil
+}
+
+// diffRoots returns the changed entries of a vector of roots.
+func diffRoots(base, target [][]byte) (*ethpb.IndexedRoots, error) {
+	if len(base) != len(target) {
+		return nil, fmt.Errorf("length of roots differ: %d != %d", len(base), len(target))
+	}
+	changed := &ethpb.IndexedRoots{}
+	for i, r := range target {
+		if !bytes.Equal(r, base[i]) {
+			changed.Indices = append(changed.Indices, uint64(i))
+			changed.Roots = append(changed.Roots, r)
+		}
+	}
+	return changed, nil
+}
+
+// applyRoots replaces the changed entries of a vector of roots.
+func applyRoots(roots [][]byte, changed *ethpb.IndexedRoots) error {
+	if changed == nil {
+		return nil
+	}
+	if len(changed.Indices) != len(changed.Roots) {
+		return errors.New("number of indices and roots differ")
+	}
+	for i, idx := range changed.Indices {
+		if idx >= uint64(len(roots)) {
+			return fmt.Errorf("root index %d out of range", idx)
+		}
+		roots[idx] = changed.Roots[i]
+	}
+	return nil
+}
+
+// deltas returns the changes of every value of a list which can only grow, such as the balances
+// of the validator registry. The deltas of appended values are relative to zero.
+func deltas(base, target []uint64) ([]int64, error) {
+	if len(target) < len(base) {
+		return nil, fmt.Errorf("list shrunk from %d to %d values", len(base), len(target))
+	}
+	d := make([]int64, len(target))
+	for i, v := range target {
+		if i < len(base) {
+			d[i] = int64(v - base[i])
+		} else {
+			d[i] = int64(v)
+		}
+	}
+	return d, nil
+}
+
+// applyDeltas returns the values of a list after applying their deltas.
+func applyDeltas(base []uint64, d []int64) ([]uint64, error) {
+	if len(d) < len(base) {
+		return nil, fmt.Errorf("%d deltas for %d values", len(d), len(base))
+	}
+	values := make([]uint64, len(d))
+	for i, delta := range d {
+		if i < len(base) {
+			values[i] = base[i] + uint64(delta)
+		} else {
+			values[i] = uint64(delta)
+		}
+	}
+	return values, nil
+}
+
+// changedSyncCommittee returns the sync committee of the target state if it differs from
+// the one of its base state, and nil otherwise.
+func changedSyncCommittee(base, target func() (*ethpb.SyncCommittee, error)) (*ethpb.SyncCommittee, error) {
+	baseCommittee, err := base()
+	if err != nil {
+		return nil, err
+	}
+	committee, err := target()
+	if err != nil {
+		return nil, err
+	}
+	if proto.Equal(baseCommittee, committee) {
+		return nil, nil
+	}
+	return committee, nil
+}
+
+func eth1DataVotesEqual(a, b []*ethpb.Eth1Data) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !proto.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// validatorsEqual compares validators field by field, as it is a lot faster
+// than the reflection based proto.Equal for a whole validator registry.
+func validatorsEqual(a, b *ethpb.Validator) bool {
+	return bytes.Equal(a.PublicKey, b.PublicKey) &&
+		bytes.Equal(a.WithdrawalCredentials, b.WithdrawalCredentials) &&
+		a.EffectiveBalance == b.EffectiveBalance &&
+		a.Slashed == b.Slashed &&
+		a.ActivationEligibilityEpoch == b.ActivationEligibilityEpoch &&
+		a.ActivationEpoch == b.ActivationEpoch &&
+		a.ExitEpoch == b.ExitEpoch &&
+		a.WithdrawableEpoch == b.WithdrawableEpoch
+}
@@ -0,0 +1,177 @@
+package stategen
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	testDB "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// mutateState applies the kind of changes an epoch of blocks makes to a state.
+func mutateState(t *testing.T, st state.BeaconState) {
+	require.NoError(t, st.SetSlot(st.Slot()+params.BeaconConfig().SlotsPerEpoch))
+	require.NoError(t, st.UpdateBlockRootAtIndex(3, bytesutil.ToBytes32([]byte("block root"))))
+	require.NoError(t, st.UpdateStateRootAtIndex(5, bytesutil.ToBytes32([]byte("state root"))))
+	require.NoError(t, st.UpdateRandaoMixesAtIndex(1, bytesutil.PadTo([]byte("randao"), 32)))
+	require.NoError(t, st.AppendHistoricalRoots(bytesutil.ToBytes32([]byte("historical root"))))
+	require.NoError(t, st.AppendEth1DataVotes(&ethpb.Eth1Data{
+		DepositRoot: bytesutil.PadTo([]byte("deposit root"), 32),
+		BlockHash:   bytesutil.PadTo([]byte("block hash"), 32),
+	}))
+	require.NoError(t, st.SetEth1DepositIndex(st.Eth1DepositIndex()+1))
+	require.NoError(t, st.UpdateBalancesAtIndex(0, 1))
+	require.NoError(t, st.UpdateBalancesAtIndex(1, st.Balances()[1]+100))
+	v, err := st.ValidatorAtIndex(2)
+	require.NoError(t, err)
+	v.Slashed = true
+	require.NoError(t, st.UpdateValidatorAtIndex(2, v))
+	require.NoError(t, st.AppendValidator(&ethpb.Validator{
+		PublicKey:             bytesutil.PadTo([]byte("new validator"), 48),
+		WithdrawalCredentials: make([]byte, 32),
+		EffectiveBalance:      params.BeaconConfig().MaxEffectiveBalance,
+	}))
+	require.NoError(t, st.AppendBalance(params.BeaconConfig().MaxEffectiveBalance))
+	require.NoError(t, st.UpdateSlashingsAtIndex(4, 1000))
+	require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 1, Root: bytesutil.PadTo([]byte("finalized"), 32)}))
+}
+
+func TestStateDiff_Phase0(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	target := base.Copy()
+	mutateState(t, target)
+	require.NoError(t, target.AppendCurrentEpochAttestations(&ethpb.PendingAttestation{
+		AggregationBits: []byte{1},
+		Data:            testutil.HydrateAttestationData(&ethpb.AttestationData{}),
+	}))
+
+	diff, err := computeStateDiff(base, target)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []uint64{2}, diff.ChangedValidatorIndices)
+	assert.Equal(t, 1, len(diff.AppendedValidators))
+	assert.Equal(t, false, diff.Eth1DataVotesReset)
+
+	got, err := applyStateDiffs(base, []*ethpb.StateDiff{diff})
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, target.InnerStateUnsafe(), got.InnerStateUnsafe())
+}
+
+func TestStateDiff_Altair(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisStateAltair(t, 32)
+	target := base.Copy()
+	mutateState(t, target)
+	require.NoError(t, target.AppendCurrentParticipationBits(7))
+	require.NoError(t, target.AppendPreviousParticipationBits(7))
+	require.NoError(t, target.AppendInactivityScore(3))
+	nextCommittee, err := target.CurrentSyncCommittee()
+	require.NoError(t, err)
+	nextCommittee.AggregatePubkey = bytesutil.PadTo([]byte("aggregate"), 48)
+	require.NoError(t, target.SetNextSyncCommittee(nextCommittee))
+
+	diff, err := computeStateDiff(base, target)
+	require.NoError(t, err)
+	assert.Equal(t, (*ethpb.SyncCommittee)(nil), diff.CurrentSyncCommittee)
+	require.NotNil(t, diff.NextSyncCommittee)
+
+	got, err := applyStateDiffs(base, []*ethpb.StateDiff{diff})
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, target.InnerStateUnsafe(), got.InnerStateUnsafe())
+}
+
+func TestStateDiff_ChainedDiffs(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	middle := base.Copy()
+	mutateState(t, middle)
+	target := middle.Copy()
+	mutateState(t, target)
+	// A new eth1 voting period resets the votes.
+	require.NoError(t, target.SetEth1DataVotes([]*ethpb.Eth1Data{}))
+
+	first, err := computeStateDiff(base, middle)
+	require.NoError(t, err)
+	second, err := computeStateDiff(middle, target)
+	require.NoError(t, err)
+	assert.Equal(t, true, second.Eth1DataVotesReset)
+
+	got, err := applyStateDiffs(base, []*ethpb.StateDiff{first, second})
+	require.NoError(t, err)
+	assert.DeepSSZEqual(t, target.InnerStateUnsafe(), got.InnerStateUnsafe())
+}
+
+func TestStateDiff_DifferentForks(t *testing.T) {
+	base, _ := testutil.DeterministicGenesisState(t, 32)
+	target, _ := testutil.DeterministicGenesisStateAltair(t, 32)
+	_, err := computeStateDiff(base, target)
+	assert.ErrorContains(t, "different forks", err)
+}
+
+func TestMigrateToCold_SavesStateDiffs(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableColdStateDiffs: true})
+	defer resetCfg()
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	slotsPerEpoch := params.BeaconConfig().SlotsPerEpoch
+	service.slotsPerArchivedPoint = 4 * slotsPerEpoch
+
+	genesisState, _ := testutil.DeterministicGenesisState(t, 32)
+	genesisStateRoot, err := genesisState.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	genesis := blocks.NewGenesisBlock(genesisStateRoot[:])
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	gRoot, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveState(ctx, genesisState, gRoot))
+	require.NoError(t, beaconDB.SaveGenesisBlockRoot(ctx, gRoot))
+
+	// Epoch boundary states of epochs 1 to 4, where epoch 4 is an archived point.
+	states := make(map[[32]byte]state.BeaconState)
+	roots := make([][32]byte, 0)
+	st := genesisState.Copy()
+	for epoch := types.Slot(1); epoch <= 4; epoch++ {
+		mutateState(t, st)
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = epoch * slotsPerEpoch
+		b.Block.ProposerIndex = types.ValidatorIndex(epoch)
+		r, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+		require.NoError(t, beaconDB.SaveStateSummary(ctx, &ethpb.StateSummary{Slot: b.Block.Slot, Root: r[:]}))
+		require.NoError(t, service.epochBoundaryStateCache.put(r, st.Copy()))
+		states[r] = st.Copy()
+		roots = append(roots, r)
+	}
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 4*slotsPerEpoch + 1
+	fRoot, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	require.NoError(t, service.MigrateToCold(ctx, fRoot))
+
+	for i, r := range roots {
+		if i == len(roots)-1 {
+			assert.Equal(t, true, beaconDB.HasState(ctx, r), "Archived point was not saved as full state")
+		} else {
+			assert.Equal(t, false, beaconDB.HasState(ctx, r), "Epoch boundary was saved as full state")
+			assert.Equal(t, true, beaconDB.HasStateDiff(ctx, r), "Epoch boundary was not saved as state diff")
+		}
+	}
+
+	// The states are loaded from the diffs once they are gone from the caches.
+	service.epochBoundaryStateCache = newBoundaryStateCache()
+	for _, r := range roots {
+		got, err := service.StateByRoot(ctx, r)
+		require.NoError(t, err)
+		assert.DeepSSZEqual(t, states[r].InnerStateUnsafe(), got.InnerStateUnsafe())
+	}
+}
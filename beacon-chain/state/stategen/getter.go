@@ -21,7 +21,7 @@ func (s *State) HasState(ctx context.Context, blockRoot [32]byte) (bool, error)
 	if has {
 		return true, nil
 	}
-	return s.beaconDB.HasState(ctx, blockRoot), nil
+	return s.beaconDB.HasState(ctx, blockRoot) || s.beaconDB.HasStateDiff(ctx, blockRoot), nil
 }
 
 // HasStateInCache returns true if the state exists in cache.
@@ -166,6 +166,9 @@ func (s *State) loadStateByRoot(ctx context.Context, blockRoot [32]byte) (state.
 	if s.beaconDB.HasState(ctx, blockRoot) {
 		return s.beaconDB.State(ctx, blockRoot)
 	}
+	if s.beaconDB.HasStateDiff(ctx, blockRoot) {
+		return s.loadStateFromDiffs(ctx, blockRoot)
+	}
 
 	summary, err := s.stateSummary(ctx, blockRoot)
 	if err != nil {
@@ -285,6 +288,9 @@ func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (state.Bea
 		if s.beaconDB.HasState(ctx, parentRoot) {
 			return s.beaconDB.State(ctx, parentRoot)
 		}
+		if s.beaconDB.HasStateDiff(ctx, parentRoot) {
+			return s.loadStateFromDiffs(ctx, parentRoot)
+		}
 		b, err = s.beaconDB.Block(ctx, parentRoot)
 		if err != nil {
 			return nil, err
@@ -294,3 +300,41 @@ func (s *State) lastAncestorState(ctx context.Context, root [32]byte) (state.Bea
 		}
 	}
 }
+
+// This loads the state of a block root saved as a state diff. The chain of state diffs is followed
+// back to the full state it is based on, then the state diffs are applied on top of it.
+func (s *State) loadStateFromDiffs(ctx context.Context, blockRoot [32]byte) (state.BeaconState, error) {
+	ctx, span := trace.StartSpan(ctx, "stateGen.loadStateFromDiffs")
+	defer span.End()
+
+	var diffs []*ethpb.StateDiff
+	root := blockRoot
+	for !s.beaconDB.HasState(ctx, root) {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		diff, err := s.beaconDB.StateDiff(ctx, root)
+		if err != nil {
+			return nil, err
+		}
+		if diff == nil {
+			return nil, errUnknownState
+		}
+		diffs = append(diffs, diff)
+		root = bytesutil.ToBytes32(diff.BaseBlockRoot)
+	}
+	base, err := s.beaconDB.State(ctx, root)
+	if err != nil {
+		return nil, err
+	}
+	if base == nil || base.IsNil() {
+		return nil, errUnknownState
+	}
+
+	// The diffs were collected from newest to oldest.
+	for i, j := 0, len(diffs)-1; i < j; i, j = i+1, j-1 {
+		diffs[i], diffs[j] = diffs[j], diffs[i]
+	}
+	stateDiffCount.Observe(float64(len(diffs)))
+	return applyStateDiffs(base, diffs)
+}
@@ -13,4 +13,11 @@ var (
 			Buckets: []float64{64, 256, 1024, 2048, 4096},
 		},
 	)
+	stateDiffCount = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "state_diffs_count",
+			Help:    "The number of state diffs to apply to generate a state",
+			Buckets: []float64{1, 4, 16, 64, 256},
+		},
+	)
 )
@@ -5,8 +5,12 @@ import (
 	"encoding/hex"
 	"fmt"
 
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/state"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
+	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/trace"
 )
@@ -33,50 +37,22 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 
 	// Start at previous finalized slot, stop at current finalized slot.
 	// If the slot is on archived point, save the state of that slot to the DB.
+	// With cold state diffs enabled, the states of the epoch boundaries in between
+	// archived points are saved as diffs to the state of the previous epoch boundary.
+	saveDiffs := featureconfig.Get().EnableColdStateDiffs
+	var diffBaseRoot [32]byte
+	var diffBase state.BeaconState
 	for slot := oldFSlot; slot < fSlot; slot++ {
 		if ctx.Err() != nil {
 			return ctx.Err()
 		}
 
-		if slot%s.slotsPerArchivedPoint == 0 && slot != 0 {
-			cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+		archivedPoint := slot%s.slotsPerArchivedPoint == 0
+		diffPoint := saveDiffs && slot%params.BeaconConfig().SlotsPerEpoch == 0
+		if (archivedPoint || diffPoint) && slot != 0 {
+			aRoot, aState, err := s.epochBoundaryRootAndState(ctx, slot)
 			if err != nil {
-				return fmt.Errorf("could not get epoch boundary state for slot %d", slot)
-			}
-
-			var aRoot [32]byte
-			var aState state.BeaconState
-
-			// When the epoch boundary state is not in cache due to skip slot scenario,
-			// we have to regenerate the state which will represent epoch boundary.
-			// By finding the highest available block below epoch boundary slot, we
-			// generate the state for that block root.
-			if exists {
-				aRoot = cached.root
-				aState = cached.state
-			} else {
-				blks, err := s.beaconDB.HighestSlotBlocksBelow(ctx, slot)
-				if err != nil {
-					return err
-				}
-				// Given the block has been finalized, the db should not have more than one block in a given slot.
-				// We should error out when this happens.
-				if len(blks) != 1 {
-					return errUnknownBlock
-				}
-				missingRoot, err := blks[0].Block().HashTreeRoot()
-				if err != nil {
-					return err
-				}
-				aRoot = missingRoot
-				// There's no need to generate the state if the state already exists on the DB.
-				// We can skip saving the state.
-				if !s.beaconDB.HasState(ctx, aRoot) {
-					aState, err = s.StateByRoot(ctx, missingRoot)
-					if err != nil {
-						return err
-					}
-				}
+				return err
 			}
 
 			if s.beaconDB.HasState(ctx, aRoot) {
@@ -92,8 +68,30 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 					}
 				}
 				s.saveHotStateDB.lock.Unlock()
+				diffBaseRoot, diffBase = aRoot, aState
 				continue
 			}
+			if s.beaconDB.HasStateDiff(ctx, aRoot) {
+				diffBaseRoot, diffBase = aRoot, aState
+				continue
+			}
+
+			if !archivedPoint {
+				if diffBase == nil {
+					diffBaseRoot, diffBase, err = s.stateDiffBase(ctx, slot)
+					if err != nil {
+						return err
+					}
+				}
+				saved, err := s.saveStateDiff(ctx, aRoot, aState, diffBaseRoot, diffBase)
+				if err != nil {
+					return err
+				}
+				diffBaseRoot, diffBase = aRoot, aState
+				if saved {
+					continue
+				}
+			}
 
 			if err := s.beaconDB.SaveState(ctx, aState, aRoot); err != nil {
 				return err
@@ -103,6 +101,7 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 					"slot": aState.Slot(),
 					"root": hex.EncodeToString(bytesutil.Trunc(aRoot[:])),
 				}).Info("Saved state in DB")
+			diffBaseRoot, diffBase = aRoot, aState
 		}
 	}
 
@@ -115,5 +114,105 @@ func (s *State) MigrateToCold(ctx context.Context, fRoot [32]byte) error {
 		s.SaveFinalizedState(fSlot, fRoot, fInfo.state)
 	}
 
+	for _, hook := range s.finalizationHooks {
+		hook(ctx, fSlot, fRoot)
+	}
+
 	return nil
 }
+
+// This returns the block root and the state which represent an epoch boundary slot. The state is nil
+// when it is already saved in the DB, either as a full state or as a state diff.
+//
+// When the epoch boundary state is not in cache due to skip slot scenario,
+// we have to regenerate the state which will represent epoch boundary.
+// By finding the highest available block below epoch boundary slot, we
+// generate the state for that block root.
+func (s *State) epochBoundaryRootAndState(ctx context.Context, slot types.Slot) ([32]byte, state.BeaconState, error) {
+	cached, exists, err := s.epochBoundaryStateCache.getBySlot(slot)
+	if err != nil {
+		return [32]byte{}, nil, fmt.Errorf("could not get epoch boundary state for slot %d", slot)
+	}
+	if exists {
+		return cached.root, cached.state, nil
+	}
+
+	blks, err := s.beaconDB.HighestSlotBlocksBelow(ctx, slot)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	// Given the block has been finalized, the db should not have more than one block in a given slot.
+	// We should error out when this happens.
+	if len(blks) != 1 {
+		return [32]byte{}, nil, errUnknownBlock
+	}
+	missingRoot, err := blks[0].Block().HashTreeRoot()
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	// There's no need to generate the state if the state already exists on the DB.
+	if s.beaconDB.HasState(ctx, missingRoot) || s.beaconDB.HasStateDiff(ctx, missingRoot) {
+		return missingRoot, nil, nil
+	}
+	missingState, err := s.StateByRoot(ctx, missingRoot)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	return missingRoot, missingState, nil
+}
+
+// This returns the block root and the state of the previous epoch boundary, which the
+// state diff of an epoch boundary slot is based on.
+func (s *State) stateDiffBase(ctx context.Context, slot types.Slot) ([32]byte, state.BeaconState, error) {
+	baseSlot := slot - params.BeaconConfig().SlotsPerEpoch
+	if slot < params.BeaconConfig().SlotsPerEpoch {
+		baseSlot = 0
+	}
+	var baseRoot [32]byte
+	var err error
+	if baseSlot == 0 {
+		baseRoot, err = s.genesisRoot(ctx)
+	} else {
+		baseRoot, _, err = s.epochBoundaryRootAndState(ctx, baseSlot)
+	}
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	base, err := s.StateByRoot(ctx, baseRoot)
+	if err != nil {
+		return [32]byte{}, nil, err
+	}
+	return baseRoot, base, nil
+}
+
+// This saves the state of a block root as a diff to the state of a base block root. The diff
+// is only saved when the base state is saved in the DB and is of the same fork, otherwise false
+// is returned and the state has to be saved in full.
+func (s *State) saveStateDiff(
+	ctx context.Context,
+	root [32]byte,
+	st state.BeaconState,
+	baseRoot [32]byte,
+	base state.BeaconState,
+) (bool, error) {
+	if base == nil || base.IsNil() || base.Version() != st.Version() {
+		return false, nil
+	}
+	if !s.beaconDB.HasState(ctx, baseRoot) && !s.beaconDB.HasStateDiff(ctx, baseRoot) {
+		return false, nil
+	}
+	diff, err := computeStateDiff(base, st)
+	if err != nil {
+		return false, errors.Wrap(err, "could not compute state diff")
+	}
+	diff.BaseBlockRoot = baseRoot[:]
+	if err := s.beaconDB.SaveStateDiff(ctx, root, diff); err != nil {
+		return false, err
+	}
+	log.WithFields(
+		logrus.Fields{
+			"slot": st.Slot(),
+			"root": hex.EncodeToString(bytesutil.Trunc(root[:])),
+		}).Debug("Saved state diff in DB")
+	return true, nil
+}
@@ -32,6 +32,28 @@ func TestMigrateToCold_CanSaveFinalizedInfo(t *testing.T) {
 	assert.DeepEqual(t, wanted, service.finalizedInfo, "Incorrect finalized info")
 }
 
+func TestMigrateToCold_CallsFinalizationHooks(t *testing.T) {
+	ctx := context.Background()
+	beaconDB := testDB.SetupDB(t)
+	service := New(beaconDB)
+	var gotSlot types.Slot
+	var gotRoot [32]byte
+	service.AddFinalizationHook(func(_ context.Context, fSlot types.Slot, fRoot [32]byte) {
+		gotSlot, gotRoot = fSlot, fRoot
+	})
+	beaconState, _ := testutil.DeterministicGenesisState(t, 32)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 1
+	br, err := b.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, service.beaconDB.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(b)))
+	require.NoError(t, service.epochBoundaryStateCache.put(br, beaconState))
+	require.NoError(t, service.MigrateToCold(ctx, br))
+
+	assert.Equal(t, types.Slot(1), gotSlot)
+	assert.Equal(t, br, gotRoot)
+}
+
 func TestMigrateToCold_HappyPath(t *testing.T) {
 	hook := logTest.NewGlobal()
 	ctx := context.Background()
@@ -14,6 +14,7 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"go.opencensus.io/trace"
 )
@@ -48,8 +49,13 @@ type State struct {
 	finalizedInfo           *finalizedInfo
 	epochBoundaryStateCache *epochBoundaryState
 	saveHotStateDB          *saveHotStateDbConfig
+	finalizationHooks       []FinalizationHook
 }
 
+// FinalizationHook is called with the finalized slot and block root once the states up to a
+// new finalized checkpoint have been migrated to the cold section of the DB.
+type FinalizationHook func(ctx context.Context, fSlot types.Slot, fRoot [32]byte)
+
 // This tracks the config in the event of long non-finality,
 // how often does the node save hot states to db? what are
 // the saved hot states in db?... etc
@@ -105,17 +111,28 @@ func (s *State) Resume(ctx context.Context) (state.BeaconState, error) {
 		return nil, errors.New("finalized state not found in disk")
 	}
 
-	go func() {
-		if err := s.beaconDB.CleanUpDirtyStates(ctx, s.slotsPerArchivedPoint); err != nil {
-			log.WithError(err).Error("Could not clean up dirty states")
-		}
-	}()
+	// States in between archived points may be the base of state diffs, which
+	// must not be cleaned up.
+	if !featureconfig.Get().EnableColdStateDiffs {
+		go func() {
+			if err := s.beaconDB.CleanUpDirtyStates(ctx, s.slotsPerArchivedPoint); err != nil {
+				log.WithError(err).Error("Could not clean up dirty states")
+			}
+		}()
+	}
 
 	s.finalizedInfo = &finalizedInfo{slot: fState.Slot(), root: fRoot, state: fState.Copy()}
 
 	return fState, nil
 }
 
+// AddFinalizationHook registers a hook to be called at the end of every migration to cold.
+// Hooks run synchronously with the migration, so they should hand off long running work.
+// Hooks must be registered before the state manager is used.
+func (s *State) AddFinalizationHook(hook FinalizationHook) {
+	s.finalizationHooks = append(s.finalizationHooks, hook)
+}
+
 // SaveFinalizedState saves the finalized slot, root and state into memory to be used by state gen service.
 // This used for migration at the correct start slot and used for hot state play back to ensure
 // lower bound to start is always at the last finalized state.
@@ -286,6 +286,25 @@ func Merkleize(leaves [][]byte) [][][]byte {
 	return layers
 }
 
+// MerkleProof returns the Merkle branch of the leaf at the given index, from the
+// bottom layer up to the layer below the root, using the layers of a trie as
+// returned by Merkleize.
+func MerkleProof(layers [][][]byte, index int) ([][]byte, error) {
+	if len(layers) == 0 || index < 0 || index >= len(layers[0]) {
+		return nil, errors.Errorf("index %d out of range of the trie leaves", index)
+	}
+	proof := make([][]byte, 0, len(layers)-1)
+	for i := 0; i < len(layers)-1; i++ {
+		sibling := make([]byte, 32)
+		if index^1 < len(layers[i]) {
+			copy(sibling, layers[i][index^1])
+		}
+		proof = append(proof, sibling)
+		index /= 2
+	}
+	return proof, nil
+}
+
 // MerkleizeTrieLeaves merkleize the trie leaves.
 func MerkleizeTrieLeaves(layers [][][32]byte, hashLayer [][32]byte,
 	hasher func([]byte) [32]byte) ([][][32]byte, [][32]byte) {
@@ -110,3 +110,32 @@ func TestRecomputeFromLayer_VariableSizedArray(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, expectedRoot, root)
 }
+
+func TestMerkleProof(t *testing.T) {
+	leaves := make([][]byte, 24)
+	for i := range leaves {
+		leaves[i] = bytesutil.PadTo([]byte{byte(i + 1)}, 32)
+	}
+	layers := stateutil.Merkleize(leaves)
+	root := layers[len(layers)-1][0]
+
+	for _, index := range []int{0, 5, 20, 23, 31} {
+		proof, err := stateutil.MerkleProof(layers, index)
+		require.NoError(t, err)
+		require.Equal(t, 5, len(proof))
+		node := layers[0][index]
+		for i, sibling := range proof {
+			var h [32]byte
+			if (index>>i)%2 == 1 {
+				h = hashutil.Hash(append(append([]byte{}, sibling...), node...))
+			} else {
+				h = hashutil.Hash(append(append([]byte{}, node...), sibling...))
+			}
+			node = h[:]
+		}
+		assert.DeepEqual(t, root, node, "Wrong root for leaf %d", index)
+	}
+
+	_, err := stateutil.MerkleProof(layers, 32)
+	require.ErrorContains(t, "out of range", err)
+}
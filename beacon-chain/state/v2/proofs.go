@@ -0,0 +1,62 @@
+package v2
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/stateutil"
+	"github.com/prysmaticlabs/prysm/beacon-chain/state/types"
+	"github.com/prysmaticlabs/prysm/shared/htrutils"
+)
+
+// FinalizedRootProof crafts a Merkle proof of the finalized root contained in the
+// finalized checkpoint of the beacon state. The first element of the proof is the
+// root of the finalized epoch, the sibling of the finalized root in the checkpoint.
+func (b *BeaconState) FinalizedRootProof(ctx context.Context) ([][]byte, error) {
+	if !b.hasInnerState() {
+		return nil, ErrNilInnerState
+	}
+	// Recompute the field roots to make sure the Merkle layers are up to date.
+	if _, err := b.HashTreeRoot(ctx); err != nil {
+		return nil, err
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	if b.state.FinalizedCheckpoint == nil {
+		return nil, errors.New("nil finalized checkpoint")
+	}
+	epochRoot := htrutils.Uint64Root(uint64(b.state.FinalizedCheckpoint.Epoch))
+	fieldProof, err := stateutil.MerkleProof(b.merkleLayers, int(finalizedCheckpoint))
+	if err != nil {
+		return nil, err
+	}
+	return append([][]byte{epochRoot[:]}, fieldProof...), nil
+}
+
+// CurrentSyncCommitteeProof crafts a Merkle proof of the current sync committee
+// of the beacon state.
+func (b *BeaconState) CurrentSyncCommitteeProof(ctx context.Context) ([][]byte, error) {
+	return b.fieldProof(ctx, currentSyncCommittee)
+}
+
+// NextSyncCommitteeProof crafts a Merkle proof of the next sync committee of the
+// beacon state.
+func (b *BeaconState) NextSyncCommitteeProof(ctx context.Context) ([][]byte, error) {
+	return b.fieldProof(ctx, nextSyncCommittee)
+}
+
+func (b *BeaconState) fieldProof(ctx context.Context, field types.FieldIndex) ([][]byte, error) {
+	if !b.hasInnerState() {
+		return nil, ErrNilInnerState
+	}
+	if _, err := b.HashTreeRoot(ctx); err != nil {
+		return nil, err
+	}
+
+	b.lock.RLock()
+	defer b.lock.RUnlock()
+
+	return stateutil.MerkleProof(b.merkleLayers, int(field))
+}
@@ -0,0 +1,87 @@
+package v2_test
+
+import (
+	"context"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/hashutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestBeaconStateAltair_FinalizedRootProof(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisStateAltair(t, 64)
+	finalizedRoot := bytesutil.PadTo([]byte("finalized"), 32)
+	require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 3, Root: finalizedRoot}))
+
+	proof, err := st.FinalizedRootProof(ctx)
+	require.NoError(t, err)
+	// The finalized root is the second leaf of the finalized checkpoint, the 21st field
+	// of a state with 32 leaves, for a generalized index of 105.
+	require.Equal(t, 6, len(proof))
+	gIndex := 105
+	node := finalizedRoot
+	for i, sibling := range proof {
+		var h [32]byte
+		if (gIndex>>i)%2 == 1 {
+			h = hashutil.Hash(append(append([]byte{}, sibling...), node...))
+		} else {
+			h = hashutil.Hash(append(append([]byte{}, node...), sibling...))
+		}
+		node = h[:]
+	}
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], node)
+
+	// The proof reflects later changes to the state.
+	require.NoError(t, st.SetFinalizedCheckpoint(&ethpb.Checkpoint{Epoch: 4, Root: finalizedRoot}))
+	updated, err := st.FinalizedRootProof(ctx)
+	require.NoError(t, err)
+	epochRoot := bytesutil.PadTo([]byte{4}, 32)
+	assert.DeepEqual(t, epochRoot, updated[0])
+	assert.DeepEqual(t, proof[1:], updated[1:])
+}
+
+func TestBeaconStateAltair_SyncCommitteeProofs(t *testing.T) {
+	ctx := context.Background()
+	st, _ := testutil.DeterministicGenesisStateAltair(t, 64)
+	root, err := st.HashTreeRoot(ctx)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name   string
+		gIndex int
+		proof  func(context.Context) ([][]byte, error)
+		get    func() (*ethpb.SyncCommittee, error)
+	}{
+		{name: "current sync committee", gIndex: 54, proof: st.CurrentSyncCommitteeProof, get: st.CurrentSyncCommittee},
+		{name: "next sync committee", gIndex: 55, proof: st.NextSyncCommitteeProof, get: st.NextSyncCommittee},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			proof, err := tt.proof(ctx)
+			require.NoError(t, err)
+			require.Equal(t, 5, len(proof))
+			committee, err := tt.get()
+			require.NoError(t, err)
+			leaf, err := committee.HashTreeRoot()
+			require.NoError(t, err)
+			node := leaf[:]
+			for i, sibling := range proof {
+				var h [32]byte
+				if (tt.gIndex>>i)%2 == 1 {
+					h = hashutil.Hash(append(append([]byte{}, sibling...), node...))
+				} else {
+					h = hashutil.Hash(append(append([]byte{}, node...), sibling...))
+				}
+				node = h[:]
+			}
+			assert.DeepEqual(t, root[:], node)
+		})
+	}
+}
@@ -0,0 +1,302 @@
+// Package backfill downloads the blocks before the checkpoint sync origin of a beacon node. It walks
+// backward from the origin block with BeaconBlocksByRange requests, checks that every block is the
+// parent of the block after it, and saves them until genesis is reached. Progress is kept in the
+// database so that backfilling resumes where it left off after a restart.
+package backfill
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/blockchain"
+	"github.com/prysmaticlabs/prysm/beacon-chain/db"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/beacon-chain/sync"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/sirupsen/logrus"
+)
+
+var _ shared.Service = (*Service)(nil)
+
+const (
+	// batchSize is the number of slots requested from a peer in a single backfill batch.
+	batchSize = 64
+	// retryInterval is the time to wait before retrying when no suitable peers are available,
+	// or while initial sync is still running.
+	retryInterval = 6 * time.Second
+)
+
+var (
+	// errNoLink is returned when a batch of blocks does not link to the lowest backfilled block.
+	errNoLink = errors.New("blocks do not link to the lowest backfilled block")
+	// errEmptyBatch is returned when a peer has no blocks in the requested range, either because the
+	// range consists of empty slots or because the peer does not have the history.
+	errEmptyBatch = errors.New("no blocks in the requested range")
+)
+
+// Config to set up the backfill service.
+type Config struct {
+	P2P         p2p.P2P
+	DB          db.NoHeadAccessDatabase
+	Chain       blockchain.ChainInfoFetcher
+	InitialSync sync.Checker
+}
+
+// blocksFetcher requests a range of blocks from a peer.
+type blocksFetcher func(ctx context.Context, pid peer.ID, req *pb.BeaconBlocksByRangeRequest) ([]block.SignedBeaconBlock, error)
+
+// Service backfills the blocks before the checkpoint sync origin.
+type Service struct {
+	cfg    *Config
+	ctx    context.Context
+	cancel context.CancelFunc
+	fetch  blocksFetcher
+	lowest block.SignedBeaconBlock
+	// searchSlot is the slot below which blocks are requested next. It is lower than the slot of the
+	// lowest backfilled block when every peer reported the batches in between as empty slots.
+	searchSlot types.Slot
+	complete   bool
+}
+
+// NewService initializes the backfill service.
+func NewService(ctx context.Context, cfg *Config) *Service {
+	ctx, cancel := context.WithCancel(ctx)
+	s := &Service{
+		cfg:    cfg,
+		ctx:    ctx,
+		cancel: cancel,
+	}
+	s.fetch = func(ctx context.Context, pid peer.ID, req *pb.BeaconBlocksByRangeRequest) ([]block.SignedBeaconBlock, error) {
+		return sync.SendBeaconBlocksByRangeRequest(ctx, s.cfg.Chain, s.cfg.P2P, pid, req, nil)
+	}
+	return s
+}
+
+// Start the backfill service. Nothing is done for nodes which were not started from a checkpoint.
+func (s *Service) Start() {
+	found, err := s.initialize(s.ctx)
+	if err != nil {
+		log.WithError(err).Error("Could not initialize backfill")
+		return
+	}
+	if !found {
+		log.Debug("Node was not started from a checkpoint, not backfilling")
+		return
+	}
+	if s.complete {
+		log.Debug("Backfill already complete")
+		return
+	}
+	go s.run()
+}
+
+// Stop the backfill service.
+func (s *Service) Stop() error {
+	s.cancel()
+	return nil
+}
+
+// Status of the backfill service. Backfilling blocks does not affect the health of the node.
+func (s *Service) Status() error {
+	return nil
+}
+
+// initialize loads the lowest backfilled block from the database. It returns false if the node was
+// not started from a checkpoint sync origin.
+func (s *Service) initialize(ctx context.Context) (bool, error) {
+	root, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	if errors.Is(err, db.ErrNoOriginCheckpointBlockRoot) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	blk, err := s.cfg.DB.Block(ctx, root)
+	if err != nil {
+		return false, err
+	}
+	if blk == nil || blk.IsNil() {
+		return false, errors.Errorf("lowest backfilled block %#x not found in db", root)
+	}
+	s.setLowest(blk)
+	return true, s.checkComplete(ctx)
+}
+
+func (s *Service) run() {
+	log.WithField("slot", s.lowest.Block().Slot()).Info("Backfilling blocks before the checkpoint sync origin")
+	for !s.complete {
+		if s.ctx.Err() != nil {
+			return
+		}
+		if s.cfg.InitialSync != nil && s.cfg.InitialSync.Syncing() {
+			s.wait()
+			continue
+		}
+		pids := s.peers()
+		if len(pids) == 0 {
+			log.Debug("No peers available to backfill from, waiting")
+			s.wait()
+			continue
+		}
+		s.backfillRound(s.ctx, pids)
+	}
+	log.Info("Backfill complete, all blocks since genesis are in the database")
+}
+
+// backfillRound requests the batch of blocks below the search slot from each of the given peers in
+// turn, until one of them is saved. The search window only moves below the batch when every peer
+// reported it as empty, as a single peer without the history would otherwise stall backfilling.
+func (s *Service) backfillRound(ctx context.Context, pids []peer.ID) {
+	empty := 0
+	for _, pid := range pids {
+		err := s.backfillBatch(ctx, pid)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+		log.WithError(err).WithField("peer", pid).Debug("Could not backfill batch")
+		switch {
+		case errors.Is(err, errEmptyBatch):
+			empty++
+		case errors.Is(err, errNoLink) && s.searchSlot != s.lowest.Block().Slot():
+			// The batches in between may have been wrongly reported as empty slots, so the peer
+			// is not blamed and the search starts again below the lowest backfilled block.
+			s.resetSearch()
+		case errors.Is(err, errNoLink) || errors.Is(err, sync.ErrInvalidFetchedData):
+			s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(pid)
+		}
+	}
+	if empty > 0 && empty == len(pids) {
+		s.skipEmptyBatch()
+	}
+}
+
+// backfillBatch requests the batch of blocks below the search slot from the given peer, and saves
+// the blocks which link to the lowest backfilled block.
+func (s *Service) backfillBatch(ctx context.Context, pid peer.ID) error {
+	start := s.batchStart()
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: start,
+		Count:     uint64(s.searchSlot - start),
+		Step:      1,
+	}
+	blks, err := s.fetch(ctx, pid, req)
+	if err != nil {
+		return err
+	}
+	linked, roots, err := s.linkedBlocks(blks)
+	if err != nil {
+		return err
+	}
+	if len(linked) == 0 {
+		return errEmptyBatch
+	}
+	if err := s.cfg.DB.SaveBlocks(ctx, linked); err != nil {
+		return errors.Wrap(err, "could not save backfilled blocks")
+	}
+	if err := s.cfg.DB.SaveBackfillBlockRoot(ctx, roots[len(roots)-1]); err != nil {
+		return errors.Wrap(err, "could not save backfill progress")
+	}
+	s.setLowest(linked[len(linked)-1])
+	log.WithFields(logrus.Fields{
+		"slot":   s.lowest.Block().Slot(),
+		"blocks": len(linked),
+	}).Debug("Backfilled blocks")
+	return s.checkComplete(ctx)
+}
+
+// linkedBlocks walks the fetched blocks from the highest to the lowest slot and returns the blocks,
+// ordered by descending slot, which form an unbroken chain of parents of the lowest backfilled block.
+func (s *Service) linkedBlocks(blks []block.SignedBeaconBlock) ([]block.SignedBeaconBlock, [][32]byte, error) {
+	expected := bytesutil.ToBytes32(s.lowest.Block().ParentRoot())
+	linked := make([]block.SignedBeaconBlock, 0, len(blks))
+	roots := make([][32]byte, 0, len(blks))
+	for i := len(blks) - 1; i >= 0; i-- {
+		blk := blks[i]
+		if blk == nil || blk.IsNil() || blk.Block().IsNil() {
+			return nil, nil, sync.ErrInvalidFetchedData
+		}
+		root, err := blk.Block().HashTreeRoot()
+		if err != nil {
+			return nil, nil, err
+		}
+		if root != expected {
+			return nil, nil, errNoLink
+		}
+		linked = append(linked, blk)
+		roots = append(roots, root)
+		expected = bytesutil.ToBytes32(blk.Block().ParentRoot())
+	}
+	return linked, roots, nil
+}
+
+// checkComplete marks backfilling as complete when the parent of the lowest backfilled block is
+// already in the database, which is the case once the genesis block is reached.
+func (s *Service) checkComplete(ctx context.Context) error {
+	parent := bytesutil.ToBytes32(s.lowest.Block().ParentRoot())
+	if s.lowest.Block().Slot() == 0 || parent == params.BeaconConfig().ZeroHash {
+		s.complete = true
+		return nil
+	}
+	if !s.cfg.DB.HasBlock(ctx, parent) {
+		return nil
+	}
+	if err := s.cfg.DB.SaveBackfillBlockRoot(ctx, parent); err != nil {
+		return errors.Wrap(err, "could not save backfill progress")
+	}
+	s.complete = true
+	return nil
+}
+
+// peers returns the peers which have finalized at least the same epoch as the node, as they
+// can serve every block before the checkpoint sync origin.
+func (s *Service) peers() []peer.ID {
+	cp := s.cfg.Chain.FinalizedCheckpt()
+	if cp == nil {
+		return nil
+	}
+	_, pids := s.cfg.P2P.Peers().BestFinalized(params.BeaconConfig().MaxPeersToSync, cp.Epoch)
+	return pids
+}
+
+// batchStart returns the first slot of the batch of blocks below the search slot.
+func (s *Service) batchStart() types.Slot {
+	if s.searchSlot > batchSize {
+		return s.searchSlot - batchSize
+	}
+	return 0
+}
+
+// skipEmptyBatch moves the search window below a batch of empty slots. As there is always a block at
+// genesis, the search starts again below the lowest backfilled block once genesis is reached instead.
+func (s *Service) skipEmptyBatch() {
+	start := s.batchStart()
+	if start == 0 {
+		s.resetSearch()
+		return
+	}
+	s.searchSlot = start
+}
+
+func (s *Service) resetSearch() {
+	s.searchSlot = s.lowest.Block().Slot()
+}
+
+func (s *Service) setLowest(blk block.SignedBeaconBlock) {
+	s.lowest = blk
+	s.searchSlot = blk.Block().Slot()
+	backfillLowestSlot.Set(float64(s.searchSlot))
+}
+
+func (s *Service) wait() {
+	select {
+	case <-s.ctx.Done():
+	case <-time.After(retryInterval):
+	}
+}
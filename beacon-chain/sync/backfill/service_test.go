@@ -0,0 +1,166 @@
+package backfill
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p-core/peer"
+	types "github.com/prysmaticlabs/eth2-types"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	dbtest "github.com/prysmaticlabs/prysm/beacon-chain/db/testing"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+// testChain builds a chain of blocks from genesis up to the given slot, skipping the given slots.
+func testChain(t *testing.T, head types.Slot, skipped map[types.Slot]bool) ([]block.SignedBeaconBlock, [][32]byte) {
+	var blks []block.SignedBeaconBlock
+	var roots [][32]byte
+	parent := make([]byte, 32)
+	for slot := types.Slot(0); slot <= head; slot++ {
+		if skipped[slot] {
+			continue
+		}
+		b := testutil.NewBeaconBlock()
+		b.Block.Slot = slot
+		b.Block.ParentRoot = parent
+		root, err := b.Block.HashTreeRoot()
+		require.NoError(t, err)
+		blks = append(blks, wrapper.WrappedPhase0SignedBeaconBlock(b))
+		roots = append(roots, root)
+		parent = bytesutil.SafeCopyBytes(root[:])
+	}
+	return blks, roots
+}
+
+func setupService(t *testing.T, blks []block.SignedBeaconBlock, roots [][32]byte) *Service {
+	ctx := context.Background()
+	beaconDB := dbtest.SetupDB(t)
+	// Only the genesis block and the origin block are in the database.
+	require.NoError(t, beaconDB.SaveBlock(ctx, blks[0]))
+	require.NoError(t, beaconDB.SaveBlock(ctx, blks[len(blks)-1]))
+	require.NoError(t, beaconDB.SaveOriginCheckpointBlockRoot(ctx, roots[len(roots)-1]))
+
+	s := NewService(ctx, &Config{
+		P2P:   p2ptest.NewTestP2P(t),
+		DB:    beaconDB,
+		Chain: &mock.ChainService{FinalizedCheckPoint: &pb.Checkpoint{}},
+	})
+	s.fetch = func(ctx context.Context, pid peer.ID, req *pb.BeaconBlocksByRangeRequest) ([]block.SignedBeaconBlock, error) {
+		var res []block.SignedBeaconBlock
+		for _, b := range blks {
+			if b.Block().Slot() >= req.StartSlot && b.Block().Slot() < req.StartSlot+types.Slot(req.Count) {
+				res = append(res, b)
+			}
+		}
+		return res, nil
+	}
+	return s
+}
+
+func TestService_Backfill(t *testing.T) {
+	ctx := context.Background()
+	// Leave a full batch of empty slots to exercise the search for lower blocks.
+	skipped := map[types.Slot]bool{3: true, 17: true}
+	for slot := types.Slot(100); slot < 100+2*batchSize; slot++ {
+		skipped[slot] = true
+	}
+	blks, roots := testChain(t, 300, skipped)
+	s := setupService(t, blks, roots)
+
+	found, err := s.initialize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	require.Equal(t, false, s.complete)
+	for i := 0; !s.complete; i++ {
+		require.Equal(t, true, i < 100, "backfill did not complete")
+		s.backfillRound(ctx, []peer.ID{"peer"})
+	}
+
+	// Every block before the origin is saved and indexed as finalized.
+	for i, r := range roots[:len(roots)-1] {
+		assert.Equal(t, true, s.cfg.DB.HasBlock(ctx, r), "missing block at slot %d", blks[i].Block().Slot())
+		assert.Equal(t, true, s.cfg.DB.IsFinalizedBlock(ctx, r), "block at slot %d not finalized", blks[i].Block().Slot())
+	}
+	lowest, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[0], lowest)
+
+	// A restarted service knows that backfilling is complete.
+	restarted := NewService(ctx, s.cfg)
+	found, err = restarted.initialize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	assert.Equal(t, true, restarted.complete)
+}
+
+func TestService_Backfill_NotLinked(t *testing.T) {
+	ctx := context.Background()
+	blks, roots := testChain(t, 100, nil)
+	s := setupService(t, blks, roots)
+	found, err := s.initialize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+
+	other, _ := testChain(t, 99, map[types.Slot]bool{50: true})
+	s.fetch = func(ctx context.Context, pid peer.ID, req *pb.BeaconBlocksByRangeRequest) ([]block.SignedBeaconBlock, error) {
+		return other[len(other)-int(req.Count):], nil
+	}
+	assert.ErrorContains(t, errNoLink.Error(), s.backfillBatch(ctx, "peer"))
+	lowest, err := s.cfg.DB.BackfillBlockRoot(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, roots[len(roots)-1], lowest)
+}
+
+func TestService_Backfill_EmptyPeerResponse(t *testing.T) {
+	ctx := context.Background()
+	blks, roots := testChain(t, 200, nil)
+	s := setupService(t, blks, roots)
+	found, err := s.initialize(ctx)
+	require.NoError(t, err)
+	require.Equal(t, true, found)
+	fetch := s.fetch
+	s.fetch = func(ctx context.Context, pid peer.ID, req *pb.BeaconBlocksByRangeRequest) ([]block.SignedBeaconBlock, error) {
+		if pid == "empty" {
+			return nil, nil
+		}
+		return fetch(ctx, pid, req)
+	}
+	scorer := s.cfg.P2P.Peers().Scorers().BadResponsesScorer()
+
+	// The range is requested again from another peer when a peer has no blocks in it.
+	s.backfillRound(ctx, []peer.ID{"empty", "good"})
+	assert.Equal(t, blks[len(blks)-1-batchSize].Block().Slot(), s.lowest.Block().Slot())
+	assert.Equal(t, s.lowest.Block().Slot(), s.searchSlot)
+
+	// The search window only moves down when every peer reported the range as empty.
+	s.backfillRound(ctx, []peer.ID{"empty"})
+	assert.Equal(t, s.lowest.Block().Slot()-batchSize, s.searchSlot)
+
+	// Blocks which do not link because of the skipped range reset the search window, without
+	// blaming the peer which sent them.
+	s.backfillRound(ctx, []peer.ID{"good"})
+	assert.Equal(t, s.lowest.Block().Slot(), s.searchSlot)
+	_, err = scorer.Count("good")
+	assert.ErrorContains(t, "peer unknown", err)
+
+	for i := 0; !s.complete; i++ {
+		require.Equal(t, true, i < 10, "backfill did not complete")
+		s.backfillRound(ctx, []peer.ID{"empty", "good"})
+	}
+	_, err = scorer.Count("empty")
+	assert.ErrorContains(t, "peer unknown", err)
+}
+
+func TestService_NoOrigin(t *testing.T) {
+	s := NewService(context.Background(), &Config{DB: dbtest.SetupDB(t)})
+	found, err := s.initialize(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+}
@@ -0,0 +1,124 @@
+// Package checkpoint retrieves the finalized state and block used as the origin of the chain
+// when a beacon node is started from a checkpoint (checkpoint sync) rather than from genesis.
+// The origin can be read from ssz files on disk or downloaded from the standard beacon API
+// of another, trusted, beacon node.
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+)
+
+const (
+	finalizedStatePath = "/eth/v2/debug/beacon/states/finalized"
+	blockBySlotPath    = "/eth/v2/beacon/blocks/%d"
+	sszContentType     = "application/octet-stream"
+	// A finalized mainnet state is well above 100MB, so the timeout has to be generous.
+	remoteRequestTimeout = 5 * time.Minute
+)
+
+// The ssz encoded beacon state begins with genesis_time (8 bytes), genesis_validators_root (32 bytes),
+// slot (8 bytes) and fork (16 bytes), followed by the fixed size latest_block_header. This layout is
+// shared by every fork, so the header can be read without knowing the type of the state.
+const (
+	latestBlockHeaderOffset = 8 + 32 + 8 + 16
+	latestBlockHeaderSize   = 8 + 8 + 32 + 32 + 32
+)
+
+// Origin contains the ssz encoded finalized state and its block to start the chain from.
+type Origin struct {
+	State []byte
+	Block []byte
+}
+
+// FromFiles reads the ssz encoded origin state and block from the given file paths.
+func FromFiles(statePath, blockPath string) (*Origin, error) {
+	if statePath == "" || blockPath == "" {
+		return nil, errors.New("both a checkpoint state and a checkpoint block file must be provided")
+	}
+	st, err := ioutil.ReadFile(statePath) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read checkpoint state file %s", statePath)
+	}
+	blk, err := ioutil.ReadFile(blockPath) // #nosec G304
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not read checkpoint block file %s", blockPath)
+	}
+	return &Origin{State: st, Block: blk}, nil
+}
+
+// FromRemote downloads the latest finalized state and its block from the beacon API of the beacon
+// node at the given base URL. The block is requested by the slot of the latest block header in the
+// state; its root is verified against the state when the origin is saved to the database.
+func FromRemote(ctx context.Context, baseURL string) (*Origin, error) {
+	u, err := url.Parse(strings.TrimSuffix(baseURL, "/"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid checkpoint sync url %s", baseURL)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("checkpoint sync url %s must use http or https", baseURL)
+	}
+	client := &http.Client{Timeout: remoteRequestTimeout}
+
+	log.WithField("url", u.String()).Info("Downloading finalized state for checkpoint sync")
+	st, err := getSSZ(ctx, client, u.String()+finalizedStatePath)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not download finalized state")
+	}
+	header, err := latestBlockHeader(st)
+	if err != nil {
+		return nil, err
+	}
+	log.WithField("slot", header.Slot).Info("Downloading finalized block for checkpoint sync")
+	blk, err := getSSZ(ctx, client, u.String()+fmt.Sprintf(blockBySlotPath, header.Slot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not download finalized block")
+	}
+	return &Origin{State: st, Block: blk}, nil
+}
+
+func getSSZ(ctx context.Context, client *http.Client, endpoint string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", sszContentType)
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			log.WithError(err).Error("Could not close response body")
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		body, err := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		if err != nil {
+			return nil, fmt.Errorf("unexpected status code %d from %s", resp.StatusCode, endpoint)
+		}
+		return nil, fmt.Errorf("unexpected status code %d from %s: %s", resp.StatusCode, endpoint, string(body))
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+// latestBlockHeader reads the latest block header out of an ssz encoded state of any fork.
+func latestBlockHeader(enc []byte) (*ethpb.BeaconBlockHeader, error) {
+	if len(enc) < latestBlockHeaderOffset+latestBlockHeaderSize {
+		return nil, errors.New("encoded state is too short")
+	}
+	header := &ethpb.BeaconBlockHeader{}
+	if err := header.UnmarshalSSZ(enc[latestBlockHeaderOffset : latestBlockHeaderOffset+latestBlockHeaderSize]); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal latest block header of state")
+	}
+	return header, nil
+}
@@ -0,0 +1,91 @@
+package checkpoint
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func encodedState(t *testing.T) []byte {
+	st, err := testutil.NewBeaconState(func(s *ethpb.BeaconState) error {
+		s.GenesisValidatorsRoot = bytesutil.PadTo([]byte("gvr"), 32)
+		s.Slot = 100
+		s.LatestBlockHeader.Slot = 97
+		return nil
+	})
+	require.NoError(t, err)
+	enc, err := st.MarshalSSZ()
+	require.NoError(t, err)
+	return enc
+}
+
+func TestFromFiles(t *testing.T) {
+	dir := t.TempDir()
+	statePath := filepath.Join(dir, "state.ssz")
+	blockPath := filepath.Join(dir, "block.ssz")
+	require.NoError(t, ioutil.WriteFile(statePath, []byte("state"), 0600))
+	require.NoError(t, ioutil.WriteFile(blockPath, []byte("block"), 0600))
+
+	o, err := FromFiles(statePath, blockPath)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte("state"), o.State)
+	assert.DeepEqual(t, []byte("block"), o.Block)
+
+	_, err = FromFiles(statePath, "")
+	assert.ErrorContains(t, "must be provided", err)
+	_, err = FromFiles(statePath, filepath.Join(dir, "missing.ssz"))
+	assert.ErrorContains(t, "could not read checkpoint block file", err)
+}
+
+func TestFromRemote(t *testing.T) {
+	enc := encodedState(t)
+	mux := http.NewServeMux()
+	mux.HandleFunc(finalizedStatePath, func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, sszContentType, r.Header.Get("Accept"))
+		_, err := w.Write(enc)
+		require.NoError(t, err)
+	})
+	mux.HandleFunc(fmt.Sprintf(blockBySlotPath, 97), func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, sszContentType, r.Header.Get("Accept"))
+		_, err := w.Write([]byte("block"))
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	o, err := FromRemote(context.Background(), srv.URL+"/")
+	require.NoError(t, err)
+	assert.DeepEqual(t, enc, o.State)
+	assert.DeepEqual(t, []byte("block"), o.Block)
+}
+
+func TestFromRemote_Errors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "state not found", http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	_, err := FromRemote(context.Background(), srv.URL)
+	assert.ErrorContains(t, "unexpected status code 404", err)
+	_, err = FromRemote(context.Background(), "ftp://localhost")
+	assert.ErrorContains(t, "must use http or https", err)
+}
+
+func TestLatestBlockHeader(t *testing.T) {
+	header, err := latestBlockHeader(encodedState(t))
+	require.NoError(t, err)
+	assert.Equal(t, uint64(97), uint64(header.Slot))
+
+	_, err = latestBlockHeader([]byte{1, 2, 3})
+	assert.ErrorContains(t, "too short", err)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/libp2p/go-libp2p-core/peer"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers"
 	"github.com/prysmaticlabs/prysm/beacon-chain/p2p/peers/scorers"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	"github.com/prysmaticlabs/prysm/shared/mathutil"
@@ -116,10 +117,29 @@ func (f *blocksFetcher) filterPeers(ctx context.Context, peers []peer.ID, peersP
 		overallScore := blockProviderScore*(1.0-f.capacityWeight) + capScore*f.capacityWeight
 		return math.Round(overallScore*scorers.ScoreRoundingFactor) / scorers.ScoreRoundingFactor
 	})
+	if flags.Get().PreferTrustedSyncPeers {
+		peers = trustedPeersFirst(f.p2p.Peers(), peers)
+	}
 
 	return trimPeers(peers, peersPercentage)
 }
 
+// trustedPeersFirst moves the trusted peers to the front of the list, keeping the order of the others.
+func trustedPeersFirst(peerStatus *peers.Status, pids []peer.ID) []peer.ID {
+	sorted := make([]peer.ID, 0, len(pids))
+	for _, pid := range pids {
+		if peerStatus.IsTrusted(pid) {
+			sorted = append(sorted, pid)
+		}
+	}
+	for _, pid := range pids {
+		if !peerStatus.IsTrusted(pid) {
+			sorted = append(sorted, pid)
+		}
+	}
+	return sorted
+}
+
 // trimPeers limits peer list, returning only specified percentage of peers.
 // Takes system constraints into account (min/max peers to sync).
 func trimPeers(peers []peer.ID, peersPercentage float64) []peer.ID {
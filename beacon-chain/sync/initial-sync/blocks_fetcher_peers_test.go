@@ -301,6 +301,34 @@ func TestBlocksFetcher_filterPeers(t *testing.T) {
 	}
 }
 
+func TestBlocksFetcher_filterPeers_PreferTrusted(t *testing.T) {
+	mc, p2p, _ := initializeTestServices(t, []types.Slot{}, []*peerData{})
+	fetcher := newBlocksFetcher(context.Background(), &blocksFetcherConfig{
+		chain: mc,
+		p2p:   p2p,
+	})
+	p2p.Peers().SetTrustedPeers([]peer.ID{"c"})
+	scorer := p2p.Peers().Scorers().BlockProviderScorer()
+	scorer.IncrementProcessedBlocks("a", scorer.Params().ProcessedBlocksCap)
+	scorer.IncrementProcessedBlocks("b", scorer.Params().ProcessedBlocksCap/2)
+	peerIDs := []peer.ID{"a", "b", "c"}
+
+	filteredPIDs := fetcher.filterPeers(context.Background(), peerIDs, 1.0)
+	assert.Equal(t, 3, len(filteredPIDs))
+
+	resetFlags := flags.Get()
+	flags.Init(&flags.GlobalFlags{
+		BlockBatchLimit:            64,
+		BlockBatchLimitBurstFactor: 10,
+		PreferTrustedSyncPeers:     true,
+	})
+	defer flags.Init(resetFlags)
+	for i := 0; i < 10; i++ {
+		filteredPIDs = fetcher.filterPeers(context.Background(), peerIDs, 1.0)
+		assert.Equal(t, peer.ID("c"), filteredPIDs[0], "Trusted peer is not preferred")
+	}
+}
+
 func TestBlocksFetcher_removeStalePeerLocks(t *testing.T) {
 	type peerData struct {
 		peerID   peer.ID
@@ -2,6 +2,7 @@ package sync
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	libp2pcore "github.com/libp2p/go-libp2p-core"
@@ -40,6 +41,29 @@ func (s *Service) beaconBlocksByRangeRPCHandler(ctx context.Context, msg interfa
 		traceutil.AnnotateError(span, err)
 		return err
 	}
+	// Blocks below the lowest retained slot have been pruned from the database.
+	lowestSlot, err := s.cfg.DB.LowestRetainedSlot(ctx)
+	if err != nil {
+		s.writeErrorResponseToStream(responseCodeServerError, p2ptypes.ErrGeneric.Error(), stream)
+		traceutil.AnnotateError(span, err)
+		return err
+	}
+	if m.StartSlot.Add(m.Step*(m.Count-1)) < lowestSlot {
+		reason := fmt.Sprintf("%s: lowest available slot is %d", p2ptypes.ErrResourceUnavailable.Error(), lowestSlot)
+		s.writeErrorResponseToStream(responseCodeResourceUnavailable, reason, stream)
+		traceutil.AnnotateError(span, p2ptypes.ErrResourceUnavailable)
+		return p2ptypes.ErrResourceUnavailable
+	}
+	// A range which overlaps the pruned blocks is served from the first requested slot which is
+	// still retained.
+	if m.StartSlot < lowestSlot {
+		skipped := (uint64(lowestSlot-m.StartSlot) + m.Step - 1) / m.Step
+		m = &pb.BeaconBlocksByRangeRequest{
+			StartSlot: m.StartSlot.Add(skipped * m.Step),
+			Count:     m.Count - skipped,
+			Step:      m.Step,
+		}
+	}
 
 	// The initial count for the first batch to be returned back.
 	count := m.Count
@@ -274,6 +274,83 @@ func TestRPCBeaconBlocksByRange_ReturnsGenesisBlock(t *testing.T) {
 	}
 }
 
+func TestRPCBeaconBlocksByRange_PrunedHistory(t *testing.T) {
+	p1 := p2ptest.NewTestP2P(t)
+	p2 := p2ptest.NewTestP2P(t)
+	p1.Connect(p2)
+	assert.Equal(t, 1, len(p1.BHost.Network().Peers()), "Expected peers to be connected")
+	d := db.SetupDB(t)
+	ctx := context.Background()
+
+	genesis := testutil.NewBeaconBlock()
+	parent, err := genesis.Block.HashTreeRoot()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(genesis)))
+	require.NoError(t, d.SaveGenesisBlockRoot(ctx, parent))
+	for i := types.Slot(1); i <= 4; i++ {
+		blk := testutil.NewBeaconBlock()
+		blk.Block.Slot = i
+		blk.Block.ParentRoot = bytesutil.SafeCopyBytes(parent[:])
+		require.NoError(t, d.SaveBlock(ctx, wrapper.WrappedPhase0SignedBeaconBlock(blk)))
+		parent, err = blk.Block.HashTreeRoot()
+		require.NoError(t, err)
+		require.NoError(t, d.SaveStateSummary(ctx, &pb.StateSummary{Slot: i, Root: parent[:]}))
+	}
+	st, err := testutil.NewBeaconState()
+	require.NoError(t, err)
+	require.NoError(t, d.SaveState(ctx, st, parent))
+	require.NoError(t, d.SaveFinalizedCheckpoint(ctx, &ethpb.Checkpoint{Epoch: 0, Root: parent[:]}))
+	require.NoError(t, d.PruneHistory(ctx, parent))
+
+	// The requested range is entirely below the lowest retained slot.
+	req := &pb.BeaconBlocksByRangeRequest{
+		StartSlot: 1,
+		Step:      1,
+		Count:     3,
+	}
+	r := &Service{cfg: &Config{P2P: p1, DB: d, Chain: &chainMock.ChainService{}}, rateLimiter: newRateLimiter(p1)}
+	pcl := protocol.ID(p2p.RPCBlocksByRangeTopicV1)
+	var wg sync.WaitGroup
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectFailure(t, responseCodeResourceUnavailable, p2ptypes.ErrResourceUnavailable.Error()+": lowest available slot is 4", stream)
+	})
+
+	stream1, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	err = r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream1)
+	assert.ErrorContains(t, p2ptypes.ErrResourceUnavailable.Error(), err)
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+
+	// The requested range overlaps the retained blocks, which are returned.
+	req = &pb.BeaconBlocksByRangeRequest{
+		StartSlot: 2,
+		Step:      2,
+		Count:     3,
+	}
+	r.rateLimiter.limiterMap[string(pcl)] = leakybucket.NewCollector(0.000001, int64(flags.Get().BlockBatchLimit), false)
+	wg.Add(1)
+	p2.BHost.SetStreamHandler(pcl, func(stream network.Stream) {
+		defer wg.Done()
+		expectSuccess(t, stream)
+		res := testutil.NewBeaconBlock()
+		assert.NoError(t, r.cfg.P2P.Encoding().DecodeWithMaxLength(stream, res))
+		assert.Equal(t, types.Slot(4), res.Block.Slot)
+	})
+
+	stream2, err := p1.BHost.NewStream(context.Background(), p2.BHost.ID(), pcl)
+	require.NoError(t, err)
+	require.NoError(t, r.beaconBlocksByRangeRPCHandler(context.Background(), req, stream2))
+
+	if testutil.WaitTimeout(&wg, 1*time.Second) {
+		t.Fatal("Did not receive stream within 1 sec")
+	}
+}
+
 func TestRPCBeaconBlocksByRange_RPCHandlerRateLimitOverflow(t *testing.T) {
 	d := db.SetupDB(t)
 	saveBlocks := func(req *pb.BeaconBlocksByRangeRequest) {
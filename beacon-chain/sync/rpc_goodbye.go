@@ -70,6 +70,11 @@ func (s *Service) sendGoodbye(ctx context.Context, id peer.ID) error {
 }
 
 func (s *Service) sendGoodByeAndDisconnect(ctx context.Context, code p2ptypes.RPCGoodbyeCode, id peer.ID) error {
+	// Trusted peers are never disconnected.
+	if s.cfg.P2P.Peers().IsTrusted(id) {
+		log.WithField("peer", id).Debug("Not disconnecting from trusted peer")
+		return nil
+	}
 	lock := mputil.NewMultilock(id.String())
 	lock.Lock()
 	defer lock.Unlock()
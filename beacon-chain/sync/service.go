@@ -28,8 +28,10 @@ import (
 	"github.com/prysmaticlabs/prysm/beacon-chain/state/stategen"
 	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
 	"github.com/prysmaticlabs/prysm/shared"
 	"github.com/prysmaticlabs/prysm/shared/abool"
+	"github.com/prysmaticlabs/prysm/shared/event"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/runutil"
 	"github.com/prysmaticlabs/prysm/shared/slotutil"
@@ -69,6 +71,9 @@ type Config struct {
 	BlockNotifier     blockfeed.Notifier
 	OperationNotifier operation.Notifier
 	StateGen          *stategen.State
+	// Feeds of gossip attestations and blocks for the in-process slasher, nil if it is disabled.
+	SlasherAttestationsFeed *event.Feed
+	SlasherBlockHeadersFeed *event.Feed
 }
 
 // This defines the interface for interacting with block chain service
@@ -111,6 +116,8 @@ type Service struct {
 	seenAttesterSlashingCache        map[uint64]bool
 	badBlockCache                    *lru.Cache
 	badBlockLock                     sync.RWMutex
+	slasherAttsQueue                 chan *ethpb.Attestation
+	slasherBlocksQueue               chan block.SignedBeaconBlock
 }
 
 // NewService initializes new regular sync service.
@@ -128,6 +135,8 @@ func NewService(ctx context.Context, cfg *Config) *Service {
 		seenPendingBlocks:    make(map[[32]byte]bool),
 		blkRootToPendingAtts: make(map[[32]byte][]*ethpb.SignedAggregateAttestationAndProof),
 		rateLimiter:          rLimiter,
+		slasherAttsQueue:     make(chan *ethpb.Attestation, slasherQueueSize),
+		slasherBlocksQueue:   make(chan block.SignedBeaconBlock, slasherQueueSize),
 	}
 
 	go r.registerHandlers()
@@ -150,6 +159,9 @@ func (s *Service) Start() {
 	s.processPendingBlocksQueue()
 	s.processPendingAttsQueue()
 	s.maintainPeerStatuses()
+	if s.cfg.SlasherAttestationsFeed != nil || s.cfg.SlasherBlockHeadersFeed != nil {
+		go s.sendToSlasherRoutine()
+	}
 	if !flags.Get().DisableSync {
 		s.resyncIfBehind()
 	}
@@ -0,0 +1,117 @@
+package sync
+
+import (
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/shared/attestationutil"
+	"github.com/prysmaticlabs/prysm/shared/blockutil"
+)
+
+// Number of validated gossip messages which can wait to be sent to the in-process slasher.
+// Messages received while the queue is full are dropped rather than delaying validation.
+const slasherQueueSize = 4096
+
+// Queues an attestation received over gossip to be sent to the in-process slasher,
+// if it is enabled.
+func (s *Service) queueAttestationForSlasher(att *ethpb.Attestation) {
+	if s.cfg.SlasherAttestationsFeed == nil {
+		return
+	}
+	select {
+	case s.slasherAttsQueue <- att:
+	default:
+		log.Debug("Slasher attestation queue is full, dropping attestation")
+	}
+}
+
+// Queues a block received over gossip to be sent to the in-process slasher,
+// if it is enabled.
+func (s *Service) queueBlockForSlasher(blk block.SignedBeaconBlock) {
+	if s.cfg.SlasherBlockHeadersFeed == nil {
+		return
+	}
+	select {
+	case s.slasherBlocksQueue <- blk:
+	default:
+		log.Debug("Slasher block queue is full, dropping block")
+	}
+}
+
+// Sends the queued gossip attestations and blocks to the in-process slasher, one at a time,
+// until the service is stopped.
+func (s *Service) sendToSlasherRoutine() {
+	for {
+		select {
+		case att := <-s.slasherAttsQueue:
+			s.sendAttestationToSlasher(att)
+		case blk := <-s.slasherBlocksQueue:
+			s.sendBlockHeaderToSlasher(blk)
+		case <-s.ctx.Done():
+			return
+		}
+	}
+}
+
+// Sends an attestation received over gossip, converted to an indexed attestation,
+// to the in-process slasher if its signature is valid.
+func (s *Service) sendAttestationToSlasher(att *ethpb.Attestation) {
+	preState, err := s.cfg.Chain.AttestationPreState(s.ctx, att)
+	if err != nil {
+		log.WithError(err).Debug("Could not retrieve pre state for slasher")
+		return
+	}
+	committee, err := helpers.BeaconCommitteeFromState(preState, att.Data.Slot, att.Data.CommitteeIndex)
+	if err != nil {
+		log.WithError(err).Debug("Could not get attestation committee for slasher")
+		return
+	}
+	indexedAtt, err := attestationutil.ConvertToIndexed(s.ctx, att, committee)
+	if err != nil {
+		log.WithError(err).Debug("Could not convert to indexed attestation for slasher")
+		return
+	}
+	if err := blocks.VerifyIndexedAttestation(s.ctx, preState, indexedAtt); err != nil {
+		log.WithError(err).Debug("Could not verify attestation for slasher")
+		return
+	}
+	signingRoot, err := indexedAtt.Data.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute attestation data root for slasher")
+		return
+	}
+	s.cfg.SlasherAttestationsFeed.Send(&slashertypes.IndexedAttestationWrapper{
+		IndexedAttestation: indexedAtt,
+		SigningRoot:        signingRoot,
+	})
+}
+
+// Sends the signed header of a block received over gossip to the in-process slasher
+// if its proposer signature is valid.
+func (s *Service) sendBlockHeaderToSlasher(blk block.SignedBeaconBlock) {
+	headState, err := s.cfg.Chain.HeadState(s.ctx)
+	if err != nil {
+		log.WithError(err).Debug("Could not retrieve head state for slasher")
+		return
+	}
+	if err := blocks.VerifyBlockSignatureUsingCurrentFork(headState, blk); err != nil {
+		log.WithError(err).Debug("Could not verify block signature for slasher")
+		return
+	}
+	header, err := blockutil.SignedBeaconBlockHeaderFromBlockInterface(blk)
+	if err != nil {
+		log.WithError(err).Debug("Could not extract block header for slasher")
+		return
+	}
+	signingRoot, err := header.Header.HashTreeRoot()
+	if err != nil {
+		log.WithError(err).Debug("Could not compute block header root for slasher")
+		return
+	}
+	s.cfg.SlasherBlockHeadersFeed.Send(&slashertypes.SignedBlockHeaderWrapper{
+		SignedBeaconBlockHeader: header,
+		SigningRoot:             signingRoot,
+	})
+}
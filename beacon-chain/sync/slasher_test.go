@@ -0,0 +1,219 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	pubsub "github.com/libp2p/go-libp2p-pubsub"
+	pubsubpb "github.com/libp2p/go-libp2p-pubsub/pb"
+	"github.com/prysmaticlabs/go-bitfield"
+	mock "github.com/prysmaticlabs/prysm/beacon-chain/blockchain/testing"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	p2ptest "github.com/prysmaticlabs/prysm/beacon-chain/p2p/testing"
+	slashertypes "github.com/prysmaticlabs/prysm/beacon-chain/slasher/types"
+	mockSync "github.com/prysmaticlabs/prysm/beacon-chain/sync/initial-sync/testing"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/block"
+	"github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/wrapper"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestService_queueBlockForSlasher(t *testing.T) {
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 64)
+	b := testutil.NewBeaconBlock()
+	b.Block.Slot = 5
+	b.Block.ProposerIndex = 3
+	var err error
+	b.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, b.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[3])
+	require.NoError(t, err)
+	blk := wrapper.WrappedPhase0SignedBeaconBlock(b)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	s := &Service{
+		cfg:                &Config{Chain: &mock.ChainService{State: beaconState}},
+		ctx:                ctx,
+		slasherBlocksQueue: make(chan block.SignedBeaconBlock, 2),
+	}
+
+	// Nothing is queued without a slasher feed.
+	s.queueBlockForSlasher(blk)
+	assert.Equal(t, 0, len(s.slasherBlocksQueue))
+
+	headersFeed := new(event.Feed)
+	headersChan := make(chan *slashertypes.SignedBlockHeaderWrapper, 2)
+	sub := headersFeed.Subscribe(headersChan)
+	defer sub.Unsubscribe()
+	s.cfg.SlasherBlockHeadersFeed = headersFeed
+
+	// A block with an invalid proposer signature is queued, but never reaches the slasher.
+	badlySigned := testutil.NewBeaconBlock()
+	badlySigned.Block.Slot = 5
+	badlySigned.Block.ProposerIndex = 4
+	badlySigned.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, badlySigned.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[3])
+	require.NoError(t, err)
+	s.queueBlockForSlasher(wrapper.WrappedPhase0SignedBeaconBlock(badlySigned))
+
+	// Blocks received while the queue is full are dropped.
+	s.queueBlockForSlasher(blk)
+	s.queueBlockForSlasher(blk)
+	assert.Equal(t, 2, len(s.slasherBlocksQueue))
+
+	go s.sendToSlasherRoutine()
+	received := <-headersChan
+	assert.Equal(t, b.Block.Slot, received.SignedBeaconBlockHeader.Header.Slot)
+	assert.Equal(t, b.Block.ProposerIndex, received.SignedBeaconBlockHeader.Header.ProposerIndex)
+	wantedRoot, err := received.SignedBeaconBlockHeader.Header.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, received.SigningRoot)
+	select {
+	case <-headersChan:
+		t.Fatal("Block with an invalid signature was sent to the slasher")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestValidateBeaconBlockPubSub_SeenProposerSlot_SendsToSlasher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := p2ptest.NewTestP2P(t)
+	beaconState, privKeys := testutil.DeterministicGenesisState(t, 100)
+	proposerIdx, err := helpers.BeaconProposerIndex(beaconState)
+	require.NoError(t, err)
+
+	// A second, conflicting block from the proposer for an already seen slot.
+	msg := testutil.NewBeaconBlock()
+	msg.Block.Slot = 1
+	msg.Block.ProposerIndex = proposerIdx
+	msg.Block.Body.Graffiti = bytesutil.PadTo([]byte("conflicting"), 32)
+	msg.Signature, err = helpers.ComputeDomainAndSign(beaconState, 0, msg.Block, params.BeaconConfig().DomainBeaconProposer, privKeys[proposerIdx])
+	require.NoError(t, err)
+
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	chainService := &mock.ChainService{Genesis: time.Unix(time.Now().Unix()-int64(params.BeaconConfig().SecondsPerSlot), 0),
+		State: beaconState,
+	}
+	headersFeed := new(event.Feed)
+	headersChan := make(chan *slashertypes.SignedBlockHeaderWrapper, 1)
+	sub := headersFeed.Subscribe(headersChan)
+	defer sub.Unsubscribe()
+	r := &Service{
+		cfg: &Config{
+			P2P:                     p,
+			InitialSync:             &mockSync.Sync{IsSyncing: false},
+			Chain:                   chainService,
+			BlockNotifier:           chainService.BlockNotifier(),
+			SlasherBlockHeadersFeed: headersFeed,
+		},
+		ctx:                ctx,
+		seenBlockCache:     c,
+		slasherBlocksQueue: make(chan block.SignedBeaconBlock, 1),
+	}
+
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, msg)
+	require.NoError(t, err)
+	topic := p2p.GossipTypeMapping[reflect.TypeOf(msg)]
+	m := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		},
+	}
+	r.setSeenBlockIndexSlot(msg.Block.Slot, msg.Block.ProposerIndex)
+	time.Sleep(10 * time.Millisecond) // Wait for cached value to pass through buffers.
+	assert.Equal(t, pubsub.ValidationIgnore, r.validateBeaconBlockPubSub(ctx, "", m))
+
+	go r.sendToSlasherRoutine()
+	received := <-headersChan
+	wantedRoot, err := msg.Block.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, received.SigningRoot)
+	assert.DeepEqual(t, msg.Signature, received.SignedBeaconBlockHeader.Signature)
+}
+
+func TestValidateCommitteeIndexBeaconAttestation_SeenCommitteeIndicesSlot_SendsToSlasher(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	p := p2ptest.NewTestP2P(t)
+	savedState, keys := testutil.DeterministicGenesisState(t, 64)
+	require.NoError(t, savedState.SetSlot(1))
+	chain := &mock.ChainService{
+		// 1 slot ago.
+		Genesis:        time.Now().Add(time.Duration(-1*int64(params.BeaconConfig().SecondsPerSlot)) * time.Second),
+		ValidatorsRoot: [32]byte{'A'},
+		State:          savedState,
+	}
+	attsFeed := new(event.Feed)
+	attsChan := make(chan *slashertypes.IndexedAttestationWrapper, 1)
+	sub := attsFeed.Subscribe(attsChan)
+	defer sub.Unsubscribe()
+	c, err := lru.New(10)
+	require.NoError(t, err)
+	s := &Service{
+		cfg: &Config{
+			InitialSync:             &mockSync.Sync{IsSyncing: false},
+			P2P:                     p,
+			Chain:                   chain,
+			OperationNotifier:       chain.OperationNotifier(),
+			SlasherAttestationsFeed: attsFeed,
+		},
+		ctx:                              ctx,
+		seenUnAggregatedAttestationCache: c,
+		slasherAttsQueue:                 make(chan *ethpb.Attestation, 1),
+	}
+	digest, err := s.forkDigest()
+	require.NoError(t, err)
+
+	// A vote for a different block by a validator whose vote for the slot was already seen.
+	att := &ethpb.Attestation{
+		AggregationBits: bitfield.Bitlist{0b101},
+		Data: &ethpb.AttestationData{
+			BeaconBlockRoot: bytesutil.PadTo([]byte("conflicting"), 32),
+			CommitteeIndex:  0,
+			Slot:            1,
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+	}
+	committee, err := helpers.BeaconCommitteeFromState(savedState, att.Data.Slot, att.Data.CommitteeIndex)
+	require.NoError(t, err)
+	domain, err := helpers.Domain(savedState.Fork(), att.Data.Target.Epoch, params.BeaconConfig().DomainBeaconAttester, savedState.GenesisValidatorRoot())
+	require.NoError(t, err)
+	attRoot, err := helpers.ComputeSigningRoot(att.Data, domain)
+	require.NoError(t, err)
+	att.Signature = keys[committee[0]].Sign(attRoot[:]).Marshal()
+
+	buf := new(bytes.Buffer)
+	_, err = p.Encoding().EncodeGossip(buf, att)
+	require.NoError(t, err)
+	topic := fmt.Sprintf("/eth2/%x/beacon_attestation_1", digest)
+	m := &pubsub.Message{
+		Message: &pubsubpb.Message{
+			Data:  buf.Bytes(),
+			Topic: &topic,
+		},
+	}
+	s.setSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits)
+	time.Sleep(10 * time.Millisecond) // Wait for cached value to pass through buffers.
+	assert.Equal(t, pubsub.ValidationIgnore, s.validateCommitteeIndexBeaconAttestation(ctx, "", m))
+
+	go s.sendToSlasherRoutine()
+	received := <-attsChan
+	assert.DeepEqual(t, []uint64{uint64(committee[0])}, received.IndexedAttestation.AttestingIndices)
+	wantedRoot, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, wantedRoot, received.SigningRoot)
+}
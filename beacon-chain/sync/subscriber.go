@@ -359,7 +359,7 @@ func (s *Service) validPeersExist(subnetTopic string) bool {
 
 func (s *Service) retrievePersistentSubs(currSlot types.Slot) []uint64 {
 	// Persistent subscriptions from validators
-	persistentSubs := s.persistentSubnetIndices()
+	persistentSubs := s.persistentSubnetIndices(currSlot)
 	// Update desired topic indices for aggregator
 	wantedSubs := s.aggregatorSubnetIndices(currSlot)
 
@@ -4,10 +4,13 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/pkg/errors"
 	types "github.com/prysmaticlabs/eth2-types"
 	"github.com/prysmaticlabs/prysm/beacon-chain/cache"
 	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	"github.com/prysmaticlabs/prysm/beacon-chain/p2p"
+	"github.com/prysmaticlabs/prysm/cmd/beacon-chain/flags"
 	eth "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	"github.com/prysmaticlabs/prysm/shared/sliceutil"
@@ -36,10 +39,32 @@ func (s *Service) committeeIndexBeaconAttestationSubscriber(_ context.Context, m
 	return s.cfg.AttPool.SaveUnaggregatedAttestation(a)
 }
 
-func (s *Service) persistentSubnetIndices() []uint64 {
+func (s *Service) persistentSubnetIndices(currentSlot types.Slot) []uint64 {
+	if flags.Get().SubscribeToNodeIDSubnets {
+		return s.nodeIDSubnetIndices(helpers.SlotToEpoch(currentSlot))
+	}
 	return cache.SubnetIDs.GetAllSubnets()
 }
 
+// nodeIDSubnetIndices returns the long-lived subnets derived from the node ID of the local node.
+func (s *Service) nodeIDSubnetIndices(epoch types.Epoch) []uint64 {
+	record := s.cfg.P2P.ENR()
+	if record == nil {
+		return nil
+	}
+	node, err := enode.New(enode.ValidSchemes, record)
+	if err != nil {
+		log.WithError(err).Debug("Could not retrieve local node")
+		return nil
+	}
+	subnets, err := p2p.ComputeSubscribedSubnets(node.ID(), epoch)
+	if err != nil {
+		log.WithError(err).Error("Could not compute subscribed subnets")
+		return nil
+	}
+	return subnets
+}
+
 func (s *Service) aggregatorSubnetIndices(currentSlot types.Slot) []uint64 {
 	endEpoch := helpers.SlotToEpoch(currentSlot) + 1
 	endSlot := params.BeaconConfig().SlotsPerEpoch.Mul(uint64(endEpoch))
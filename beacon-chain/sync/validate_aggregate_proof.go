@@ -76,6 +76,10 @@ func (s *Service) validateAggregateAndProof(ctx context.Context, pid peer.ID, ms
 		return pubsub.ValidationIgnore
 	}
 
+	// Queue the aggregate for the slasher before any of the seen checks below can ignore it.
+	// The slasher routine verifies the attestation signature.
+	s.queueAttestationForSlasher(m.Message.Aggregate)
+
 	// Verify this is the first aggregate received from the aggregator with index and slot.
 	if s.hasSeenAggregatorIndexEpoch(m.Message.Aggregate.Data.Target.Epoch, m.Message.AggregatorIndex) {
 		return pubsub.ValidationIgnore
@@ -86,6 +86,11 @@ func (s *Service) validateCommitteeIndexBeaconAttestation(ctx context.Context, p
 		return pubsub.ValidationReject
 	}
 
+	// Conflicting votes from a validator are ignored below once its first vote for the slot has
+	// been seen, so the attestation is queued for the slasher first. The slasher routine verifies
+	// the attestation signature before the attestation reaches the slasher.
+	s.queueAttestationForSlasher(att)
+
 	// Verify this the first attestation received for the participating validator for the slot.
 	if s.hasSeenCommitteeIndicesSlot(att.Data.Slot, att.Data.CommitteeIndex, att.AggregationBits) {
 		return pubsub.ValidationIgnore
@@ -75,6 +75,11 @@ func (s *Service) validateBeaconBlockPubSub(ctx context.Context, pid peer.ID, ms
 		},
 	})
 
+	// A second block from the proposer for the slot is ignored below, but it is exactly what the
+	// slasher needs to detect a double proposal, so it is queued first. The slasher routine
+	// verifies the proposer signature before the block reaches the slasher.
+	s.queueBlockForSlasher(blk)
+
 	// Verify the block is the first block received for the proposer for the slot.
 	if s.hasSeenBlockIndexSlot(blk.Block().Slot(), blk.Block().ProposerIndex()) {
 		return pubsub.ValidationIgnore
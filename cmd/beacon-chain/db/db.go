@@ -18,9 +18,11 @@ var Commands = &cli.Command{
 	Subcommands: []*cli.Command{
 		{
 			Name:        "restore",
-			Description: `restores a database from a backup file`,
+			Description: `restores a database from a backup file, or from the incremental backups in a directory up to a slot`,
 			Flags: cmd.WrapFlags([]cli.Flag{
 				cmd.RestoreSourceFileFlag,
+				cmd.RestoreSourceDirFlag,
+				cmd.RestoreToSlotFlag,
 				cmd.RestoreTargetDirFlag,
 			}),
 			Before: tos.VerifyTosAcceptedOrPrompt,
@@ -31,5 +33,34 @@ var Commands = &cli.Command{
 				return nil
 			},
 		},
+		{
+			Name:        "convert",
+			Description: `converts a database to another storage engine, given by --db-backend`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+				cmd.DBBackendFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Convert(cliCtx); err != nil {
+					log.Fatalf("Could not convert database: %v", err)
+				}
+				return nil
+			},
+		},
+		{
+			Name:        "verify",
+			Description: `verifies the consistency of the blocks, states and state summaries of a database`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				cmd.DataDirFlag,
+			}),
+			Before: tos.VerifyTosAcceptedOrPrompt,
+			Action: func(cliCtx *cli.Context) error {
+				if err := beacondb.Verify(cliCtx); err != nil {
+					log.Fatalf("Could not verify database: %v", err)
+				}
+				return nil
+			},
+		},
 	},
 }
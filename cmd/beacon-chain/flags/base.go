@@ -11,13 +11,13 @@ var (
 	// HTTPWeb3ProviderFlag provides an HTTP access endpoint to an ETH 1.0 RPC.
 	HTTPWeb3ProviderFlag = &cli.StringFlag{
 		Name:  "http-web3provider",
-		Usage: "A mainchain web3 provider string http endpoint. Can contain auth header as well in the format --http-web3provider=\"https://goerli.infura.io/v3/xxxx,Basic xxx\" for project secret (base64 encoded) and --http-web3provider=\"https://goerli.infura.io/v3/xxxx,Bearer xxx\" for jwt use",
+		Usage: "A mainchain web3 provider string http endpoint, ws:// endpoint or IPC path. Websocket and IPC providers are subscribed to for new blocks and deposit logs. Can contain auth header as well in the format --http-web3provider=\"https://goerli.infura.io/v3/xxxx,Basic xxx\" for project secret (base64 encoded) and --http-web3provider=\"https://goerli.infura.io/v3/xxxx,Bearer xxx\" for jwt use",
 		Value: "",
 	}
 	// FallbackWeb3ProviderFlag provides a fallback endpoint to an ETH 1.0 RPC.
 	FallbackWeb3ProviderFlag = &cli.StringSliceFlag{
 		Name:  "fallback-web3provider",
-		Usage: "A mainchain web3 provider string http endpoint. This is our fallback web3 provider, this flag may be used multiple times.",
+		Usage: "A mainchain web3 provider string http endpoint, ws:// endpoint or IPC path. This is our fallback web3 provider, this flag may be used multiple times.",
 	}
 	// DepositContractFlag defines a flag for the deposit contract address.
 	DepositContractFlag = &cli.StringFlag{
@@ -91,6 +91,11 @@ var (
 		Usage: "The required number of valid peers to connect with before syncing.",
 		Value: 3,
 	}
+	// PreferTrustedSyncPeers specifies whether blocks are requested from the trusted peers first during initial sync.
+	PreferTrustedSyncPeers = &cli.BoolFlag{
+		Name:  "prefer-trusted-sync-peers",
+		Usage: "Requests blocks from the trusted peers first when syncing with external peers.",
+	}
 	// ContractDeploymentBlock is the block in which the eth1 deposit contract was deployed.
 	ContractDeploymentBlock = &cli.IntFlag{
 		Name:  "contract-deployment-block",
@@ -115,6 +120,19 @@ var (
 		Usage: "The slot durations of when an archived state gets saved in the DB.",
 		Value: 2048,
 	}
+	// HistoryRetentionEpochs specifies the number of epochs behind the finalized checkpoint for which blocks and
+	// states are kept in the DB. Older history is pruned.
+	HistoryRetentionEpochs = &cli.Uint64Flag{
+		Name: "history-retention-epochs",
+		Usage: "The number of epochs behind the finalized checkpoint for which blocks and states are kept in the DB. " +
+			"Older blocks, states and indices are pruned as the chain finalizes. 0 keeps the full history.",
+	}
+	// IncrementalBackupInterval specifies how often an incremental backup of the DB is written while the node runs.
+	IncrementalBackupInterval = &cli.DurationFlag{
+		Name: "incremental-db-backup-interval",
+		Usage: "How often to write an incremental backup of the DB, holding only the keys changed since the previous one, " +
+			"to the --db-backup-output-dir. Backups are disabled if not set.",
+	}
 	// DisableDiscv5 disables running discv5.
 	DisableDiscv5 = &cli.BoolFlag{
 		Name:  "disable-discv5",
@@ -148,6 +166,12 @@ var (
 		Name:  "subscribe-all-subnets",
 		Usage: "Subscribe to all possible attestation subnets.",
 	}
+	// SubscribeToNodeIDSubnets defines a flag to derive the long-lived attestation subnets from the node ID.
+	SubscribeToNodeIDSubnets = &cli.BoolFlag{
+		Name: "subscribe-node-id-subnets",
+		Usage: "Subscribe to long-lived attestation subnets derived from the node ID and the epoch, " +
+			"instead of the random subnets of the attached validators.",
+	}
 	// HistoricalSlasherNode is a set of beacon node flags required for performing historical detection with a slasher.
 	HistoricalSlasherNode = &cli.BoolFlag{
 		Name:  "historical-slasher-node",
@@ -182,4 +206,40 @@ var (
 		Usage: "Load a genesis state from ssz file. Testnet genesis files can be found in the " +
 			"eth2-clients/eth2-testnets repository on github.",
 	}
+	// DepositSnapshotPath defines a flag to restore the deposit trie from a deposit snapshot file.
+	DepositSnapshotPath = &cli.StringFlag{
+		Name: "deposit-snapshot",
+		Usage: "Restore the deposit trie from an ssz deposit snapshot file, as served by " +
+			"/eth/v1alpha1/debug/deposit_snapshot, so that only the deposit logs after the snapshot are fetched. " +
+			"The snapshot is ignored once deposits have been processed.",
+	}
+	// CheckpointStatePath defines a flag to start the beacon chain from a finalized state file instead of genesis.
+	CheckpointStatePath = &cli.StringFlag{
+		Name: "checkpoint-state",
+		Usage: "Start the beacon chain from a finalized state in an ssz file instead of from genesis. " +
+			"Must be used together with --checkpoint-block.",
+	}
+	// CheckpointBlockPath defines a flag to provide the block of the finalized state given with --checkpoint-state.
+	CheckpointBlockPath = &cli.StringFlag{
+		Name:  "checkpoint-block",
+		Usage: "Load the block of the finalized state given with --checkpoint-state from an ssz file.",
+	}
+	// CheckpointSyncURL defines a flag to download the finalized state and block to start the beacon chain from.
+	CheckpointSyncURL = &cli.StringFlag{
+		Name: "checkpoint-sync-url",
+		Usage: "URL of the beacon API of a trusted beacon node (e.g. http://localhost:3500) to download " +
+			"the latest finalized state and block from, to start the beacon chain from instead of genesis.",
+	}
+	// SlasherFlag defines a flag to enable slashing detection in the beacon node.
+	SlasherFlag = &cli.BoolFlag{
+		Name: "slasher",
+		Usage: "Enables the in-process slasher, which detects slashable attestations and proposals received " +
+			"over gossip and submits the resulting slashings to the operations pool",
+	}
+	// SlasherDirFlag defines a path on disk where the slasher database is stored.
+	SlasherDirFlag = &cli.StringFlag{
+		Name:  "slasher-datadir",
+		Usage: "Directory for the slasher database, defaults to the beacon node data directory",
+		Value: "",
+	}
 )
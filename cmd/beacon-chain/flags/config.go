@@ -12,6 +12,8 @@ type GlobalFlags struct {
 	DisableSync                bool
 	DisableDiscv5              bool
 	SubscribeToAllSubnets      bool
+	SubscribeToNodeIDSubnets   bool
+	PreferTrustedSyncPeers     bool
 	MinimumSyncPeers           int
 	BlockBatchLimit            int
 	BlockBatchLimitBurstFactor int
@@ -48,7 +50,12 @@ func ConfigureGlobalFlags(ctx *cli.Context) {
 		log.Warn("Subscribing to All Attestation Subnets")
 		cfg.SubscribeToAllSubnets = true
 	}
+	if ctx.Bool(SubscribeToNodeIDSubnets.Name) {
+		log.Info("Subscribing to attestation subnets derived from the node ID")
+		cfg.SubscribeToNodeIDSubnets = true
+	}
 	cfg.DisableDiscv5 = ctx.Bool(DisableDiscv5.Name)
+	cfg.PreferTrustedSyncPeers = ctx.Bool(PreferTrustedSyncPeers.Name)
 	cfg.BlockBatchLimit = ctx.Int(BlockBatchLimit.Name)
 	cfg.BlockBatchLimitBurstFactor = ctx.Int(BlockBatchLimitBurstFactor.Name)
 	configureMinimumPeers(ctx, cfg)
@@ -42,6 +42,7 @@ var appFlags = []cli.Flag{
 	flags.EthApiPort,
 	flags.GPRCGatewayCorsDomain,
 	flags.MinSyncPeers,
+	flags.PreferTrustedSyncPeers,
 	flags.ContractDeploymentBlock,
 	flags.SetGCPercent,
 	flags.HeadSync,
@@ -54,14 +55,23 @@ var appFlags = []cli.Flag{
 	flags.InteropNumValidatorsFlag,
 	flags.InteropGenesisTimeFlag,
 	flags.SlotsPerArchivedPoint,
+	flags.HistoryRetentionEpochs,
+	flags.IncrementalBackupInterval,
 	flags.EnableDebugRPCEndpoints,
 	flags.SubscribeToAllSubnets,
+	flags.SubscribeToNodeIDSubnets,
 	flags.HistoricalSlasherNode,
 	flags.ChainID,
 	flags.NetworkID,
 	flags.WeakSubjectivityCheckpt,
 	flags.Eth1HeaderReqLimit,
 	flags.GenesisStatePath,
+	flags.DepositSnapshotPath,
+	flags.CheckpointStatePath,
+	flags.CheckpointBlockPath,
+	flags.CheckpointSyncURL,
+	flags.SlasherFlag,
+	flags.SlasherDirFlag,
 	cmd.EnableBackupWebhookFlag,
 	cmd.BackupWebhookOutputDir,
 	cmd.MinimalConfigFlag,
@@ -70,6 +80,7 @@ var appFlags = []cli.Flag{
 	cmd.BootstrapNode,
 	cmd.NoDiscovery,
 	cmd.StaticPeers,
+	cmd.TrustedPeers,
 	cmd.RelayNode,
 	cmd.P2PUDPPort,
 	cmd.P2PTCPPort,
@@ -111,6 +122,7 @@ var appFlags = []cli.Flag{
 	cmd.RestoreSourceFileFlag,
 	cmd.RestoreTargetDirFlag,
 	cmd.BoltMMapInitialSizeFlag,
+	cmd.DBBackendFlag,
 }
 
 func init() {
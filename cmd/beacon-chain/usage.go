@@ -73,6 +73,7 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.RestoreSourceFileFlag,
 			cmd.RestoreTargetDirFlag,
 			cmd.BoltMMapInitialSizeFlag,
+			cmd.DBBackendFlag,
 		},
 	},
 	{
@@ -110,17 +111,26 @@ var appHelpFlagGroups = []flagGroup{
 			flags.HeadSync,
 			flags.DisableSync,
 			flags.SlotsPerArchivedPoint,
+			flags.HistoryRetentionEpochs,
+			flags.IncrementalBackupInterval,
 			flags.DisableDiscv5,
 			flags.BlockBatchLimit,
 			flags.BlockBatchLimitBurstFactor,
 			flags.EnableDebugRPCEndpoints,
 			flags.SubscribeToAllSubnets,
+			flags.SubscribeToNodeIDSubnets,
 			flags.HistoricalSlasherNode,
 			flags.ChainID,
 			flags.NetworkID,
 			flags.WeakSubjectivityCheckpt,
 			flags.Eth1HeaderReqLimit,
 			flags.GenesisStatePath,
+			flags.DepositSnapshotPath,
+			flags.CheckpointStatePath,
+			flags.CheckpointBlockPath,
+			flags.CheckpointSyncURL,
+			flags.SlasherFlag,
+			flags.SlasherDirFlag,
 		},
 	},
 	{
@@ -135,8 +145,10 @@ var appHelpFlagGroups = []flagGroup{
 			cmd.P2PAllowList,
 			cmd.P2PDenyList,
 			cmd.StaticPeers,
+			cmd.TrustedPeers,
 			cmd.EnableUPnPFlag,
 			flags.MinSyncPeers,
+			flags.PreferTrustedSyncPeers,
 		},
 	},
 	{
@@ -86,6 +86,7 @@ var appHelpFlagGroups = []flagGroup{
 		Flags: []cli.Flag{
 			flags.BeaconRPCProviderFlag,
 			flags.BeaconRPCGatewayProviderFlag,
+			flags.BeaconRESTApiProviderFlag,
 			flags.CertFlag,
 			flags.EnableWebFlag,
 			flags.DisablePenaltyRewardLogFlag,
@@ -95,6 +96,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.RPCPort,
 			flags.GRPCGatewayPort,
 			flags.GRPCGatewayHost,
+			flags.KeymanagerAPIPort,
+			flags.KeymanagerAPITokenFile,
 			flags.GrpcRetriesFlag,
 			flags.GrpcRetryDelayFlag,
 			flags.GPRCGatewayCorsDomain,
@@ -105,7 +108,11 @@ var appHelpFlagGroups = []flagGroup{
 			flags.WalletDirFlag,
 			flags.WalletPasswordFileFlag,
 			flags.GraffitiFileFlag,
+			flags.ProposerSettingsFileFlag,
 			flags.EnableDutyCountDown,
+			flags.BroadcastSignedDutiesFlag,
+			flags.SlashingProtectionPostgresURLFlag,
+			flags.MinimalSlashingProtectionFlag,
 		},
 	},
 	{
@@ -55,6 +55,7 @@ require (
 	github.com/klauspost/cpuid/v2 v2.0.8 // indirect
 	github.com/koron/go-ssdp v0.0.2 // indirect
 	github.com/kr/pretty v0.2.1
+	github.com/lib/pq v1.10.9
 	github.com/libp2p/go-libp2p v0.14.4
 	github.com/libp2p/go-libp2p-blankhost v0.2.0
 	github.com/libp2p/go-libp2p-core v0.8.6
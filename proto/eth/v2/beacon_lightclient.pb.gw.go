@@ -0,0 +1,3 @@
+// +build ignore
+
+package ignore
\ No newline at end of file
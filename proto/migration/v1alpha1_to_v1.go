@@ -381,3 +381,51 @@ func V1Alpha1BeaconBlockAltairToV2(v1alpha1Block *ethpbalpha.BeaconBlockAltair)
 	}
 	return v2Block, nil
 }
+
+// V1Alpha1SyncAggregateToV1 converts a v1alpha1 SyncAggregate to v1.
+func V1Alpha1SyncAggregateToV1(v1alpha1Aggregate *ethpbalpha.SyncAggregate) *ethpbv1.SyncAggregate {
+	if v1alpha1Aggregate == nil {
+		return &ethpbv1.SyncAggregate{}
+	}
+	return &ethpbv1.SyncAggregate{
+		SyncCommitteeBits:      v1alpha1Aggregate.SyncCommitteeBits,
+		SyncCommitteeSignature: v1alpha1Aggregate.SyncCommitteeSignature,
+	}
+}
+
+// V1Alpha1SyncCommitteeMessageToV2 converts a v1alpha1 SyncCommitteeMessage to v2.
+func V1Alpha1SyncCommitteeMessageToV2(v1alpha1Msg *ethpbalpha.SyncCommitteeMessage) *ethpbv2.SyncCommitteeMessage {
+	if v1alpha1Msg == nil {
+		return &ethpbv2.SyncCommitteeMessage{}
+	}
+	return &ethpbv2.SyncCommitteeMessage{
+		Slot:            v1alpha1Msg.Slot,
+		BeaconBlockRoot: v1alpha1Msg.BlockRoot,
+		ValidatorIndex:  v1alpha1Msg.ValidatorIndex,
+		Signature:       v1alpha1Msg.Signature,
+	}
+}
+
+// V1Alpha1SignedContributionAndProofToV2 converts a v1alpha1 SignedContributionAndProof to v2.
+func V1Alpha1SignedContributionAndProofToV2(
+	v1alpha1Contribution *ethpbalpha.SignedContributionAndProof,
+) *ethpbv2.SignedContributionAndProof {
+	if v1alpha1Contribution == nil || v1alpha1Contribution.Message == nil || v1alpha1Contribution.Message.Contribution == nil {
+		return &ethpbv2.SignedContributionAndProof{}
+	}
+	contribution := v1alpha1Contribution.Message.Contribution
+	return &ethpbv2.SignedContributionAndProof{
+		Message: &ethpbv2.ContributionAndProof{
+			AggregatorIndex: v1alpha1Contribution.Message.AggregatorIndex,
+			Contribution: &ethpbv2.SyncCommitteeContribution{
+				Slot:              contribution.Slot,
+				BeaconBlockRoot:   contribution.BlockRoot,
+				SubcommitteeIndex: contribution.SubcommitteeIndex,
+				AggregationBits:   contribution.AggregationBits,
+				Signature:         contribution.Signature,
+			},
+			SelectionProof: v1alpha1Contribution.Message.SelectionProof,
+		},
+		Signature: v1alpha1Contribution.Signature,
+	}
+}
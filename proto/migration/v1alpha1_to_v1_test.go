@@ -483,3 +483,52 @@ func Test_V1Alpha1BeaconBlockAltairToV2(t *testing.T) {
 	require.NoError(t, err)
 	assert.DeepEqual(t, alphaRoot, v2Root)
 }
+
+func Test_V1Alpha1SyncCommitteeMessageToV2(t *testing.T) {
+	alphaMsg := &ethpbalpha.SyncCommitteeMessage{
+		Slot:           slot,
+		BlockRoot:      beaconBlockRoot,
+		ValidatorIndex: validatorIndex,
+		Signature:      signature,
+	}
+
+	v2Msg := V1Alpha1SyncCommitteeMessageToV2(alphaMsg)
+	assert.Equal(t, slot, v2Msg.Slot)
+	assert.DeepEqual(t, beaconBlockRoot, v2Msg.BeaconBlockRoot)
+	assert.Equal(t, validatorIndex, v2Msg.ValidatorIndex)
+	assert.DeepEqual(t, signature, v2Msg.Signature)
+}
+
+func Test_V1Alpha1SignedContributionAndProofToV2(t *testing.T) {
+	bits := bitfield.NewBitvector128()
+	bits.SetBitAt(3, true)
+	alphaContribution := &ethpbalpha.SignedContributionAndProof{
+		Message: &ethpbalpha.ContributionAndProof{
+			AggregatorIndex: validatorIndex,
+			Contribution: &ethpbalpha.SyncCommitteeContribution{
+				Slot:              slot,
+				BlockRoot:         beaconBlockRoot,
+				SubcommitteeIndex: 2,
+				AggregationBits:   bits,
+				Signature:         signature,
+			},
+			SelectionProof: selectionProof,
+		},
+		Signature: signature,
+	}
+
+	v2Contribution := V1Alpha1SignedContributionAndProofToV2(alphaContribution)
+	require.NotNil(t, v2Contribution.Message)
+	require.NotNil(t, v2Contribution.Message.Contribution)
+	assert.Equal(t, validatorIndex, v2Contribution.Message.AggregatorIndex)
+	assert.DeepEqual(t, selectionProof, v2Contribution.Message.SelectionProof)
+	assert.DeepEqual(t, signature, v2Contribution.Signature)
+	assert.Equal(t, slot, v2Contribution.Message.Contribution.Slot)
+	assert.DeepEqual(t, beaconBlockRoot, v2Contribution.Message.Contribution.BeaconBlockRoot)
+	assert.Equal(t, uint64(2), v2Contribution.Message.Contribution.SubcommitteeIndex)
+	assert.DeepEqual(t, bits, v2Contribution.Message.Contribution.AggregationBits)
+	assert.DeepEqual(t, signature, v2Contribution.Message.Contribution.Signature)
+
+	empty := V1Alpha1SignedContributionAndProofToV2(&ethpbalpha.SignedContributionAndProof{})
+	assert.Equal(t, true, empty.Message == nil)
+}
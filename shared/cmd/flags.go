@@ -94,6 +94,12 @@ var (
 		Name:  "peer",
 		Usage: "Connect with this peer. This flag may be used multiple times.",
 	}
+	// TrustedPeers specifies the peers the node always keeps a connection with.
+	TrustedPeers = &cli.StringSliceFlag{
+		Name: "trusted-peer",
+		Usage: "Connect with this trusted peer. Trusted peers are never disconnected or down-scored, are dialed again " +
+			"when disconnected and do not count towards the max peers. This flag may be used multiple times.",
+	}
 	// BootstrapNode tells the beacon node which bootstrap node to connect to
 	BootstrapNode = &cli.StringSliceFlag{
 		Name:  "bootstrap-node",
@@ -230,12 +236,29 @@ var (
 		Name:  "restore-source-file",
 		Usage: "Filepath to the backed-up database file which will be used to restore the database",
 	}
+	// RestoreSourceDirFlag specifies the directory of the incremental database backups
+	// which will be used to restore the database.
+	RestoreSourceDirFlag = &cli.StringFlag{
+		Name:  "restore-source-dir",
+		Usage: "Directory of the incremental database backups which will be used to restore the database",
+	}
+	// RestoreToSlotFlag specifies the slot up to which incremental backups are restored.
+	RestoreToSlotFlag = &cli.Uint64Flag{
+		Name:  "to-slot",
+		Usage: "Restores the incremental database backups up to the latest one taken at or below this slot. All of them are restored if not set",
+	}
 	// RestoreTargetDirFlag specifies the target directory of the restored database.
 	RestoreTargetDirFlag = &cli.StringFlag{
 		Name:  "restore-target-dir",
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// DBBackendFlag specifies the storage engine of the beacon node database.
+	DBBackendFlag = &cli.StringFlag{
+		Name: "db-backend",
+		Usage: "Storage engine of a new beacon node database: bolt or leveldb. An existing database keeps the engine " +
+			"it was created with, use `beacon-chain db convert` to change it. Defaults to bolt",
+	}
 	// BoltMMapInitialSizeFlag specifies the initial size in bytes of boltdb's mmap syscall.
 	BoltMMapInitialSizeFlag = &cli.IntFlag{
 		Name:  "bolt-mmap-initial-size",
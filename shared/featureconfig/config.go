@@ -51,6 +51,8 @@ type Flags struct {
 	EnableOptimizedBalanceUpdate        bool // EnableOptimizedBalanceUpdate uses an updated method of performing balance updates.
 	EnableDoppelGanger                  bool // EnableDoppelGanger enables doppelganger protection on startup for the validator.
 	EnableHistoricalSpaceRepresentation bool // EnableHistoricalSpaceRepresentation enables the saving of registry validators in separate buckets to save space
+	EnableColdStateDiffs                bool // EnableColdStateDiffs saves per epoch state diffs in between the archived points of the cold state section.
+	EnableLightClientServer             bool // EnableLightClientServer builds light client updates from imported blocks and serves them.
 	// Logging related toggles.
 	DisableGRPCConnectionLogs bool // Disables logging when a new grpc client has connected.
 
@@ -209,6 +211,14 @@ func ConfigureBeaconChain(ctx *cli.Context) {
 		logEnabled(enableActiveBalanceCache)
 		cfg.EnableActiveBalanceCache = true
 	}
+	if ctx.Bool(enableColdStateDiffs.Name) {
+		logEnabled(enableColdStateDiffs)
+		cfg.EnableColdStateDiffs = true
+	}
+	if ctx.Bool(enableLightClientServer.Name) {
+		logEnabled(enableLightClientServer)
+		cfg.EnableLightClientServer = true
+	}
 	Init(cfg)
 }
 
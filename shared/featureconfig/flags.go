@@ -135,6 +135,16 @@ var (
 		Name:  "enable-active-balance-cache",
 		Usage: "This enables active balance cache cache to improve node performance during block processing",
 	}
+	enableColdStateDiffs = &cli.BoolFlag{
+		Name: "enable-cold-state-diffs",
+		Usage: "Saves finalized states in between archived points as compact per epoch state diffs, " +
+			"so that historical states can be loaded without replaying blocks",
+	}
+	enableLightClientServer = &cli.BoolFlag{
+		Name: "enable-light-client-server",
+		Usage: "Builds light client updates from imported blocks, and serves them over the beacon API " +
+			"and the light client event topics",
+	}
 )
 
 // devModeFlags holds list of flags that are set when development mode is on.
@@ -196,6 +206,8 @@ var BeaconChainFlags = append(deprecatedFlags, []cli.Flag{
 	correctlyInsertOrphanedAtts,
 	correctlyPruneCanonicalAtts,
 	enableActiveBalanceCache,
+	enableColdStateDiffs,
+	enableLightClientServer,
 }...)
 
 // E2EBeaconChainFlags contains a list of the beacon chain feature flags to be tested in E2E.
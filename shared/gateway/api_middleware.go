@@ -33,6 +33,8 @@ type Endpoint struct {
 	RequestURLLiterals []string        // Names of URL parameters that should not be base64-encoded.
 	RequestQueryParams []QueryParam    // Query parameters of the request.
 	GetResponse        interface{}     // The struct corresponding to the JSON structure used in a GET response.
+	DeleteRequest      interface{}     // The struct corresponding to the JSON structure used in a DELETE request.
+	DeleteResponse     interface{}     // The struct corresponding to the JSON structure used in a DELETE response.
 	Err                ErrorJson       // The struct corresponding to the error that should be returned in case of a request failure.
 	Hooks              HookCollection  // A collection of functions that can be invoked at various stages of the request/response cycle.
 	CustomHandlers     []CustomHandler // Functions that will be executed instead of the default request/response behaviour.
@@ -96,7 +98,11 @@ func (m *ApiProxyMiddleware) handleApiPath(path string, endpointFactory Endpoint
 			}
 		}
 
-		if req.Method == "POST" {
+		if req.Method == "POST" || (req.Method == "DELETE" && endpoint.DeleteRequest != nil) {
+			requestContainer := endpoint.PostRequest
+			if req.Method == "DELETE" {
+				requestContainer = endpoint.DeleteRequest
+			}
 			for _, hook := range endpoint.Hooks.OnPreDeserializeRequestBodyIntoContainer {
 				if errJson := hook(*endpoint, w, req); errJson != nil {
 					WriteError(w, errJson, nil)
@@ -104,7 +110,7 @@ func (m *ApiProxyMiddleware) handleApiPath(path string, endpointFactory Endpoint
 				}
 			}
 
-			if errJson := DeserializeRequestBodyIntoContainer(req.Body, endpoint.PostRequest); errJson != nil {
+			if errJson := DeserializeRequestBodyIntoContainer(req.Body, requestContainer); errJson != nil {
 				WriteError(w, errJson, nil)
 				return
 			}
@@ -115,11 +121,11 @@ func (m *ApiProxyMiddleware) handleApiPath(path string, endpointFactory Endpoint
 				}
 			}
 
-			if errJson := ProcessRequestContainerFields(endpoint.PostRequest); errJson != nil {
+			if errJson := ProcessRequestContainerFields(requestContainer); errJson != nil {
 				WriteError(w, errJson, nil)
 				return
 			}
-			if errJson := SetRequestBodyToRequestContainer(endpoint.PostRequest, req); errJson != nil {
+			if errJson := SetRequestBodyToRequestContainer(requestContainer, req); errJson != nil {
 				WriteError(w, errJson, nil)
 				return
 			}
@@ -151,9 +157,12 @@ func (m *ApiProxyMiddleware) handleApiPath(path string, endpointFactory Endpoint
 				return
 			}
 			var response interface{}
-			if req.Method == "GET" {
+			switch req.Method {
+			case "GET":
 				response = endpoint.GetResponse
-			} else {
+			case "DELETE":
+				response = endpoint.DeleteResponse
+			default:
 				response = endpoint.PostResponse
 			}
 			if errJson := DeserializeGrpcResponseBodyIntoContainer(grpcResponseBody, response); errJson != nil {
@@ -37,6 +37,26 @@ func (m *MockNodeClient) EXPECT() *MockNodeClientMockRecorder {
 	return m.recorder
 }
 
+// AddToPeerAccessList mocks base method
+func (m *MockNodeClient) AddToPeerAccessList(arg0 context.Context, arg1 *v1alpha1.PeerAccessList, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddToPeerAccessList", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddToPeerAccessList indicates an expected call of AddToPeerAccessList
+func (mr *MockNodeClientMockRecorder) AddToPeerAccessList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddToPeerAccessList", reflect.TypeOf((*MockNodeClient)(nil).AddToPeerAccessList), varargs...)
+}
+
 // GetGenesis mocks base method
 func (m *MockNodeClient) GetGenesis(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.Genesis, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +117,26 @@ func (mr *MockNodeClientMockRecorder) GetPeer(arg0, arg1 interface{}, arg2 ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeer", reflect.TypeOf((*MockNodeClient)(nil).GetPeer), varargs...)
 }
 
+// GetPeerAccessList mocks base method
+func (m *MockNodeClient) GetPeerAccessList(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.PeerAccessList, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPeerAccessList", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.PeerAccessList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPeerAccessList indicates an expected call of GetPeerAccessList
+func (mr *MockNodeClientMockRecorder) GetPeerAccessList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPeerAccessList", reflect.TypeOf((*MockNodeClient)(nil).GetPeerAccessList), varargs...)
+}
+
 // GetSyncStatus mocks base method
 func (m *MockNodeClient) GetSyncStatus(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.SyncStatus, error) {
 	m.ctrl.T.Helper()
@@ -137,6 +177,26 @@ func (mr *MockNodeClientMockRecorder) GetVersion(arg0, arg1 interface{}, arg2 ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVersion", reflect.TypeOf((*MockNodeClient)(nil).GetVersion), varargs...)
 }
 
+// ListETH1Endpoints mocks base method
+func (m *MockNodeClient) ListETH1Endpoints(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.ETH1Endpoints, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListETH1Endpoints", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.ETH1Endpoints)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListETH1Endpoints indicates an expected call of ListETH1Endpoints
+func (mr *MockNodeClientMockRecorder) ListETH1Endpoints(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListETH1Endpoints", reflect.TypeOf((*MockNodeClient)(nil).ListETH1Endpoints), varargs...)
+}
+
 // ListImplementedServices mocks base method
 func (m *MockNodeClient) ListImplementedServices(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.ImplementedServices, error) {
 	m.ctrl.T.Helper()
@@ -157,6 +217,26 @@ func (mr *MockNodeClientMockRecorder) ListImplementedServices(arg0, arg1 interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImplementedServices", reflect.TypeOf((*MockNodeClient)(nil).ListImplementedServices), varargs...)
 }
 
+// ListPeerBans mocks base method
+func (m *MockNodeClient) ListPeerBans(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.PeerBans, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPeerBans", varargs...)
+	ret0, _ := ret[0].(*v1alpha1.PeerBans)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPeerBans indicates an expected call of ListPeerBans
+func (mr *MockNodeClientMockRecorder) ListPeerBans(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPeerBans", reflect.TypeOf((*MockNodeClient)(nil).ListPeerBans), varargs...)
+}
+
 // ListPeers mocks base method
 func (m *MockNodeClient) ListPeers(arg0 context.Context, arg1 *emptypb.Empty, arg2 ...grpc.CallOption) (*v1alpha1.Peers, error) {
 	m.ctrl.T.Helper()
@@ -176,3 +256,23 @@ func (mr *MockNodeClientMockRecorder) ListPeers(arg0, arg1 interface{}, arg2 ...
 	varargs := append([]interface{}{arg0, arg1}, arg2...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPeers", reflect.TypeOf((*MockNodeClient)(nil).ListPeers), varargs...)
 }
+
+// RemoveFromPeerAccessList mocks base method
+func (m *MockNodeClient) RemoveFromPeerAccessList(arg0 context.Context, arg1 *v1alpha1.PeerAccessList, arg2 ...grpc.CallOption) (*emptypb.Empty, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{arg0, arg1}
+	for _, a := range arg2 {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RemoveFromPeerAccessList", varargs...)
+	ret0, _ := ret[0].(*emptypb.Empty)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromPeerAccessList indicates an expected call of RemoveFromPeerAccessList
+func (mr *MockNodeClientMockRecorder) RemoveFromPeerAccessList(arg0, arg1 interface{}, arg2 ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{arg0, arg1}, arg2...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromPeerAccessList", reflect.TypeOf((*MockNodeClient)(nil).RemoveFromPeerAccessList), varargs...)
+}
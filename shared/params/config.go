@@ -143,6 +143,10 @@ type BeaconChainConfig struct {
 	// Weak subjectivity values.
 	SafetyDecay uint64 // SafetyDecay is defined as the loss in the 1/3 consensus safety margin of the casper FFG mechanism.
 
+	// Fork choice algorithm constants.
+	ProposerScoreBoost uint64 // ProposerScoreBoost defines a value that is a % of the committee weight for fork-choice boosting.
+	IntervalsPerSlot   uint64 // IntervalsPerSlot defines the number of fork choice intervals in a slot defined in the fork choice spec.
+
 	// New values introduced in Altair hard fork 1.
 	// Participation flag indices.
 	TimelySourceFlagIndex uint8 `yaml:"TIMELY_SOURCE_FLAG_INDEX" spec:"true"` // TimelySourceFlagIndex is the source flag position of the participation bits.
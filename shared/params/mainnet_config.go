@@ -32,11 +32,15 @@ var mainnetNetworkConfig = &NetworkConfig{
 	AttestationSubnetCount:          64,
 	AttestationPropagationSlotRange: 32,
 	MaxRequestBlocks:                1 << 10, // 1024
+	MinEpochsForBlockRequests:       33024,   // MIN_VALIDATOR_WITHDRAWABILITY_DELAY + CHURN_LIMIT_QUOTIENT / 2
 	TtfbTimeout:                     5 * time.Second,
 	RespTimeout:                     10 * time.Second,
 	MaximumGossipClockDisparity:     500 * time.Millisecond,
 	MessageDomainInvalidSnappy:      [4]byte{00, 00, 00, 00},
 	MessageDomainValidSnappy:        [4]byte{01, 00, 00, 00},
+	SubnetsPerNode:                  2,
+	EpochsPerSubnetSubscription:     1 << 8,
+	AttestationSubnetExtraBits:      0,
 	ETH2Key:                         "eth2",
 	AttSubnetKey:                    "attnets",
 	SyncCommsSubnetKey:              "syncnets",
@@ -186,6 +190,10 @@ var mainnetBeaconConfig = &BeaconChainConfig{
 	// Weak subjectivity values.
 	SafetyDecay: 10,
 
+	// Fork choice values.
+	ProposerScoreBoost: 70,
+	IntervalsPerSlot:   3,
+
 	// Fork related values.
 	GenesisForkVersion:          []byte{0, 0, 0, 0},
 	AltairForkVersion:           []byte{1, 0, 0, 0},
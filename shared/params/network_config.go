@@ -14,11 +14,15 @@ type NetworkConfig struct {
 	AttestationSubnetCount          uint64        `yaml:"ATTESTATION_SUBNET_COUNT"`           // AttestationSubnetCount is the number of attestation subnets used in the gossipsub protocol.
 	AttestationPropagationSlotRange types.Slot    `yaml:"ATTESTATION_PROPAGATION_SLOT_RANGE"` // AttestationPropagationSlotRange is the maximum number of slots during which an attestation can be propagated.
 	MaxRequestBlocks                uint64        `yaml:"MAX_REQUEST_BLOCKS"`                 // MaxRequestBlocks is the maximum number of blocks in a single request.
+	MinEpochsForBlockRequests       types.Epoch   `yaml:"MIN_EPOCHS_FOR_BLOCK_REQUESTS"`      // MinEpochsForBlockRequests is the minimum epoch range over which a node must serve blocks.
 	TtfbTimeout                     time.Duration `yaml:"TTFB_TIMEOUT"`                       // TtfbTimeout is the maximum time to wait for first byte of request response (time-to-first-byte).
 	RespTimeout                     time.Duration `yaml:"RESP_TIMEOUT"`                       // RespTimeout is the maximum time for complete response transfer.
 	MaximumGossipClockDisparity     time.Duration `yaml:"MAXIMUM_GOSSIP_CLOCK_DISPARITY"`     // MaximumGossipClockDisparity is the maximum milliseconds of clock disparity assumed between honest nodes.
 	MessageDomainInvalidSnappy      [4]byte       `yaml:"MESSAGE_DOMAIN_INVALID_SNAPPY"`      // MessageDomainInvalidSnappy is the 4-byte domain for gossip message-id isolation of invalid snappy messages.
 	MessageDomainValidSnappy        [4]byte       `yaml:"MESSAGE_DOMAIN_VALID_SNAPPY"`        // MessageDomainValidSnappy is the 4-byte domain for gossip message-id isolation of valid snappy messages.
+	SubnetsPerNode                  uint64        `yaml:"SUBNETS_PER_NODE"`                   // SubnetsPerNode is the number of long-lived attestation subnets a node derives from its node ID.
+	EpochsPerSubnetSubscription     uint64        `yaml:"EPOCHS_PER_SUBNET_SUBSCRIPTION"`     // EpochsPerSubnetSubscription is the number of epochs a node stays subscribed to its node ID derived subnets.
+	AttestationSubnetExtraBits      uint64        `yaml:"ATTESTATION_SUBNET_EXTRA_BITS"`      // AttestationSubnetExtraBits is the number of extra node ID bits used to spread the node ID derived subnets.
 
 	// DiscoveryV5 Config
 	ETH2Key                    string // ETH2Key is the ENR key of the Ethereum consensus object in an enr.
@@ -6,6 +6,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math/bits"
 
 	protodb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
@@ -19,6 +20,10 @@ type SparseMerkleTrie struct {
 	depth         uint
 	branches      [][][]byte
 	originalItems [][]byte // list of provided items before hashing them into leaves.
+	// finalizedCount is the number of first items of a trie restored from a finalized branch,
+	// which are not stored. The layers of such a trie start at the nodes of the finalized branch,
+	// see layerStart.
+	finalizedCount uint64
 }
 
 // NewTrie returns a new merkle trie filled with zerohashes to use.
@@ -31,8 +36,9 @@ func NewTrie(depth uint64) (*SparseMerkleTrie, error) {
 // CreateTrieFromProto creates a Sparse Merkle Trie from its corresponding merkle trie.
 func CreateTrieFromProto(trieObj *protodb.SparseMerkleTrie) *SparseMerkleTrie {
 	trie := &SparseMerkleTrie{
-		depth:         uint(trieObj.Depth),
-		originalItems: trieObj.OriginalItems,
+		depth:          uint(trieObj.Depth),
+		originalItems:  trieObj.OriginalItems,
+		finalizedCount: trieObj.FinalizedCount,
 	}
 	branches := make([][][]byte, len(trieObj.Layers))
 	for i, layer := range trieObj.Layers {
@@ -73,35 +79,92 @@ func GenerateTrieFromItems(items [][]byte, depth uint64) (*SparseMerkleTrie, err
 	}, nil
 }
 
-// Items returns the original items passed in when creating the Merkle trie.
+// NewTrieFromFinalizedBranch restores a Merkle trie of count items from the roots of the largest
+// complete subtrees covering these items, as returned by FinalizedBranch. Only these roots and the
+// nodes on the right edge of the trie are stored, so the restored trie can be used to insert the
+// following items, to compute the root and to compute the proofs of the following items, but not
+// the proofs of the first count items, which it does not contain.
+func NewTrieFromFinalizedBranch(finalized [][]byte, count, depth uint64) (*SparseMerkleTrie, error) {
+	if count == 0 {
+		if len(finalized) != 0 {
+			return nil, errors.New("finalized branch of an empty trie is not empty")
+		}
+		return NewTrie(depth)
+	}
+	if depth >= 64 || count >= 1<<depth {
+		return nil, fmt.Errorf("%d items do not fit in a trie of depth %d", count, depth)
+	}
+	if len(finalized) != bits.OnesCount64(count) {
+		return nil, fmt.Errorf("wanted %d finalized roots for %d items, received %d", bits.OnesCount64(count), count, len(finalized))
+	}
+	// Each layer holds the finalized root of the layer, if any, followed by the partial node on
+	// the right edge of the trie which covers the next item.
+	layers := make([][][]byte, depth+1)
+	next := len(finalized) - 1
+	for i := uint64(0); i < depth; i++ {
+		if count&(1<<i) != 0 {
+			root := bytesutil.ToBytes32(finalized[next])
+			layers[i] = append(layers[i], root[:])
+			next--
+		}
+	}
+	node := ZeroHashes[0]
+	for i := uint64(0); i < depth; i++ {
+		if count&(1<<i) != 0 {
+			node = hashutil.Hash(append(layers[i][0], node[:]...))
+		} else {
+			node = hashutil.Hash(append(node[:], ZeroHashes[i][:]...))
+		}
+		if count%(1<<(i+1)) != 0 {
+			newItem := node
+			layers[i+1] = append(layers[i+1], newItem[:])
+		}
+	}
+	return &SparseMerkleTrie{
+		branches:       layers,
+		originalItems:  [][]byte{},
+		depth:          uint(depth),
+		finalizedCount: count,
+	}, nil
+}
+
+// Items returns the original items passed in when creating the Merkle trie. For a trie restored
+// from a finalized branch, these are the items inserted after the finalized ones.
 func (m *SparseMerkleTrie) Items() [][]byte {
 	return m.originalItems
 }
 
+// layerStart returns the index in the trie of the first node stored in the given layer, which is
+// not 0 for a trie restored from a finalized branch.
+func (m *SparseMerkleTrie) layerStart(layer uint) int {
+	return int((m.finalizedCount >> layer) &^ 1)
+}
+
 // Root returns the top-most, Merkle root of the trie.
 func (m *SparseMerkleTrie) Root() [32]byte {
 	enc := [32]byte{}
-	binary.LittleEndian.PutUint64(enc[:], uint64(len(m.originalItems)))
+	binary.LittleEndian.PutUint64(enc[:], m.finalizedCount+uint64(len(m.originalItems)))
 	return hashutil.Hash(append(m.branches[len(m.branches)-1][0], enc[:]...))
 }
 
-// Insert an item into the trie.
+// Insert an item into the trie. Items of a trie restored from a finalized branch can only be
+// inserted after the finalized ones.
 func (m *SparseMerkleTrie) Insert(item []byte, index int) {
-	for index >= len(m.branches[0]) {
+	for index-m.layerStart(0) >= len(m.branches[0]) {
 		m.branches[0] = append(m.branches[0], ZeroHashes[0][:])
 	}
 	someItem := bytesutil.ToBytes32(item)
-	m.branches[0][index] = someItem[:]
-	if index >= len(m.originalItems) {
+	m.branches[0][index-m.layerStart(0)] = someItem[:]
+	if itemIndex := index - int(m.finalizedCount); itemIndex >= len(m.originalItems) {
 		m.originalItems = append(m.originalItems, someItem[:])
 	} else {
-		m.originalItems[index] = someItem[:]
+		m.originalItems[itemIndex] = someItem[:]
 	}
 	currentIndex := index
 	root := bytesutil.ToBytes32(item)
 	for i := 0; i < int(m.depth); i++ {
 		isLeft := currentIndex%2 == 0
-		neighborIdx := currentIndex ^ 1
+		neighborIdx := (currentIndex ^ 1) - m.layerStart(uint(i))
 		var neighbor []byte
 		if neighborIdx >= len(m.branches[i]) {
 			neighbor = ZeroHashes[i][:]
@@ -116,27 +179,48 @@ func (m *SparseMerkleTrie) Insert(item []byte, index int) {
 			root = parentHash
 		}
 		parentIdx := currentIndex / 2
-		if len(m.branches[i+1]) == 0 || parentIdx >= len(m.branches[i+1]) {
+		if layerIdx := parentIdx - m.layerStart(uint(i+1)); len(m.branches[i+1]) == 0 || layerIdx >= len(m.branches[i+1]) {
 			newItem := root
 			m.branches[i+1] = append(m.branches[i+1], newItem[:])
 		} else {
 			newItem := root
-			m.branches[i+1][parentIdx] = newItem[:]
+			m.branches[i+1][layerIdx] = newItem[:]
 		}
 		currentIndex = parentIdx
 	}
 }
 
+// FinalizedBranch returns the roots of the largest complete subtrees covering the first count
+// items of the trie, from the largest subtree to the smallest one. The trie of these items can be
+// restored from them with NewTrieFromFinalizedBranch.
+func (m *SparseMerkleTrie) FinalizedBranch(count int) ([][]byte, error) {
+	if count < int(m.finalizedCount) || count > m.NumOfItems() {
+		return nil, fmt.Errorf("cannot get finalized branch of %d items in trie of %d items", count, m.NumOfItems())
+	}
+	finalized := make([][]byte, 0, bits.OnesCount64(uint64(count)))
+	for i := int(m.depth) - 1; i >= 0; i-- {
+		if count&(1<<uint(i)) == 0 {
+			continue
+		}
+		root := bytesutil.ToBytes32(m.branches[i][(count>>uint(i))-1-m.layerStart(uint(i))])
+		finalized = append(finalized, root[:])
+	}
+	return finalized, nil
+}
+
 // MerkleProof computes a proof from a trie's branches using a Merkle index.
 func (m *SparseMerkleTrie) MerkleProof(index int) ([][]byte, error) {
 	merkleIndex := uint(index)
-	leaves := m.branches[0]
-	if index >= len(leaves) {
-		return nil, fmt.Errorf("merkle index out of range in trie, max range: %d, received: %d", len(leaves), index)
+	numLeaves := m.layerStart(0) + len(m.branches[0])
+	if index >= numLeaves {
+		return nil, fmt.Errorf("merkle index out of range in trie, max range: %d, received: %d", numLeaves, index)
+	}
+	if index < int(m.finalizedCount) {
+		return nil, fmt.Errorf("merkle index %d is finalized, proofs are available from index %d", index, m.finalizedCount)
 	}
 	proof := make([][]byte, m.depth+1)
 	for i := uint(0); i < m.depth; i++ {
-		subIndex := (merkleIndex / (1 << i)) ^ 1
+		subIndex := ((merkleIndex / (1 << i)) ^ 1) - uint(m.layerStart(i))
 		if subIndex < uint(len(m.branches[i])) {
 			item := bytesutil.ToBytes32(m.branches[i][subIndex])
 			proof[i] = item[:]
@@ -145,7 +229,7 @@ func (m *SparseMerkleTrie) MerkleProof(index int) ([][]byte, error) {
 		}
 	}
 	enc := [32]byte{}
-	binary.LittleEndian.PutUint64(enc[:], uint64(len(m.originalItems)))
+	binary.LittleEndian.PutUint64(enc[:], m.finalizedCount+uint64(len(m.originalItems)))
 	proof[len(proof)-1] = enc[:]
 	return proof, nil
 }
@@ -155,8 +239,8 @@ func (m *SparseMerkleTrie) MerkleProof(index int) ([][]byte, error) {
 //   sha256(concat(node, self.to_little_endian_64(self.deposit_count), slice(zero_bytes32, start=0, len=24)))
 func (m *SparseMerkleTrie) HashTreeRoot() [32]byte {
 	var zeroBytes [32]byte
-	depositCount := uint64(len(m.originalItems))
-	if len(m.originalItems) == 1 && bytes.Equal(m.originalItems[0], zeroBytes[:]) {
+	depositCount := m.finalizedCount + uint64(len(m.originalItems))
+	if m.finalizedCount == 0 && len(m.originalItems) == 1 && bytes.Equal(m.originalItems[0], zeroBytes[:]) {
 		// Accounting for empty tries
 		depositCount = 0
 	}
@@ -169,9 +253,10 @@ func (m *SparseMerkleTrie) HashTreeRoot() [32]byte {
 // proto object
 func (m *SparseMerkleTrie) ToProto() *protodb.SparseMerkleTrie {
 	trie := &protodb.SparseMerkleTrie{
-		Depth:         uint64(m.depth),
-		Layers:        make([]*protodb.TrieLayer, len(m.branches)),
-		OriginalItems: m.originalItems,
+		Depth:          uint64(m.depth),
+		Layers:         make([]*protodb.TrieLayer, len(m.branches)),
+		OriginalItems:  m.originalItems,
+		FinalizedCount: m.finalizedCount,
 	}
 	for i, l := range m.branches {
 		trie.Layers[i] = &protodb.TrieLayer{
@@ -206,9 +291,10 @@ func (m *SparseMerkleTrie) Copy() *SparseMerkleTrie {
 	}
 
 	return &SparseMerkleTrie{
-		depth:         m.depth,
-		branches:      dstBranches,
-		originalItems: bytesutil.SafeCopy2dBytes(m.originalItems),
+		depth:          m.depth,
+		branches:       dstBranches,
+		originalItems:  bytesutil.SafeCopy2dBytes(m.originalItems),
+		finalizedCount: m.finalizedCount,
 	}
 }
 
@@ -218,8 +304,8 @@ func (m *SparseMerkleTrie) Copy() *SparseMerkleTrie {
 // empty 32-byte root.
 func (m *SparseMerkleTrie) NumOfItems() int {
 	var zeroBytes [32]byte
-	if len(m.originalItems) == 1 && bytes.Equal(m.originalItems[0], zeroBytes[:]) {
+	if m.finalizedCount == 0 && len(m.originalItems) == 1 && bytes.Equal(m.originalItems[0], zeroBytes[:]) {
 		return 0
 	}
-	return len(m.originalItems)
+	return int(m.finalizedCount) + len(m.originalItems)
 }
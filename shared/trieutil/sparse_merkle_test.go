@@ -257,3 +257,76 @@ func BenchmarkVerifyMerkleBranch(b *testing.B) {
 		}
 	}
 }
+
+func TestNewTrieFromFinalizedBranch(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	items := make([][]byte, 23)
+	for i := range items {
+		item := hashutil.Hash([]byte(strconv.Itoa(i)))
+		items[i] = item[:]
+	}
+	for _, count := range []int{0, 1, 2, 5, 8, 13, 16} {
+		full, err := NewTrie(depth)
+		require.NoError(t, err)
+		if count > 0 {
+			full, err = GenerateTrieFromItems(items[:count], depth)
+			require.NoError(t, err)
+		}
+		finalized, err := full.FinalizedBranch(count)
+		require.NoError(t, err)
+
+		restored, err := NewTrieFromFinalizedBranch(finalized, uint64(count), depth)
+		require.NoError(t, err)
+		require.Equal(t, full.HashTreeRoot(), restored.HashTreeRoot(), "count %d", count)
+		require.Equal(t, count, restored.NumOfItems())
+		// Only the finalized roots and the right edge of the trie are stored.
+		for i, layer := range restored.branches {
+			require.Equal(t, true, len(layer) <= 2, "count %d, layer %d", count, i)
+		}
+		restoredFinalized, err := restored.FinalizedBranch(count)
+		require.NoError(t, err)
+		require.DeepEqual(t, finalized, restoredFinalized)
+		if count > 0 {
+			_, err = restored.MerkleProof(count - 1)
+			require.ErrorContains(t, "is finalized", err)
+		}
+
+		// The following items are inserted and proven as in the trie of all the items.
+		for i := count; i < len(items); i++ {
+			full.Insert(items[i], i)
+			restored.Insert(items[i], i)
+			require.Equal(t, full.HashTreeRoot(), restored.HashTreeRoot(), "count %d, item %d", count, i)
+		}
+		restored = CreateTrieFromProto(restored.ToProto()).Copy()
+		require.Equal(t, len(items), restored.NumOfItems())
+		for i := count; i < len(items); i++ {
+			proof, err := restored.MerkleProof(i)
+			require.NoError(t, err)
+			root := restored.HashTreeRoot()
+			require.Equal(t, true, VerifyMerkleBranch(root[:], items[i], i, proof, depth), "count %d, item %d", count, i)
+		}
+		fullFinalized, err := full.FinalizedBranch(len(items))
+		require.NoError(t, err)
+		restoredFinalized, err = restored.FinalizedBranch(len(items))
+		require.NoError(t, err)
+		require.DeepEqual(t, fullFinalized, restoredFinalized)
+	}
+}
+
+func TestNewTrieFromFinalizedBranch_WrongBranch(t *testing.T) {
+	depth := params.BeaconConfig().DepositContractTreeDepth
+	_, err := NewTrieFromFinalizedBranch([][]byte{{'a'}}, 3, depth)
+	require.ErrorContains(t, "wanted 2 finalized roots", err)
+	_, err = NewTrieFromFinalizedBranch([][]byte{{'a'}}, 0, depth)
+	require.ErrorContains(t, "not empty", err)
+	trie, err := GenerateTrieFromItems([][]byte{{'a'}, {'b'}}, depth)
+	require.NoError(t, err)
+	_, err = trie.FinalizedBranch(3)
+	require.ErrorContains(t, "cannot get finalized branch", err)
+	finalized, err := trie.FinalizedBranch(2)
+	require.NoError(t, err)
+	restored, err := NewTrieFromFinalizedBranch(finalized, 2, depth)
+	require.NoError(t, err)
+	_, err = restored.FinalizedBranch(1)
+	require.ErrorContains(t, "cannot get finalized branch", err)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/shared/promptutil"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/remote"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
 	"github.com/urfave/cli/v2"
 )
 
@@ -141,6 +142,63 @@ func InputRemoteKeymanagerConfig(cliCtx *cli.Context) (*remote.KeymanagerOpts, e
 	return newCfg, nil
 }
 
+// InputWeb3SignerKeymanagerConfig via the cli.
+func InputWeb3SignerKeymanagerConfig(cliCtx *cli.Context) (*web3signer.KeymanagerOpts, error) {
+	baseURL := cliCtx.String(flags.Web3SignerURLFlag.Name)
+	genesisValidatorsRoot := cliCtx.String(flags.Web3SignerGenesisValidatorsRootFlag.Name)
+	crt := cliCtx.String(flags.RemoteSignerCertPathFlag.Name)
+	key := cliCtx.String(flags.RemoteSignerKeyPathFlag.Name)
+	ca := cliCtx.String(flags.RemoteSignerCACertPathFlag.Name)
+	log.Info("Input desired configuration")
+	var err error
+	if baseURL == "" {
+		baseURL, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Web3Signer URL (such as https://signer.example.com:9000)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if genesisValidatorsRoot == "" {
+		genesisValidatorsRoot, err = promptutil.ValidatePrompt(
+			os.Stdin,
+			"Genesis validators root of the network (hex encoded)",
+			promptutil.NotEmpty)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	newCfg := &web3signer.KeymanagerOpts{
+		BaseEndpoint:          strings.TrimSpace(baseURL),
+		GenesisValidatorsRoot: strings.TrimSpace(genesisValidatorsRoot),
+	}
+	if crt != "" || key != "" || ca != "" {
+		newCfg.RemoteCertificate = &web3signer.CertificateConfig{}
+		if crt != "" {
+			newCfg.RemoteCertificate.ClientCertPath, err = fileutil.ExpandPath(strings.TrimRight(crt, "\r\n"))
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not determine absolute path for %s", crt)
+			}
+		}
+		if key != "" {
+			newCfg.RemoteCertificate.ClientKeyPath, err = fileutil.ExpandPath(strings.TrimRight(key, "\r\n"))
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not determine absolute path for %s", key)
+			}
+		}
+		if ca != "" {
+			newCfg.RemoteCertificate.CACertPath, err = fileutil.ExpandPath(strings.TrimRight(ca, "\r\n"))
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not determine absolute path for %s", ca)
+			}
+		}
+	}
+	fmt.Printf("%s\n", newCfg)
+	return newCfg, nil
+}
+
 func validateCertPath(input string) error {
 	if input == "" {
 		return errors.New("crt path cannot be empty")
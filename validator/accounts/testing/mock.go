@@ -19,6 +19,7 @@ type Wallet struct {
 	AccountPasswords  map[string]string
 	WalletPassword    string
 	UnlockAccounts    bool
+	KeymanagerConfig  []byte
 	lock              sync.RWMutex
 }
 
@@ -59,6 +60,14 @@ func (w *Wallet) WriteFileAtPath(_ context.Context, pathName, fileName string, d
 	return nil
 }
 
+// WriteKeymanagerConfigToDisk --
+func (w *Wallet) WriteKeymanagerConfigToDisk(_ context.Context, encoded []byte) error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	w.KeymanagerConfig = encoded
+	return nil
+}
+
 // ReadFileAtPath --
 func (w *Wallet) ReadFileAtPath(_ context.Context, pathName, fileName string) ([]byte, error) {
 	w.lock.RLock()
@@ -133,6 +133,7 @@ func (v *validator) signSlotWithSelectionProof(ctx context.Context, pubKey [48]b
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
 		Object:          &validatorpb.SignRequest_Slot{Slot: slot},
+		SigningSlot:     slot,
 	})
 	if err != nil {
 		return nil, err
@@ -186,6 +187,7 @@ func (v *validator) aggregateAndProofSig(ctx context.Context, pubKey [48]byte, a
 		SigningRoot:     root[:],
 		SignatureDomain: d.SignatureDomain,
 		Object:          &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: agg},
+		SigningSlot:     agg.Aggregate.Data.Slot,
 	})
 	if err != nil {
 		return nil, err
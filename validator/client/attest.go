@@ -205,6 +205,7 @@ func (v *validator) signAtt(ctx context.Context, pubKey [48]byte, data *ethpb.At
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
 		Object:          &validatorpb.SignRequest_AttestationData{AttestationData: data},
+		SigningSlot:     data.Slot,
 	})
 	if err != nil {
 		return nil, [32]byte{}, err
@@ -28,6 +28,34 @@ func (v *validator) slashableAttestationCheck(
 	ctx, span := trace.StartSpan(ctx, "validator.postAttSignUpdate")
 	defer span.End()
 
+	// The attestation is checked and saved holding the lock of the public key, so that
+	// no other validator client sharing the database can sign a conflicting attestation.
+	if err := v.db.WithPublicKeyLock(ctx, pubKey, func(ctx context.Context) error {
+		return v.localSlashableAttestationCheck(ctx, indexedAtt, pubKey, signingRoot)
+	}); err != nil {
+		return err
+	}
+
+	if featureconfig.Get().SlasherProtection && v.protector != nil {
+		if !v.protector.CommitAttestation(ctx, indexedAtt) {
+			if v.emitAccountMetrics {
+				fmtKey := "0x" + hex.EncodeToString(pubKey[:])
+				ValidatorAttestFailVecSlasher.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.New(failedPostAttSignExternalErr)
+		}
+	}
+	return nil
+}
+
+// Checks the attestation against the attesting history of the public key in our DB,
+// and saves it if it is not slashable.
+func (v *validator) localSlashableAttestationCheck(
+	ctx context.Context,
+	indexedAtt *ethpb.IndexedAttestation,
+	pubKey [48]byte,
+	signingRoot [32]byte,
+) error {
 	// Based on EIP3076, validator should refuse to sign any attestation with source epoch less
 	// than the minimum source epoch present in that signer’s attestations.
 	lowestSourceEpoch, exists, err := v.db.LowestSignedSourceEpoch(ctx, pubKey)
@@ -80,14 +108,5 @@ func (v *validator) slashableAttestationCheck(
 	if err := v.db.SaveAttestationForPubKey(ctx, pubKey, signingRoot, indexedAtt); err != nil {
 		return errors.Wrap(err, "could not save attestation history for validator public key")
 	}
-
-	if featureconfig.Get().SlasherProtection && v.protector != nil {
-		if !v.protector.CommitAttestation(ctx, indexedAtt) {
-			if v.emitAccountMetrics {
-				ValidatorAttestFailVecSlasher.WithLabelValues(fmtKey).Inc()
-			}
-			return errors.New(failedPostAttSignExternalErr)
-		}
-	}
 	return nil
 }
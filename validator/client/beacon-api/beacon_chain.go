@@ -0,0 +1,173 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// StreamBlocks returns a stream of the blocks imported by the beacon node, from the block events
+// of the beacon node.
+func (c *Client) StreamBlocks(ctx context.Context, _ *ethpb.StreamBlocksRequest, _ ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error) {
+	req, err := c.newRequest(ctx, http.MethodGet, "/eth/v1/events", url.Values{"topics": {"block"}}, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "text/event-stream")
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	// The event stream has no timeout, so that it is closed with the context.
+	go func() {
+		<-ctx.Done()
+		closeBody(resp.Body)
+	}()
+	return newBlockStream(ctx, resp.Body), nil
+}
+
+// GetChainHead returns the head and the checkpoints of the chain.
+func (c *Client) GetChainHead(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	data, err := c.get(ctx, "/eth/v1/beacon/headers/head", nil)
+	if err != nil {
+		return nil, err
+	}
+	header := &ethpbv1.BlockHeaderContainer{}
+	if err := unmarshalJSON(data, header); err != nil {
+		return nil, errors.Wrap(err, "could not decode head block header")
+	}
+	if header.Header == nil || header.Header.Message == nil {
+		return nil, errors.New("head block header is empty")
+	}
+	data, err = c.get(ctx, "/eth/v1/beacon/states/head/finality_checkpoints", nil)
+	if err != nil {
+		return nil, err
+	}
+	checkpoints := &ethpbv1.StateFinalityCheckpointResponse_StateFinalityCheckpoint{}
+	if err := unmarshalJSON(data, checkpoints); err != nil {
+		return nil, errors.Wrap(err, "could not decode finality checkpoints")
+	}
+	if checkpoints.PreviousJustified == nil || checkpoints.CurrentJustified == nil || checkpoints.Finalized == nil {
+		return nil, errors.New("finality checkpoints are incomplete")
+	}
+
+	finalizedSlot, err := helpers.StartSlot(checkpoints.Finalized.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	justifiedSlot, err := helpers.StartSlot(checkpoints.CurrentJustified.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	prevJustifiedSlot, err := helpers.StartSlot(checkpoints.PreviousJustified.Epoch)
+	if err != nil {
+		return nil, err
+	}
+	headSlot := header.Header.Message.Slot
+	return &ethpb.ChainHead{
+		HeadSlot:                   headSlot,
+		HeadEpoch:                  helpers.SlotToEpoch(headSlot),
+		HeadBlockRoot:              header.Root,
+		FinalizedSlot:              finalizedSlot,
+		FinalizedEpoch:             checkpoints.Finalized.Epoch,
+		FinalizedBlockRoot:         checkpoints.Finalized.Root,
+		JustifiedSlot:              justifiedSlot,
+		JustifiedEpoch:             checkpoints.CurrentJustified.Epoch,
+		JustifiedBlockRoot:         checkpoints.CurrentJustified.Root,
+		PreviousJustifiedSlot:      prevJustifiedSlot,
+		PreviousJustifiedEpoch:     checkpoints.PreviousJustified.Epoch,
+		PreviousJustifiedBlockRoot: checkpoints.PreviousJustified.Root,
+	}, nil
+}
+
+// GetValidatorPerformance is not supported by the beacon node API.
+func (c *Client) GetValidatorPerformance(
+	_ context.Context, _ *ethpb.ValidatorPerformanceRequest, _ ...grpc.CallOption,
+) (*ethpb.ValidatorPerformanceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "validator performance is not supported by the beacon node REST API")
+}
+
+// GetSyncStatus returns whether the beacon node is syncing.
+func (c *Client) GetSyncStatus(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	data, err := c.get(ctx, "/eth/v1/node/syncing", nil)
+	if err != nil {
+		return nil, err
+	}
+	var syncing struct {
+		IsSyncing bool `json:"is_syncing"`
+	}
+	if err := json.Unmarshal(data, &syncing); err != nil {
+		return nil, errors.Wrap(err, "could not decode sync status")
+	}
+	return &ethpb.SyncStatus{Syncing: syncing.IsSyncing}, nil
+}
+
+// GetGenesis returns the genesis of the chain and the address of the deposit contract.
+func (c *Client) GetGenesis(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Genesis, error) {
+	genesisTime, genesisValidatorsRoot, err := c.genesis(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.get(ctx, "/eth/v1/config/deposit_contract", nil)
+	if err != nil {
+		return nil, err
+	}
+	var depositContract struct {
+		Address string `json:"address"`
+	}
+	if err := json.Unmarshal(data, &depositContract); err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit contract")
+	}
+	address, err := hexutil.Decode(depositContract.Address)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode deposit contract address")
+	}
+	return &ethpb.Genesis{
+		GenesisTime:            timestamppb.New(time.Unix(int64(genesisTime), 0)),
+		DepositContractAddress: address,
+		GenesisValidatorsRoot:  genesisValidatorsRoot,
+	}, nil
+}
+
+// ListPeers returns the peers the beacon node is connected to.
+func (c *Client) ListPeers(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Peers, error) {
+	data, err := c.get(ctx, "/eth/v1/node/peers", url.Values{"state": {"connected"}})
+	if err != nil {
+		return nil, err
+	}
+	var peers []struct {
+		PeerID             string `json:"peer_id"`
+		Enr                string `json:"enr"`
+		LastSeenP2PAddress string `json:"last_seen_p2p_address"`
+		State              string `json:"state"`
+		Direction          string `json:"direction"`
+	}
+	if err := json.Unmarshal(data, &peers); err != nil {
+		return nil, errors.Wrap(err, "could not decode peers")
+	}
+	resp := &ethpb.Peers{Peers: make([]*ethpb.Peer, len(peers))}
+	for i, p := range peers {
+		resp.Peers[i] = &ethpb.Peer{
+			Address:         p.LastSeenP2PAddress,
+			Direction:       ethpb.PeerDirection(ethpb.PeerDirection_value[strings.ToUpper(p.Direction)]),
+			ConnectionState: ethpb.ConnectionState(ethpb.ConnectionState_value[strings.ToUpper(p.State)]),
+			PeerId:          p.PeerID,
+			Enr:             p.Enr,
+		}
+	}
+	return resp, nil
+}
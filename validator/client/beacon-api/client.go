@@ -0,0 +1,358 @@
+// Package beaconapi implements the beacon node client of the validator client with the standard
+// beacon node REST API, so that the validator client can run against any beacon node
+// implementation.
+package beaconapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	_ = iface.ValidatorClient(&Client{})
+	_ = iface.BeaconChainClient(&Client{})
+	_ = iface.NodeClient(&Client{})
+)
+
+const (
+	// requestTimeout bounds the requests to the beacon node other than the event stream.
+	requestTimeout = 30 * time.Second
+	// maxValidatorIDs is the number of validators requested at once, which is the limit of the
+	// beacon node API.
+	maxValidatorIDs = 30
+)
+
+// nonExistentIndex is the index of validators which are not in the beacon state.
+var nonExistentIndex = types.ValidatorIndex(^uint64(0))
+
+// Client is a client of the standard beacon node REST API, which implements the beacon node
+// clients of the validator client. The errors of the beacon node are returned as gRPC status
+// errors with the code matching the HTTP status, as the validator client handles the errors of
+// both APIs the same way.
+type Client struct {
+	endpoint   string
+	httpClient *http.Client
+
+	lock                  sync.RWMutex
+	genesisTime           uint64
+	genesisValidatorsRoot []byte
+	forkSchedule          []*ethpbv1.Fork
+	// attesterDuties are the latest attester duties of each committee, in the order of the public
+	// keys of the duties request, from which the committee subnet subscriptions are made.
+	attesterDuties map[committeeKey][]*ethpbv1.AttesterDuty
+}
+
+type committeeKey struct {
+	slot           types.Slot
+	committeeIndex types.CommitteeIndex
+}
+
+// apiError is the error response of the beacon node API.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewClient creates a client of the beacon node REST API at the endpoint.
+func NewClient(endpoint string) *Client {
+	if !strings.Contains(endpoint, "://") {
+		endpoint = "http://" + endpoint
+	}
+	return &Client{
+		endpoint:       strings.TrimSuffix(endpoint, "/"),
+		httpClient:     &http.Client{},
+		attesterDuties: make(map[committeeKey][]*ethpbv1.AttesterDuty),
+	}
+}
+
+// get requests the path and returns the data of the response.
+func (c *Client) get(ctx context.Context, path string, query url.Values) (json.RawMessage, error) {
+	return c.do(ctx, http.MethodGet, path, query, nil)
+}
+
+// post sends the JSON encoding of the body to the path and returns the data of the response.
+func (c *Client) post(ctx context.Context, path string, body interface{}) (json.RawMessage, error) {
+	return c.do(ctx, http.MethodPost, path, nil, body)
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, body interface{}) (json.RawMessage, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+	req, err := c.newRequest(ctx, method, path, query, body)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := c.send(req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp.Body)
+	respBody, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not read response of %s: %v", path, err)
+	}
+	if len(respBody) == 0 {
+		return nil, nil
+	}
+	var container struct {
+		Data json.RawMessage `json:"data"`
+	}
+	if err := json.Unmarshal(respBody, &container); err != nil {
+		return nil, status.Errorf(codes.Internal, "could not decode response of %s: %v", path, err)
+	}
+	return container.Data, nil
+}
+
+func (c *Client) newRequest(ctx context.Context, method, path string, query url.Values, body interface{}) (*http.Request, error) {
+	var reqBody io.Reader
+	if body != nil {
+		enc, err := json.Marshal(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not encode request")
+		}
+		reqBody = bytes.NewReader(enc)
+	}
+	u := c.endpoint + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reqBody)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+// send sends the request, returning an error unless the beacon node responds with success.
+func (c *Client) send(req *http.Request) (*http.Response, error) {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "could not send request to %s: %v", req.URL.Path, err)
+	}
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return resp, nil
+	}
+	defer closeBody(resp.Body)
+	respErr := &apiError{Code: resp.StatusCode, Message: resp.Status}
+	if respBody, err := ioutil.ReadAll(resp.Body); err == nil {
+		if err := json.Unmarshal(respBody, respErr); err != nil {
+			respErr.Message = string(respBody)
+		}
+	}
+	return nil, status.Errorf(
+		httpStatusToCode(resp.StatusCode), "%s %s: %s", req.Method, req.URL.Path, respErr.Message,
+	)
+}
+
+func closeBody(body io.Closer) {
+	if err := body.Close(); err != nil {
+		log.WithError(err).Debug("Could not close response body")
+	}
+}
+
+func httpStatusToCode(httpStatus int) codes.Code {
+	switch httpStatus {
+	case http.StatusBadRequest:
+		return codes.InvalidArgument
+	case http.StatusNotFound:
+		return codes.NotFound
+	case http.StatusServiceUnavailable:
+		return codes.Unavailable
+	case http.StatusNotImplemented:
+		return codes.Unimplemented
+	default:
+		return codes.Internal
+	}
+}
+
+// genesis returns the genesis time and genesis validators root of the chain.
+func (c *Client) genesis(ctx context.Context) (uint64, []byte, error) {
+	c.lock.RLock()
+	genesisTime, root := c.genesisTime, c.genesisValidatorsRoot
+	c.lock.RUnlock()
+	if root != nil {
+		return genesisTime, root, nil
+	}
+
+	data, err := c.get(ctx, "/eth/v1/beacon/genesis", nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	var genesis struct {
+		GenesisTime           string `json:"genesis_time"`
+		GenesisValidatorsRoot string `json:"genesis_validators_root"`
+	}
+	if err := json.Unmarshal(data, &genesis); err != nil {
+		return 0, nil, errors.Wrap(err, "could not decode genesis")
+	}
+	genesisTime, err = parseUint(genesis.GenesisTime)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not decode genesis time")
+	}
+	root, err = hexutil.Decode(genesis.GenesisValidatorsRoot)
+	if err != nil {
+		return 0, nil, errors.Wrap(err, "could not decode genesis validators root")
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.genesisTime, c.genesisValidatorsRoot = genesisTime, root
+	return genesisTime, root, nil
+}
+
+// fork returns the fork of the epoch from the fork schedule of the beacon node.
+func (c *Client) fork(ctx context.Context, epoch types.Epoch) (*ethpb.Fork, error) {
+	c.lock.RLock()
+	schedule := c.forkSchedule
+	c.lock.RUnlock()
+	if schedule == nil {
+		data, err := c.get(ctx, "/eth/v1/config/fork_schedule", nil)
+		if err != nil {
+			return nil, err
+		}
+		if err := unmarshalList(data, func(item []byte) error {
+			f := &ethpbv1.Fork{}
+			schedule = append(schedule, f)
+			return unmarshalJSON(item, f)
+		}); err != nil {
+			return nil, errors.Wrap(err, "could not decode fork schedule")
+		}
+		c.lock.Lock()
+		c.forkSchedule = schedule
+		c.lock.Unlock()
+	}
+
+	var fork *ethpbv1.Fork
+	for _, f := range schedule {
+		if f.Epoch <= epoch && (fork == nil || f.Epoch >= fork.Epoch) {
+			fork = f
+		}
+	}
+	if fork == nil {
+		return nil, fmt.Errorf("no fork scheduled at epoch %d", epoch)
+	}
+	return &ethpb.Fork{
+		PreviousVersion: fork.PreviousVersion,
+		CurrentVersion:  fork.CurrentVersion,
+		Epoch:           fork.Epoch,
+	}, nil
+}
+
+// validators returns the validators with the given ids, which are public keys or indices, from
+// the head state. Validators which are not in the state are omitted.
+func (c *Client) validators(ctx context.Context, ids []string) ([]*ethpbv1.ValidatorContainer, error) {
+	vals := make([]*ethpbv1.ValidatorContainer, 0, len(ids))
+	for start := 0; start < len(ids); start += maxValidatorIDs {
+		end := start + maxValidatorIDs
+		if end > len(ids) {
+			end = len(ids)
+		}
+		data, err := c.get(ctx, "/eth/v1/beacon/states/head/validators", url.Values{"id": {strings.Join(ids[start:end], ",")}})
+		if err != nil {
+			return nil, err
+		}
+		if err := unmarshalList(data, func(item []byte) error {
+			val := &ethpbv1.ValidatorContainer{}
+			vals = append(vals, val)
+			return unmarshalJSON(item, val)
+		}); err != nil {
+			return nil, errors.Wrap(err, "could not decode validators")
+		}
+	}
+	return vals, nil
+}
+
+// validatorsByPubKey returns the validators of the public keys which are in the head state.
+func (c *Client) validatorsByPubKey(ctx context.Context, pubKeys [][]byte) (map[[48]byte]*ethpbv1.ValidatorContainer, error) {
+	ids := make([]string, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		ids[i] = hexutil.Encode(pubKey)
+	}
+	vals, err := c.validators(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byPubKey := make(map[[48]byte]*ethpbv1.ValidatorContainer, len(vals))
+	for _, val := range vals {
+		if val.Validator == nil {
+			continue
+		}
+		byPubKey[bytesutil.ToBytes48(val.Validator.Pubkey)] = val
+	}
+	return byPubKey, nil
+}
+
+// validatorIndex returns the index of the validator with the public key.
+func (c *Client) validatorIndex(ctx context.Context, pubKey []byte) (types.ValidatorIndex, error) {
+	data, err := c.get(ctx, "/eth/v1/beacon/states/head/validators/"+hexutil.Encode(pubKey), nil)
+	if err != nil {
+		return 0, err
+	}
+	val := &ethpbv1.ValidatorContainer{}
+	if err := unmarshalJSON(data, val); err != nil {
+		return 0, errors.Wrap(err, "could not decode validator")
+	}
+	return val.Index, nil
+}
+
+// validatorStatus converts the status of the beacon node API to the status of the validator client.
+func validatorStatus(s ethpbv1.ValidatorStatus) ethpb.ValidatorStatus {
+	switch s {
+	case ethpbv1.ValidatorStatus_PENDING_INITIALIZED:
+		return ethpb.ValidatorStatus_DEPOSITED
+	case ethpbv1.ValidatorStatus_PENDING_QUEUED:
+		return ethpb.ValidatorStatus_PENDING
+	case ethpbv1.ValidatorStatus_ACTIVE_ONGOING:
+		return ethpb.ValidatorStatus_ACTIVE
+	case ethpbv1.ValidatorStatus_ACTIVE_EXITING:
+		return ethpb.ValidatorStatus_EXITING
+	case ethpbv1.ValidatorStatus_ACTIVE_SLASHED:
+		return ethpb.ValidatorStatus_SLASHING
+	case ethpbv1.ValidatorStatus_EXITED_UNSLASHED, ethpbv1.ValidatorStatus_EXITED_SLASHED,
+		ethpbv1.ValidatorStatus_WITHDRAWAL_POSSIBLE, ethpbv1.ValidatorStatus_WITHDRAWAL_DONE:
+		return ethpb.ValidatorStatus_EXITED
+	default:
+		return ethpb.ValidatorStatus_UNKNOWN_STATUS
+	}
+}
+
+// unmarshalList decodes the JSON list, calling decode with each of its items.
+func unmarshalList(data []byte, decode func(item []byte) error) error {
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := decode(item); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func parseUint(s string) (uint64, error) {
+	return strconv.ParseUint(s, 10, 64)
+}
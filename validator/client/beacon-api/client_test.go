@@ -0,0 +1,323 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	pubKey1 = bytesutil.PadTo([]byte{0x01}, 48)
+	pubKey2 = bytesutil.PadTo([]byte{0x02}, 48)
+)
+
+func writeData(t *testing.T, w http.ResponseWriter, data string) {
+	_, err := fmt.Fprintf(w, `{"data":%s}`, data)
+	require.NoError(t, err)
+}
+
+func TestNewClient_Endpoint(t *testing.T) {
+	assert.Equal(t, "http://localhost:3500", NewClient("localhost:3500/").endpoint)
+	assert.Equal(t, "https://localhost:3500", NewClient("https://localhost:3500").endpoint)
+}
+
+func TestClient_ErrorCodes(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/node/syncing", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		_, err := w.Write([]byte(`{"code":503,"message":"Beacon node is currently syncing"}`))
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewClient(srv.URL)
+
+	_, err := c.GetSyncStatus(context.Background(), &emptypb.Empty{})
+	assert.Equal(t, codes.Unavailable, status.Code(err))
+	assert.ErrorContains(t, "Beacon node is currently syncing", err)
+
+	_, err = c.GetChainHead(context.Background(), &emptypb.Empty{})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestClient_GetDutiesAndSubscribeCommitteeSubnets(t *testing.T) {
+	var subscriptions []*committeeSubscription
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/states/head/validators", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, hexutil.Encode(pubKey1)+","+hexutil.Encode(pubKey2), r.URL.Query().Get("id"))
+		writeData(t, w, fmt.Sprintf(
+			`[{"index":"5","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"%s","activation_epoch":"0"}}]`,
+			hexutil.Encode(pubKey1),
+		))
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/attester/", func(w http.ResponseWriter, r *http.Request) {
+		var indices []string
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&indices))
+		assert.DeepEqual(t, []string{"5"}, indices)
+		epoch := strings.TrimPrefix(r.URL.Path, "/eth/v1/validator/duties/attester/")
+		slot := "33"
+		if epoch == "2" {
+			slot = "65"
+		}
+		writeData(t, w, fmt.Sprintf(
+			`[{"pubkey":"%s","validator_index":"5","committee_index":"1","committee_length":"2",`+
+				`"committees_at_slot":"4","validator_committee_index":"0","slot":"%s"}]`,
+			hexutil.Encode(pubKey1), slot,
+		))
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/committees", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, `[{"index":"1","slot":"33","validators":["5","9"]},{"index":"1","slot":"65","validators":["9","5"]}]`)
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/proposer/1", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, fmt.Sprintf(
+			`[{"pubkey":"%s","validator_index":"5","slot":"40"},{"pubkey":"0x03","validator_index":"9","slot":"41"}]`,
+			hexutil.Encode(pubKey1),
+		))
+	})
+	mux.HandleFunc("/eth/v1/validator/beacon_committee_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&subscriptions))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewClient(srv.URL)
+
+	resp, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{pubKey1, pubKey2}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.CurrentEpochDuties))
+	duty := resp.CurrentEpochDuties[0]
+	assert.Equal(t, ethpb.ValidatorStatus_ACTIVE, duty.Status)
+	assert.Equal(t, uint64(5), uint64(duty.ValidatorIndex))
+	assert.Equal(t, uint64(33), uint64(duty.AttesterSlot))
+	assert.Equal(t, uint64(1), uint64(duty.CommitteeIndex))
+	assert.Equal(t, 2, len(duty.Committee))
+	require.Equal(t, 1, len(duty.ProposerSlots))
+	assert.Equal(t, uint64(40), uint64(duty.ProposerSlots[0]))
+	assert.Equal(t, ethpb.ValidatorStatus_UNKNOWN_STATUS, resp.CurrentEpochDuties[1].Status)
+	require.Equal(t, 2, len(resp.NextEpochDuties))
+	assert.Equal(t, uint64(65), uint64(resp.NextEpochDuties[0].AttesterSlot))
+	assert.Equal(t, 0, len(resp.NextEpochDuties[0].ProposerSlots))
+
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{33, 65},
+		CommitteeIds: []types.CommitteeIndex{1, 1},
+		IsAggregator: []bool{true, false},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(subscriptions))
+	assert.DeepEqual(t, &committeeSubscription{
+		ValidatorIndex:   "5",
+		CommitteeIndex:   "1",
+		CommitteesAtSlot: "4",
+		Slot:             "33",
+		IsAggregator:     true,
+	}, subscriptions[0])
+	assert.Equal(t, "65", subscriptions[1].Slot)
+
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{34},
+		CommitteeIds: []types.CommitteeIndex{1},
+		IsAggregator: []bool{false},
+	})
+	assert.ErrorContains(t, "no attester duty left for committee 1 at slot 34", err)
+}
+
+func TestClient_SubscribeCommitteeSubnets_ValidatorsInSameCommittee(t *testing.T) {
+	var subscriptions []*committeeSubscription
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/states/head/validators", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, fmt.Sprintf(
+			`[{"index":"9","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"%s","activation_epoch":"0"}},`+
+				`{"index":"5","balance":"32000000000","status":"active_ongoing","validator":{"pubkey":"%s","activation_epoch":"0"}}]`,
+			hexutil.Encode(pubKey2), hexutil.Encode(pubKey1),
+		))
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/attester/", func(w http.ResponseWriter, r *http.Request) {
+		epoch := strings.TrimPrefix(r.URL.Path, "/eth/v1/validator/duties/attester/")
+		slot := "33"
+		if epoch == "2" {
+			slot = "65"
+		}
+		// The duties are not in the order of the public keys of the request.
+		writeData(t, w, fmt.Sprintf(
+			`[{"pubkey":"%s","validator_index":"9","committee_index":"1","committee_length":"2",`+
+				`"committees_at_slot":"4","validator_committee_index":"1","slot":"%s"},`+
+				`{"pubkey":"%s","validator_index":"5","committee_index":"1","committee_length":"2",`+
+				`"committees_at_slot":"4","validator_committee_index":"0","slot":"%s"}]`,
+			hexutil.Encode(pubKey2), slot, hexutil.Encode(pubKey1), slot,
+		))
+	})
+	mux.HandleFunc("/eth/v1/beacon/states/head/committees", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, `[{"index":"1","slot":"33","validators":["5","9"]},{"index":"1","slot":"65","validators":["5","9"]}]`)
+	})
+	mux.HandleFunc("/eth/v1/validator/duties/proposer/1", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, `[]`)
+	})
+	mux.HandleFunc("/eth/v1/validator/beacon_committee_subscriptions", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&subscriptions))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewClient(srv.URL)
+
+	resp, err := c.GetDuties(context.Background(), &ethpb.DutiesRequest{Epoch: 1, PublicKeys: [][]byte{pubKey1, pubKey2}})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resp.CurrentEpochDuties))
+	assert.Equal(t, uint64(5), uint64(resp.CurrentEpochDuties[0].ValidatorIndex))
+	assert.Equal(t, uint64(9), uint64(resp.CurrentEpochDuties[1].ValidatorIndex))
+
+	// The validator client requests a subscription for each validator, in the order of the public keys.
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{33, 33},
+		CommitteeIds: []types.CommitteeIndex{1, 1},
+		IsAggregator: []bool{false, true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(subscriptions))
+	assert.Equal(t, "5", subscriptions[0].ValidatorIndex)
+	assert.Equal(t, false, subscriptions[0].IsAggregator)
+	assert.Equal(t, "9", subscriptions[1].ValidatorIndex)
+	assert.Equal(t, true, subscriptions[1].IsAggregator)
+
+	_, err = c.SubscribeCommitteeSubnets(context.Background(), &ethpb.CommitteeSubnetsSubscribeRequest{
+		Slots:        []types.Slot{33, 33, 33},
+		CommitteeIds: []types.CommitteeIndex{1, 1, 1},
+		IsAggregator: []bool{false, false, false},
+	})
+	assert.ErrorContains(t, "no attester duty left for committee 1 at slot 33", err)
+}
+
+func TestClient_DomainData(t *testing.T) {
+	genesisValidatorsRoot := bytesutil.PadTo([]byte{0xaa}, 32)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, fmt.Sprintf(
+			`{"genesis_time":"1606824023","genesis_validators_root":"%s","genesis_fork_version":"0x00000000"}`,
+			hexutil.Encode(genesisValidatorsRoot),
+		))
+	})
+	mux.HandleFunc("/eth/v1/config/fork_schedule", func(w http.ResponseWriter, r *http.Request) {
+		writeData(t, w, `[{"previous_version":"0x00000000","current_version":"0x00000000","epoch":"0"},`+
+			`{"previous_version":"0x00000000","current_version":"0x01000000","epoch":"10"}]`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+	c := NewClient(srv.URL)
+
+	domainType := params.BeaconConfig().DomainBeaconAttester[:]
+	for _, epoch := range []uint64{5, 12} {
+		resp, err := c.DomainData(context.Background(), &ethpb.DomainRequest{Epoch: types.Epoch(epoch), Domain: domainType})
+		require.NoError(t, err)
+		version := []byte{0, 0, 0, 0}
+		if epoch >= 10 {
+			version = []byte{1, 0, 0, 0}
+		}
+		fork := &ethpb.Fork{PreviousVersion: []byte{0, 0, 0, 0}, CurrentVersion: version, Epoch: 0}
+		if epoch >= 10 {
+			fork.Epoch = 10
+		}
+		want, err := helpers.Domain(fork, types.Epoch(epoch), params.BeaconConfig().DomainBeaconAttester, genesisValidatorsRoot)
+		require.NoError(t, err)
+		assert.DeepEqual(t, want, resp.SignatureDomain)
+	}
+}
+
+func TestClient_WaitForChainStart(t *testing.T) {
+	defer func(interval time.Duration) { chainStartPollInterval = interval }(chainStartPollInterval)
+	chainStartPollInterval = 10 * time.Millisecond
+
+	requests := 0
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/genesis", func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if requests < 3 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		writeData(t, w, `{"genesis_time":"100","genesis_validators_root":"0x0102","genesis_fork_version":"0x00000000"}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	stream, err := NewClient(srv.URL).WaitForChainStart(context.Background(), &emptypb.Empty{})
+	require.NoError(t, err)
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, 3, requests)
+	assert.Equal(t, true, resp.Started)
+	assert.Equal(t, uint64(100), resp.GenesisTime)
+	assert.DeepEqual(t, []byte{0x01, 0x02}, resp.GenesisValidatorsRoot)
+}
+
+func TestClient_StreamBlocks(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/events", func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "block", r.URL.Query().Get("topics"))
+		assert.Equal(t, "text/event-stream", r.Header.Get("Accept"))
+		_, err := w.Write([]byte("event: head\ndata: {\"slot\":\"9\"}\n\n" +
+			"event: block\ndata: {\"slot\":\"10\",\"block\":\"0x01\"}\n\n" +
+			"event: block\ndata: {\"slot\":\"11\",\"block\":\"0x02\"}\n\n"))
+		require.NoError(t, err)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	stream, err := NewClient(srv.URL).StreamBlocks(context.Background(), &ethpb.StreamBlocksRequest{})
+	require.NoError(t, err)
+	for _, slot := range []uint64{10, 11} {
+		blk, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, slot, uint64(blk.Block.Slot))
+	}
+	_, err = stream.Recv()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestClient_ProposeAttestation(t *testing.T) {
+	var body []map[string]interface{}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/eth/v1/beacon/pool/attestations", func(w http.ResponseWriter, r *http.Request) {
+		enc, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.NoError(t, json.Unmarshal(enc, &body))
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	att := &ethpb.Attestation{
+		AggregationBits: []byte{0x03},
+		Data: &ethpb.AttestationData{
+			Slot:            3,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		},
+		Signature: make([]byte, 96),
+	}
+	resp, err := NewClient(srv.URL).ProposeAttestation(context.Background(), att)
+	require.NoError(t, err)
+	root, err := att.Data.HashTreeRoot()
+	require.NoError(t, err)
+	assert.DeepEqual(t, root[:], resp.AttestationDataRoot)
+	require.Equal(t, 1, len(body))
+	assert.Equal(t, "0x03", body[0]["aggregation_bits"])
+	assert.Equal(t, "3", body[0]["data"].(map[string]interface{})["slot"])
+}
@@ -0,0 +1,180 @@
+package beaconapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// The beacon node API encodes integers as decimal strings, byte arrays as 0x-prefixed hex strings
+// and enums as their lowercase names. The protobuf messages of the eth API use the field names of
+// the beacon node API, so that they are converted to and from JSON by reflection.
+
+// marshalJSON returns the JSON value of the message in the encoding of the beacon node API.
+func marshalJSON(m proto.Message) map[string]interface{} {
+	return messageValue(m.ProtoReflect())
+}
+
+func messageValue(m protoreflect.Message) map[string]interface{} {
+	fields := m.Descriptor().Fields()
+	value := make(map[string]interface{}, fields.Len())
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if fd.IsList() {
+			list := m.Get(fd).List()
+			items := make([]interface{}, list.Len())
+			for j := 0; j < list.Len(); j++ {
+				items[j] = fieldValue(fd, list.Get(j))
+			}
+			value[string(fd.Name())] = items
+			continue
+		}
+		value[string(fd.Name())] = fieldValue(fd, m.Get(fd))
+	}
+	return value
+}
+
+func fieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) interface{} {
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		return messageValue(v.Message())
+	case protoreflect.BytesKind:
+		return hexutil.Encode(v.Bytes())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return strings.ToLower(string(ev.Name()))
+		}
+		return strconv.FormatInt(int64(v.Enum()), 10)
+	case protoreflect.Uint32Kind, protoreflect.Uint64Kind, protoreflect.Fixed32Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.Int32Kind, protoreflect.Int64Kind, protoreflect.Sint32Kind, protoreflect.Sint64Kind,
+		protoreflect.Sfixed32Kind, protoreflect.Sfixed64Kind:
+		return strconv.FormatInt(v.Int(), 10)
+	default:
+		return v.Interface()
+	}
+}
+
+// unmarshalJSON decodes the JSON value in the encoding of the beacon node API into the message.
+// Fields which are not part of the message are ignored.
+func unmarshalJSON(data []byte, m proto.Message) error {
+	return setMessage(data, m.ProtoReflect())
+}
+
+func setMessage(data []byte, m protoreflect.Message) error {
+	var value map[string]json.RawMessage
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	fields := m.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		raw, ok := value[string(fd.Name())]
+		if !ok || string(raw) == "null" {
+			continue
+		}
+		if err := setField(m, fd, raw); err != nil {
+			return errors.Wrapf(err, "could not decode field %s", fd.Name())
+		}
+	}
+	return nil
+}
+
+func setField(m protoreflect.Message, fd protoreflect.FieldDescriptor, raw json.RawMessage) error {
+	if fd.IsList() {
+		var items []json.RawMessage
+		if err := json.Unmarshal(raw, &items); err != nil {
+			return err
+		}
+		list := m.Mutable(fd).List()
+		for _, item := range items {
+			if fd.Kind() == protoreflect.MessageKind {
+				elem := list.NewElement()
+				if err := setMessage(item, elem.Message()); err != nil {
+					return err
+				}
+				list.Append(elem)
+				continue
+			}
+			v, err := scalarValue(fd, item)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+	if fd.Kind() == protoreflect.MessageKind {
+		return setMessage(raw, m.Mutable(fd).Message())
+	}
+	v, err := scalarValue(fd, raw)
+	if err != nil {
+		return err
+	}
+	m.Set(fd, v)
+	return nil
+}
+
+func scalarValue(fd protoreflect.FieldDescriptor, raw json.RawMessage) (protoreflect.Value, error) {
+	if fd.Kind() == protoreflect.BoolKind {
+		var b bool
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBool(b), nil
+	}
+	// Integers are accepted both as strings and as numbers.
+	s := strings.Trim(string(raw), `"`)
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		var str string
+		if err := json.Unmarshal(raw, &str); err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfString(str), nil
+	case protoreflect.BytesKind:
+		b, err := hexutil.Decode(s)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBytes(b), nil
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByName(protoreflect.Name(strings.ToUpper(s)))
+		if ev == nil {
+			return protoreflect.Value{}, fmt.Errorf("unknown value %q of %s", s, fd.Enum().Name())
+		}
+		return protoreflect.ValueOfEnum(ev.Number()), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unsupported field kind %s", fd.Kind())
+	}
+}
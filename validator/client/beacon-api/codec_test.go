@@ -0,0 +1,59 @@
+package beaconapi
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestMarshalJSON_Encoding(t *testing.T) {
+	data := &ethpbv1.AttestationData{
+		Slot:            12,
+		Index:           3,
+		BeaconBlockRoot: []byte{0xab, 0xcd},
+		Source:          &ethpbv1.Checkpoint{Epoch: 1, Root: []byte{0x01}},
+		Target:          &ethpbv1.Checkpoint{Epoch: 2, Root: []byte{0x02}},
+	}
+	enc, err := json.Marshal(marshalJSON(data))
+	require.NoError(t, err)
+	want := `{"beacon_block_root":"0xabcd","index":"3","slot":"12",` +
+		`"source":{"epoch":"1","root":"0x01"},"target":{"epoch":"2","root":"0x02"}}`
+	assert.Equal(t, want, string(enc))
+}
+
+func TestUnmarshalJSON_RoundTrip(t *testing.T) {
+	val := &ethpbv1.ValidatorContainer{
+		Index:   7,
+		Balance: 32000000000,
+		Status:  ethpbv1.ValidatorStatus_ACTIVE_ONGOING,
+		Validator: &ethpbv1.Validator{
+			Pubkey:          bytesutil.PadTo([]byte{0x01}, 48),
+			ActivationEpoch: 5,
+			ExitEpoch:       params.BeaconConfig().FarFutureEpoch,
+		},
+	}
+	enc, err := json.Marshal(marshalJSON(val))
+	require.NoError(t, err)
+	assert.Equal(t, true, strings.Contains(string(enc), `"status":"active_ongoing"`))
+
+	decoded := &ethpbv1.ValidatorContainer{}
+	require.NoError(t, unmarshalJSON(enc, decoded))
+	assert.Equal(t, true, proto.Equal(val, decoded), "Decoded %v, want %v", decoded, val)
+}
+
+func TestUnmarshalJSON_NumbersAndUnknownFields(t *testing.T) {
+	cp := &ethpbv1.Checkpoint{}
+	require.NoError(t, unmarshalJSON([]byte(`{"epoch":4,"root":"0x0a","unknown":"1"}`), cp))
+	assert.Equal(t, uint64(4), uint64(cp.Epoch))
+	assert.DeepEqual(t, []byte{0x0a}, cp.Root)
+
+	err := unmarshalJSON([]byte(`{"epoch":"four"}`), cp)
+	assert.ErrorContains(t, "could not decode field epoch", err)
+}
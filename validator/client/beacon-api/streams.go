@@ -0,0 +1,133 @@
+package beaconapi
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// chainStartPollInterval is the interval at which the beacon node is polled for the genesis of
+// the chain.
+var chainStartPollInterval = 10 * time.Second
+
+// blockStream receives the block events of the beacon node. The events only carry the slot and
+// the root of the block, so the received blocks only have their slot set.
+type blockStream struct {
+	grpc.ClientStream
+	ctx     context.Context
+	scanner *bufio.Scanner
+}
+
+func newBlockStream(ctx context.Context, body io.Reader) *blockStream {
+	return &blockStream{ctx: ctx, scanner: bufio.NewScanner(body)}
+}
+
+// Recv returns the next block of the event stream.
+func (s *blockStream) Recv() (*ethpb.SignedBeaconBlock, error) {
+	var event string
+	for s.scanner.Scan() {
+		line := s.scanner.Text()
+		switch {
+		case strings.HasPrefix(line, "event:"):
+			event = strings.TrimSpace(strings.TrimPrefix(line, "event:"))
+		case strings.HasPrefix(line, "data:") && event == "block":
+			var block struct {
+				Slot string `json:"slot"`
+			}
+			if err := json.Unmarshal([]byte(strings.TrimSpace(strings.TrimPrefix(line, "data:"))), &block); err != nil {
+				return nil, errors.Wrap(err, "could not decode block event")
+			}
+			slot, err := parseUint(block.Slot)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not decode block event slot")
+			}
+			return &ethpb.SignedBeaconBlock{Block: &ethpb.BeaconBlock{Slot: types.Slot(slot)}}, nil
+		case line == "":
+			event = ""
+		}
+	}
+	if s.ctx.Err() != nil {
+		return nil, s.ctx.Err()
+	}
+	if err := s.scanner.Err(); err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return nil, io.EOF
+}
+
+// chainStartStream polls the beacon node until it knows the genesis of the chain.
+type chainStartStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	client *Client
+}
+
+// Recv returns the genesis of the chain once the chain has started.
+func (s *chainStartStream) Recv() (*ethpb.ChainStartResponse, error) {
+	for {
+		genesisTime, genesisValidatorsRoot, err := s.client.genesis(s.ctx)
+		if err == nil {
+			return &ethpb.ChainStartResponse{
+				Started:               true,
+				GenesisTime:           genesisTime,
+				GenesisValidatorsRoot: genesisValidatorsRoot,
+			}, nil
+		}
+		// The beacon node returns 404 until the chain has started.
+		if status.Code(err) != codes.NotFound {
+			return nil, err
+		}
+		select {
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		case <-time.After(chainStartPollInterval):
+		}
+	}
+}
+
+// activationStream polls the statuses of the validators every slot.
+type activationStream struct {
+	grpc.ClientStream
+	ctx      context.Context
+	client   *Client
+	pubKeys  [][]byte
+	received bool
+}
+
+// Recv returns the statuses of the validators, immediately on the first call and one slot after
+// the previous call on the next ones.
+func (s *activationStream) Recv() (*ethpb.ValidatorActivationResponse, error) {
+	if s.received {
+		select {
+		case <-s.ctx.Done():
+			return nil, s.ctx.Err()
+		case <-time.After(time.Duration(params.BeaconConfig().SecondsPerSlot) * time.Second):
+		}
+	}
+	s.received = true
+
+	resp, err := s.client.MultipleValidatorStatus(s.ctx, &ethpb.MultipleValidatorStatusRequest{PublicKeys: s.pubKeys})
+	if err != nil {
+		return nil, err
+	}
+	statuses := make([]*ethpb.ValidatorActivationResponse_Status, len(resp.PublicKeys))
+	for i, pubKey := range resp.PublicKeys {
+		statuses[i] = &ethpb.ValidatorActivationResponse_Status{
+			PublicKey: pubKey,
+			Status:    resp.Statuses[i],
+			Index:     resp.Indices[i],
+		}
+	}
+	return &ethpb.ValidatorActivationResponse{Statuses: statuses}, nil
+}
@@ -0,0 +1,570 @@
+package beaconapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpbv1 "github.com/prysmaticlabs/prysm/proto/eth/v1"
+	ethpbv2 "github.com/prysmaticlabs/prysm/proto/eth/v2"
+	"github.com/prysmaticlabs/prysm/proto/migration"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// committeeSubscription is a subscription to the subnet of a beacon committee.
+type committeeSubscription struct {
+	ValidatorIndex   string `json:"validator_index"`
+	CommitteeIndex   string `json:"committee_index"`
+	CommitteesAtSlot string `json:"committees_at_slot"`
+	Slot             string `json:"slot"`
+	IsAggregator     bool   `json:"is_aggregator"`
+}
+
+// GetDuties returns the duties of the validators at the requested epoch and at the next epoch.
+func (c *Client) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, _ ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	vals, err := c.validatorsByPubKey(ctx, in.PublicKeys)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validators")
+	}
+	attesterDuties := make(map[committeeKey][]*ethpbv1.AttesterDuty)
+	currentDuties, err := c.duties(ctx, in.Epoch, in.PublicKeys, vals, true /* withProposers */, attesterDuties)
+	if err != nil {
+		return nil, err
+	}
+	// The proposers of the next epoch are not known yet.
+	nextDuties, err := c.duties(ctx, in.Epoch+1, in.PublicKeys, vals, false /* withProposers */, attesterDuties)
+	if err != nil {
+		return nil, err
+	}
+
+	c.lock.Lock()
+	c.attesterDuties = attesterDuties
+	c.lock.Unlock()
+
+	return &ethpb.DutiesResponse{
+		Duties:             currentDuties,
+		CurrentEpochDuties: currentDuties,
+		NextEpochDuties:    nextDuties,
+	}, nil
+}
+
+// duties returns the duties of the validators at the epoch, and adds their attester duties to
+// the attester duties of their committees in the order of the public keys.
+func (c *Client) duties(
+	ctx context.Context,
+	epoch types.Epoch,
+	pubKeys [][]byte,
+	vals map[[48]byte]*ethpbv1.ValidatorContainer,
+	withProposers bool,
+	dutiesByCommittee map[committeeKey][]*ethpbv1.AttesterDuty,
+) ([]*ethpb.DutiesResponse_Duty, error) {
+	indices := make([]string, 0, len(vals))
+	for _, val := range vals {
+		indices = append(indices, strconv.FormatUint(uint64(val.Index), 10))
+	}
+
+	attesterDuties := make(map[types.ValidatorIndex]*ethpbv1.AttesterDuty)
+	committees := make(map[committeeKey][]types.ValidatorIndex)
+	proposerSlots := make(map[types.ValidatorIndex][]types.Slot)
+	if len(indices) > 0 {
+		data, err := c.post(ctx, fmt.Sprintf("/eth/v1/validator/duties/attester/%d", epoch), indices)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get attester duties of epoch %d", epoch)
+		}
+		if err := unmarshalList(data, func(item []byte) error {
+			duty := &ethpbv1.AttesterDuty{}
+			if err := unmarshalJSON(item, duty); err != nil {
+				return err
+			}
+			attesterDuties[duty.ValidatorIndex] = duty
+			return nil
+		}); err != nil {
+			return nil, errors.Wrap(err, "could not decode attester duties")
+		}
+		if len(attesterDuties) > 0 {
+			committees, err = c.committees(ctx, epoch)
+			if err != nil {
+				return nil, errors.Wrapf(err, "could not get committees of epoch %d", epoch)
+			}
+		}
+	}
+	if withProposers && len(indices) > 0 {
+		data, err := c.get(ctx, fmt.Sprintf("/eth/v1/validator/duties/proposer/%d", epoch), nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not get proposer duties of epoch %d", epoch)
+		}
+		if err := unmarshalList(data, func(item []byte) error {
+			duty := &ethpbv1.ProposerDuty{}
+			if err := unmarshalJSON(item, duty); err != nil {
+				return err
+			}
+			proposerSlots[duty.ValidatorIndex] = append(proposerSlots[duty.ValidatorIndex], duty.Slot)
+			return nil
+		}); err != nil {
+			return nil, errors.Wrap(err, "could not decode proposer duties")
+		}
+	}
+
+	duties := make([]*ethpb.DutiesResponse_Duty, len(pubKeys))
+	for i, pubKey := range pubKeys {
+		duty := &ethpb.DutiesResponse_Duty{
+			PublicKey: pubKey,
+			Status:    ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		}
+		if val, ok := vals[bytesutil.ToBytes48(pubKey)]; ok {
+			duty.ValidatorIndex = val.Index
+			duty.Status = validatorStatus(val.Status)
+			duty.ProposerSlots = proposerSlots[val.Index]
+			if attesterDuty, ok := attesterDuties[val.Index]; ok {
+				key := committeeKey{slot: attesterDuty.Slot, committeeIndex: attesterDuty.CommitteeIndex}
+				duty.AttesterSlot = attesterDuty.Slot
+				duty.CommitteeIndex = attesterDuty.CommitteeIndex
+				duty.Committee = committees[key]
+				dutiesByCommittee[key] = append(dutiesByCommittee[key], attesterDuty)
+			}
+		}
+		duties[i] = duty
+	}
+	return duties, nil
+}
+
+// committees returns the members of the beacon committees of the epoch.
+func (c *Client) committees(ctx context.Context, epoch types.Epoch) (map[committeeKey][]types.ValidatorIndex, error) {
+	query := url.Values{"epoch": {strconv.FormatUint(uint64(epoch), 10)}}
+	data, err := c.get(ctx, "/eth/v1/beacon/states/head/committees", query)
+	if err != nil {
+		return nil, err
+	}
+	committees := make(map[committeeKey][]types.ValidatorIndex)
+	if err := unmarshalList(data, func(item []byte) error {
+		committee := &ethpbv1.Committee{}
+		if err := unmarshalJSON(item, committee); err != nil {
+			return err
+		}
+		committees[committeeKey{slot: committee.Slot, committeeIndex: committee.Index}] = committee.Validators
+		return nil
+	}); err != nil {
+		return nil, errors.Wrap(err, "could not decode committees")
+	}
+	return committees, nil
+}
+
+// DomainData computes the signature domain from the fork schedule and the genesis validators root
+// of the beacon node.
+func (c *Client) DomainData(ctx context.Context, in *ethpb.DomainRequest, _ ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	_, genesisValidatorsRoot, err := c.genesis(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get genesis validators root")
+	}
+	fork, err := c.fork(ctx, in.Epoch)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork")
+	}
+	domain, err := helpers.Domain(fork, in.Epoch, bytesutil.ToBytes4(in.Domain), genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.DomainResponse{SignatureDomain: domain}, nil
+}
+
+// WaitForChainStart returns a stream which receives the genesis of the chain once the beacon node
+// knows it.
+func (c *Client) WaitForChainStart(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	return &chainStartStream{ctx: ctx, client: c}, nil
+}
+
+// WaitForActivation returns a stream which receives the statuses of the validators every slot.
+func (c *Client) WaitForActivation(
+	ctx context.Context, in *ethpb.ValidatorActivationRequest, _ ...grpc.CallOption,
+) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	return &activationStream{ctx: ctx, client: c, pubKeys: in.PublicKeys}, nil
+}
+
+// ValidatorIndex returns the index of the validator in the head state.
+func (c *Client) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, _ ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	index, err := c.validatorIndex(ctx, in.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.ValidatorIndexResponse{Index: index}, nil
+}
+
+// MultipleValidatorStatus returns the statuses of the validators requested by public key, followed
+// by those of the validators requested by index only.
+func (c *Client) MultipleValidatorStatus(
+	ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, _ ...grpc.CallOption,
+) (*ethpb.MultipleValidatorStatusResponse, error) {
+	ids := make([]string, 0, len(in.PublicKeys)+len(in.Indices))
+	for _, pubKey := range in.PublicKeys {
+		ids = append(ids, hexutil.Encode(pubKey))
+	}
+	for _, index := range in.Indices {
+		ids = append(ids, strconv.FormatInt(index, 10))
+	}
+	vals, err := c.validators(ctx, ids)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get validators")
+	}
+	byPubKey := make(map[[48]byte]*ethpbv1.ValidatorContainer, len(vals))
+	byIndex := make(map[types.ValidatorIndex]*ethpbv1.ValidatorContainer, len(vals))
+	for _, val := range vals {
+		if val.Validator == nil {
+			continue
+		}
+		byPubKey[bytesutil.ToBytes48(val.Validator.Pubkey)] = val
+		byIndex[val.Index] = val
+	}
+
+	resp := &ethpb.MultipleValidatorStatusResponse{}
+	requested := make(map[[48]byte]bool, len(in.PublicKeys))
+	for _, pubKey := range in.PublicKeys {
+		requested[bytesutil.ToBytes48(pubKey)] = true
+		appendValidatorStatus(resp, pubKey, byPubKey[bytesutil.ToBytes48(pubKey)])
+	}
+	for _, index := range in.Indices {
+		val, ok := byIndex[types.ValidatorIndex(index)]
+		if !ok || requested[bytesutil.ToBytes48(val.Validator.Pubkey)] {
+			continue
+		}
+		requested[bytesutil.ToBytes48(val.Validator.Pubkey)] = true
+		appendValidatorStatus(resp, val.Validator.Pubkey, val)
+	}
+	return resp, nil
+}
+
+// appendValidatorStatus appends the status of the validator, which is nil if the validator is not
+// in the head state.
+func appendValidatorStatus(resp *ethpb.MultipleValidatorStatusResponse, pubKey []byte, val *ethpbv1.ValidatorContainer) {
+	s := &ethpb.ValidatorStatusResponse{
+		Status:          ethpb.ValidatorStatus_UNKNOWN_STATUS,
+		ActivationEpoch: params.BeaconConfig().FarFutureEpoch,
+	}
+	index := nonExistentIndex
+	if val != nil {
+		s.Status = validatorStatus(val.Status)
+		s.ActivationEpoch = val.Validator.ActivationEpoch
+		index = val.Index
+	}
+	resp.PublicKeys = append(resp.PublicKeys, pubKey)
+	resp.Statuses = append(resp.Statuses, s)
+	resp.Indices = append(resp.Indices, index)
+}
+
+// GetBlock requests an unsigned block to propose from the beacon node.
+func (c *Client) GetBlock(ctx context.Context, in *ethpb.BlockRequest, _ ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	query := url.Values{"randao_reveal": {hexutil.Encode(in.RandaoReveal)}}
+	if len(in.Graffiti) > 0 {
+		query.Set("graffiti", hexutil.Encode(bytesutil.PadTo(in.Graffiti, 32)))
+	}
+	data, err := c.get(ctx, fmt.Sprintf("/eth/v1/validator/blocks/%d", in.Slot), query)
+	if err != nil {
+		return nil, err
+	}
+	blk := &ethpbv1.BeaconBlock{}
+	if err := unmarshalJSON(data, blk); err != nil {
+		return nil, errors.Wrap(err, "could not decode block")
+	}
+	// The blocks of both APIs have the same SSZ encoding.
+	enc, err := blk.MarshalSSZ()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal block")
+	}
+	alphaBlk := &ethpb.BeaconBlock{}
+	if err := alphaBlk.UnmarshalSSZ(enc); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal block")
+	}
+	return alphaBlk, nil
+}
+
+// ProposeBlock publishes the signed block.
+func (c *Client) ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	root, err := in.Block.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute block root")
+	}
+	blk, err := migration.V1Alpha1ToV1SignedBlock(in)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not convert block")
+	}
+	body := map[string]interface{}{
+		"message":   marshalJSON(blk.Block),
+		"signature": hexutil.Encode(blk.Signature),
+	}
+	if _, err := c.post(ctx, "/eth/v1/beacon/blocks", body); err != nil {
+		return nil, err
+	}
+	return &ethpb.ProposeResponse{BlockRoot: root[:]}, nil
+}
+
+// GetAttestationData requests the attestation data of the committee at the slot.
+func (c *Client) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, _ ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	data, err := c.attestationData(ctx, in.Slot, in.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	return migration.V1AttDataToV1Alpha1(data), nil
+}
+
+func (c *Client) attestationData(ctx context.Context, slot types.Slot, committeeIndex types.CommitteeIndex) (*ethpbv1.AttestationData, error) {
+	query := url.Values{
+		"slot":            {strconv.FormatUint(uint64(slot), 10)},
+		"committee_index": {strconv.FormatUint(uint64(committeeIndex), 10)},
+	}
+	resp, err := c.get(ctx, "/eth/v1/validator/attestation_data", query)
+	if err != nil {
+		return nil, err
+	}
+	data := &ethpbv1.AttestationData{}
+	if err := unmarshalJSON(resp, data); err != nil {
+		return nil, errors.Wrap(err, "could not decode attestation data")
+	}
+	return data, nil
+}
+
+// ProposeAttestation publishes the attestation.
+func (c *Client) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, _ ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	root, err := in.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	body := []interface{}{marshalJSON(migration.V1Alpha1AttestationToV1(in))}
+	if _, err := c.post(ctx, "/eth/v1/beacon/pool/attestations", body); err != nil {
+		return nil, err
+	}
+	return &ethpb.AttestResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// SubmitAggregateSelectionProof returns the aggregate attestation of the committee at the slot, to
+// be signed by the aggregator.
+func (c *Client) SubmitAggregateSelectionProof(
+	ctx context.Context, in *ethpb.AggregateSelectionRequest, _ ...grpc.CallOption,
+) (*ethpb.AggregateSelectionResponse, error) {
+	index, err := c.validatorIndex(ctx, in.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	data, err := c.attestationData(ctx, in.Slot, in.CommitteeIndex)
+	if err != nil {
+		return nil, err
+	}
+	root, err := data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	query := url.Values{
+		"attestation_data_root": {hexutil.Encode(root[:])},
+		"slot":                  {strconv.FormatUint(uint64(in.Slot), 10)},
+	}
+	resp, err := c.get(ctx, "/eth/v1/validator/aggregate_attestation", query)
+	if err != nil {
+		return nil, err
+	}
+	aggregate := &ethpbv1.Attestation{}
+	if err := unmarshalJSON(resp, aggregate); err != nil {
+		return nil, errors.Wrap(err, "could not decode aggregate attestation")
+	}
+	return &ethpb.AggregateSelectionResponse{
+		AggregateAndProof: &ethpb.AggregateAttestationAndProof{
+			AggregatorIndex: index,
+			Aggregate:       migration.V1AttToV1Alpha1(aggregate),
+			SelectionProof:  in.SlotSignature,
+		},
+	}, nil
+}
+
+// SubmitSignedAggregateSelectionProof publishes the signed aggregate and proof.
+func (c *Client) SubmitSignedAggregateSelectionProof(
+	ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, _ ...grpc.CallOption,
+) (*ethpb.SignedAggregateSubmitResponse, error) {
+	signed := in.SignedAggregateAndProof
+	if signed == nil || signed.Message == nil || signed.Message.Aggregate == nil {
+		return nil, status.Error(codes.InvalidArgument, "signed aggregate request can't be nil")
+	}
+	root, err := signed.Message.Aggregate.Data.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute attestation data root")
+	}
+	body := []interface{}{marshalJSON(&ethpbv1.SignedAggregateAttestationAndProof{
+		Message:   migration.V1Alpha1AggregateAttAndProofToV1(signed.Message),
+		Signature: signed.Signature,
+	})}
+	if _, err := c.post(ctx, "/eth/v1/validator/aggregate_and_proofs", body); err != nil {
+		return nil, err
+	}
+	return &ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: root[:]}, nil
+}
+
+// ProposeExit publishes the signed voluntary exit.
+func (c *Client) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, _ ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	root, err := in.Exit.HashTreeRoot()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not compute exit root")
+	}
+	if _, err := c.post(ctx, "/eth/v1/beacon/pool/voluntary_exits", marshalJSON(migration.V1Alpha1ExitToV1(in))); err != nil {
+		return nil, err
+	}
+	return &ethpb.ProposeExitResponse{ExitRoot: root[:]}, nil
+}
+
+// SubscribeCommitteeSubnets subscribes the beacon node to the subnets of the committees. The beacon
+// node API identifies the subscriptions by the validators, which are known from the attester
+// duties of the last GetDuties call: the validator client requests a subscription for each of its
+// validators with a duty in the committee, in the order of the public keys of its duties request,
+// so the subscriptions to the same committee are made for its validators in that order.
+func (c *Client) SubscribeCommitteeSubnets(
+	ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, _ ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	if len(in.Slots) != len(in.CommitteeIds) || len(in.Slots) != len(in.IsAggregator) {
+		return nil, status.Error(codes.InvalidArgument, "request fields are not the same length")
+	}
+	subscriptions := make([]*committeeSubscription, len(in.Slots))
+	subscribed := make(map[committeeKey]int)
+	c.lock.RLock()
+	for i, slot := range in.Slots {
+		key := committeeKey{slot: slot, committeeIndex: in.CommitteeIds[i]}
+		duties := c.attesterDuties[key]
+		if subscribed[key] >= len(duties) {
+			c.lock.RUnlock()
+			return nil, status.Errorf(
+				codes.InvalidArgument, "no attester duty left for committee %d at slot %d", in.CommitteeIds[i], slot,
+			)
+		}
+		duty := duties[subscribed[key]]
+		subscribed[key]++
+		subscriptions[i] = &committeeSubscription{
+			ValidatorIndex:   strconv.FormatUint(uint64(duty.ValidatorIndex), 10),
+			CommitteeIndex:   strconv.FormatUint(uint64(duty.CommitteeIndex), 10),
+			CommitteesAtSlot: strconv.FormatUint(duty.CommitteesAtSlot, 10),
+			Slot:             strconv.FormatUint(uint64(slot), 10),
+			IsAggregator:     in.IsAggregator[i],
+		}
+	}
+	c.lock.RUnlock()
+	if _, err := c.post(ctx, "/eth/v1/validator/beacon_committee_subscriptions", subscriptions); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// CheckDoppelGanger is not supported by the beacon node API.
+func (c *Client) CheckDoppelGanger(_ context.Context, _ *ethpb.DoppelGangerRequest, _ ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "doppelganger protection is not supported by the beacon node REST API")
+}
+
+// GetSyncMessageBlockRoot returns the head block root, which is signed by the sync committee.
+func (c *Client) GetSyncMessageBlockRoot(ctx context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncMessageBlockRootResponse, error) {
+	root, err := c.headRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &ethpb.SyncMessageBlockRootResponse{Root: root}, nil
+}
+
+func (c *Client) headRoot(ctx context.Context) ([]byte, error) {
+	data, err := c.get(ctx, "/eth/v1/beacon/blocks/head/root", nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Root string `json:"root"`
+	}
+	if err := json.Unmarshal(data, &resp); err != nil {
+		return nil, errors.Wrap(err, "could not decode head root")
+	}
+	return hexutil.Decode(resp.Root)
+}
+
+// SubmitSyncMessage publishes the sync committee message.
+func (c *Client) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage, _ ...grpc.CallOption) (*emptypb.Empty, error) {
+	body := []interface{}{marshalJSON(migration.V1Alpha1SyncCommitteeMessageToV2(in))}
+	if _, err := c.post(ctx, "/eth/v1/beacon/pool/sync_committees", body); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// GetSyncSubcommitteeIndex returns the positions of the validator in the sync committee of the slot.
+func (c *Client) GetSyncSubcommitteeIndex(
+	ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest, _ ...grpc.CallOption,
+) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	index, err := c.validatorIndex(ctx, in.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	path := fmt.Sprintf("/eth/v1/validator/duties/sync/%d", helpers.SlotToEpoch(in.Slot))
+	data, err := c.post(ctx, path, []string{strconv.FormatUint(uint64(index), 10)})
+	if err != nil {
+		return nil, err
+	}
+	var duties []struct {
+		ValidatorSyncCommitteeIndices []string `json:"validator_sync_committee_indices"`
+	}
+	if err := json.Unmarshal(data, &duties); err != nil {
+		return nil, errors.Wrap(err, "could not decode sync committee duties")
+	}
+	resp := &ethpb.SyncSubcommitteeIndexResponse{}
+	for _, duty := range duties {
+		for _, s := range duty.ValidatorSyncCommitteeIndices {
+			i, err := parseUint(s)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not decode sync committee index")
+			}
+			resp.Indices = append(resp.Indices, types.CommitteeIndex(i))
+		}
+	}
+	return resp, nil
+}
+
+// GetSyncCommitteeContribution returns the aggregate of the sync committee messages of the
+// subcommittee for the head block at the slot.
+func (c *Client) GetSyncCommitteeContribution(
+	ctx context.Context, in *ethpb.SyncCommitteeContributionRequest, _ ...grpc.CallOption,
+) (*ethpb.SyncCommitteeContribution, error) {
+	root, err := c.headRoot(ctx)
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{
+		"slot":               {strconv.FormatUint(uint64(in.Slot), 10)},
+		"subcommittee_index": {strconv.FormatUint(in.SubnetId, 10)},
+		"beacon_block_root":  {hexutil.Encode(root)},
+	}
+	data, err := c.get(ctx, "/eth/v1/validator/sync_committee_contribution", query)
+	if err != nil {
+		return nil, err
+	}
+	contribution := &ethpbv2.SyncCommitteeContribution{}
+	if err := unmarshalJSON(data, contribution); err != nil {
+		return nil, errors.Wrap(err, "could not decode sync committee contribution")
+	}
+	return &ethpb.SyncCommitteeContribution{
+		Slot:              contribution.Slot,
+		BlockRoot:         contribution.BeaconBlockRoot,
+		SubcommitteeIndex: contribution.SubcommitteeIndex,
+		AggregationBits:   contribution.AggregationBits,
+		Signature:         contribution.Signature,
+	}, nil
+}
+
+// SubmitSignedContributionAndProof publishes the signed sync committee contribution and proof.
+func (c *Client) SubmitSignedContributionAndProof(
+	ctx context.Context, in *ethpb.SignedContributionAndProof, _ ...grpc.CallOption,
+) (*emptypb.Empty, error) {
+	body := []interface{}{marshalJSON(migration.V1Alpha1SignedContributionAndProofToV2(in))}
+	if _, err := c.post(ctx, "/eth/v1/validator/contribution_and_proofs", body); err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"context"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+)
+
+var broadcastSubmissionsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "validator_broadcast_submissions_total",
+	Help: "The number of signed duties broadcast to each beacon node, by duty and result.",
+}, []string{"endpoint", "duty", "result"})
+
+// broadcast submits a signed duty. When signed duties are broadcast, the duty is submitted to all
+// the beacon nodes in parallel and the first successful response is returned, while the other
+// submissions complete in the background. If all the submissions fail, the error of the current
+// beacon node is returned.
+func (f *beaconNodeFailover) broadcast(duty string, submit func(n *beaconNode) (interface{}, error)) (interface{}, error) {
+	active := f.node()
+	if !f.broadcastSignedDuties || len(f.nodes) == 1 {
+		resp, err := submit(active)
+		active.record(err)
+		return resp, err
+	}
+
+	type result struct {
+		node *beaconNode
+		resp interface{}
+		err  error
+	}
+	results := make(chan *result, len(f.nodes))
+	for _, n := range f.nodes {
+		go func(n *beaconNode) {
+			resp, err := submit(n)
+			n.record(err)
+			outcome := "success"
+			if err != nil {
+				outcome = "failure"
+				log.WithError(err).WithFields(logrus.Fields{
+					"endpoint": n.endpoint,
+					"duty":     duty,
+				}).Debug("Could not broadcast signed duty to beacon node")
+			}
+			broadcastSubmissionsCounter.WithLabelValues(n.endpoint, duty, outcome).Inc()
+			results <- &result{node: n, resp: resp, err: err}
+		}(n)
+	}
+	var err error
+	for range f.nodes {
+		r := <-results
+		if r.err == nil {
+			return r.resp, nil
+		}
+		if r.node == active || err == nil {
+			err = r.err
+		}
+	}
+	return nil, err
+}
+
+func (f *beaconNodeFailover) ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, opts ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+	resp, err := f.broadcast("block", func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ProposeBlock(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*ethpb.ProposeResponse), nil
+}
+
+func (f *beaconNodeFailover) ProposeAttestation(ctx context.Context, in *ethpb.Attestation, opts ...grpc.CallOption) (*ethpb.AttestResponse, error) {
+	resp, err := f.broadcast("attestation", func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.ProposeAttestation(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*ethpb.AttestResponse), nil
+}
+
+func (f *beaconNodeFailover) SubmitSignedAggregateSelectionProof(
+	ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, opts ...grpc.CallOption,
+) (*ethpb.SignedAggregateSubmitResponse, error) {
+	resp, err := f.broadcast("aggregate", func(n *beaconNode) (interface{}, error) {
+		return n.validatorClient.SubmitSignedAggregateSelectionProof(ctx, in, opts...)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.(*ethpb.SignedAggregateSubmitResponse), nil
+}
@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func TestBeaconNodeFailover_BroadcastSignedDuties(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	backup := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	f := newBeaconNodeFailover(context.Background(), []*beaconNode{
+		{endpoint: "primary:4000", validatorClient: primary},
+		{endpoint: "backup:4000", validatorClient: backup},
+	}, true /* broadcastSignedDuties */)
+
+	blk := testutil.NewBeaconBlock()
+	primaryDone := make(chan struct{})
+	primary.EXPECT().ProposeBlock(gomock.Any(), blk).DoAndReturn(
+		func(_ context.Context, _ *ethpb.SignedBeaconBlock, _ ...grpc.CallOption) (*ethpb.ProposeResponse, error) {
+			defer close(primaryDone)
+			return nil, status.Error(codes.Unavailable, "no peers")
+		},
+	)
+	backup.EXPECT().ProposeBlock(gomock.Any(), blk).Return(&ethpb.ProposeResponse{BlockRoot: []byte{1}}, nil)
+	resp, err := f.ProposeBlock(context.Background(), blk)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1}, resp.BlockRoot)
+	<-primaryDone
+
+	// All the submissions failed, so the error of the current beacon node is returned.
+	att := &ethpb.Attestation{}
+	primary.EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, status.Error(codes.Internal, "primary failed"))
+	backup.EXPECT().ProposeAttestation(gomock.Any(), att).Return(nil, status.Error(codes.Internal, "backup failed"))
+	_, err = f.ProposeAttestation(context.Background(), att)
+	assert.ErrorContains(t, "primary failed", err)
+}
+
+func TestBeaconNodeFailover_SubmitToCurrentNode(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	backup := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	f := newBeaconNodeFailover(context.Background(), []*beaconNode{
+		{endpoint: "primary:4000", validatorClient: primary},
+		{endpoint: "backup:4000", validatorClient: backup},
+	}, false /* broadcastSignedDuties */)
+
+	req := &ethpb.SignedAggregateSubmitRequest{}
+	primary.EXPECT().SubmitSignedAggregateSelectionProof(gomock.Any(), req).Return(
+		&ethpb.SignedAggregateSubmitResponse{AttestationDataRoot: []byte{2}}, nil,
+	)
+	backup.EXPECT().SubmitSignedAggregateSelectionProof(gomock.Any(), gomock.Any()).Times(0)
+	resp, err := f.SubmitSignedAggregateSelectionProof(context.Background(), req)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{2}, resp.AttestationDataRoot)
+}
+
+func TestBeaconNodeFailover_SubscribeCommitteeSubnetsAfterSwitch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	primary := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	backup := mock.NewMockBeaconNodeValidatorClient(ctrl)
+	f := newBeaconNodeFailover(context.Background(), []*beaconNode{
+		{endpoint: "primary:4000", validatorClient: primary},
+		{endpoint: "backup:4000", validatorClient: backup},
+	}, false /* broadcastSignedDuties */)
+
+	dutiesReq := &ethpb.DutiesRequest{Epoch: 3, PublicKeys: [][]byte{{1}}}
+	primary.EXPECT().GetDuties(gomock.Any(), dutiesReq).Return(&ethpb.DutiesResponse{}, nil)
+	_, err := f.GetDuties(context.Background(), dutiesReq)
+	require.NoError(t, err)
+
+	// The duties were served by the current beacon node, so they are not requested again.
+	subReq := &ethpb.CommitteeSubnetsSubscribeRequest{Slots: []types.Slot{96}}
+	primary.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), subReq).Return(&emptypb.Empty{}, nil)
+	_, err = f.SubscribeCommitteeSubnets(context.Background(), subReq)
+	require.NoError(t, err)
+
+	// The failover switched to the backup beacon node, which is asked for the duties before
+	// subscribing, and only once.
+	f.lock.Lock()
+	f.active = 1
+	f.lock.Unlock()
+	gomock.InOrder(
+		backup.EXPECT().GetDuties(gomock.Any(), dutiesReq).Return(&ethpb.DutiesResponse{}, nil),
+		backup.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), subReq).Return(&emptypb.Empty{}, nil).Times(2),
+	)
+	_, err = f.SubscribeCommitteeSubnets(context.Background(), subReq)
+	require.NoError(t, err)
+	_, err = f.SubscribeCommitteeSubnets(context.Background(), subReq)
+	require.NoError(t, err)
+
+	// The subscriptions fail if the duties cannot be requested from the current beacon node.
+	f.lock.Lock()
+	f.active = 0
+	f.lock.Unlock()
+	primary.EXPECT().GetDuties(gomock.Any(), dutiesReq).Return(nil, status.Error(codes.Unavailable, "connection refused"))
+	primary.EXPECT().SubscribeCommitteeSubnets(gomock.Any(), gomock.Any()).Times(0)
+	_, err = f.SubscribeCommitteeSubnets(context.Background(), subReq)
+	assert.ErrorContains(t, "could not get duties from current beacon node", err)
+}
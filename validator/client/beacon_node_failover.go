@@ -0,0 +1,198 @@
+package client
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	_ = iface.ValidatorClient(&beaconNodeFailover{})
+	_ = iface.BeaconChainClient(&beaconNodeFailover{})
+	_ = iface.NodeClient(&beaconNodeFailover{})
+)
+
+func (f *beaconNodeFailover) GetDuties(ctx context.Context, in *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.GetDuties(ctx, in, opts...)
+	n.record(err)
+	if err == nil {
+		f.lock.Lock()
+		f.dutiesRequest, f.dutiesNode = in, n
+		f.lock.Unlock()
+	}
+	return resp, err
+}
+
+func (f *beaconNodeFailover) DomainData(ctx context.Context, in *ethpb.DomainRequest, opts ...grpc.CallOption) (*ethpb.DomainResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.DomainData(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) WaitForChainStart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error) {
+	n := f.node()
+	resp, err := n.validatorClient.WaitForChainStart(f.streamContext(ctx), in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error) {
+	n := f.node()
+	resp, err := n.validatorClient.WaitForActivation(f.streamContext(ctx), in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, opts ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.ValidatorIndex(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, opts ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.MultipleValidatorStatus(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetBlock(ctx context.Context, in *ethpb.BlockRequest, opts ...grpc.CallOption) (*ethpb.BeaconBlock, error) {
+	n := f.node()
+	resp, err := n.validatorClient.GetBlock(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, opts ...grpc.CallOption) (*ethpb.AttestationData, error) {
+	n := f.node()
+	resp, err := n.validatorClient.GetAttestationData(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, opts ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.SubmitAggregateSelectionProof(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, opts ...grpc.CallOption) (*ethpb.ProposeExitResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.ProposeExit(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	n := f.node()
+	f.lock.RLock()
+	dutiesRequest, dutiesNode := f.dutiesRequest, f.dutiesNode
+	f.lock.RUnlock()
+	// The duties were requested from another beacon node before the failover switched, so
+	// request them again from the current one, which may need them to find the committees.
+	if dutiesRequest != nil && dutiesNode != n {
+		_, err := n.validatorClient.GetDuties(ctx, dutiesRequest, opts...)
+		n.record(err)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not get duties from current beacon node")
+		}
+		f.lock.Lock()
+		f.dutiesNode = n
+		f.lock.Unlock()
+	}
+	resp, err := n.validatorClient.SubscribeCommitteeSubnets(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest, opts ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.CheckDoppelGanger(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetSyncMessageBlockRoot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.SyncMessageBlockRootResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.GetSyncMessageBlockRoot(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	n := f.node()
+	resp, err := n.validatorClient.SubmitSyncMessage(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest, opts ...grpc.CallOption) (*ethpb.SyncSubcommitteeIndexResponse, error) {
+	n := f.node()
+	resp, err := n.validatorClient.GetSyncSubcommitteeIndex(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest, opts ...grpc.CallOption) (*ethpb.SyncCommitteeContribution, error) {
+	n := f.node()
+	resp, err := n.validatorClient.GetSyncCommitteeContribution(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	n := f.node()
+	resp, err := n.validatorClient.SubmitSignedContributionAndProof(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) StreamBlocks(ctx context.Context, in *ethpb.StreamBlocksRequest, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error) {
+	n := f.node()
+	resp, err := n.beaconClient.StreamBlocks(f.streamContext(ctx), in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetChainHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error) {
+	n := f.node()
+	resp, err := n.beaconClient.GetChainHead(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error) {
+	n := f.node()
+	resp, err := n.beaconClient.GetValidatorPerformance(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+	n := f.node()
+	resp, err := n.nodeClient.GetSyncStatus(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) GetGenesis(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.Genesis, error) {
+	n := f.node()
+	resp, err := n.nodeClient.GetGenesis(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
+
+func (f *beaconNodeFailover) ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.Peers, error) {
+	n := f.node()
+	resp, err := n.nodeClient.ListPeers(ctx, in, opts...)
+	n.record(err)
+	return resp, err
+}
@@ -0,0 +1,387 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/runutil"
+	"github.com/prysmaticlabs/prysm/shared/timeutils"
+	"github.com/prysmaticlabs/prysm/validator/client/iface"
+	"github.com/sirupsen/logrus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	beaconNodeHealthyGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_beacon_node_healthy",
+		Help: "Boolean indicating whether the beacon node passed its latest health check: 0=false, 1=true.",
+	}, []string{"endpoint"})
+	beaconNodeActiveGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_beacon_node_active",
+		Help: "Boolean indicating whether the duties are sent to the beacon node: 0=false, 1=true.",
+	}, []string{"endpoint"})
+	beaconNodeScoreGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_beacon_node_score",
+		Help: "The health score of the beacon node, between 0 and 1.",
+	}, []string{"endpoint"})
+	beaconNodeSlotsBehindGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_beacon_node_slots_behind",
+		Help: "The number of slots the head of the beacon node is behind the best configured beacon node.",
+	}, []string{"endpoint"})
+	beaconNodePeersGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "validator_beacon_node_peers",
+		Help: "The number of peers the beacon node is connected to.",
+	}, []string{"endpoint"})
+)
+
+var (
+	// time after which a health check of a beacon node is aborted.
+	beaconNodeHealthCheckTimeout = 5 * time.Second
+	// number of slots a beacon node can be behind the best beacon node before it is considered unhealthy.
+	beaconNodeMaxSlotsBehind = uint64(4)
+	// number of peers below which a beacon node is considered unhealthy.
+	beaconNodeMinPeers = uint64(1)
+	// number of peers at which a beacon node gets the best peer score.
+	beaconNodeTargetPeers = uint64(16)
+	// share of failed requests above which a beacon node is considered unhealthy.
+	beaconNodeMaxErrorRate = 0.5
+	// number of requests between health checks below which the error rate is not computed.
+	beaconNodeMinRequests = uint64(4)
+	// score difference within which the beacon nodes are preferred in the order they are configured in.
+	beaconNodeScoreMargin = 0.1
+	// error when the beacon node is syncing.
+	errBeaconNodeSyncing = errors.New("beacon node is syncing")
+	// error when the beacon node has too few peers.
+	errBeaconNodeFewPeers = errors.Errorf("beacon node has fewer than %d peers", beaconNodeMinPeers)
+	// error when too many requests to the beacon node failed.
+	errBeaconNodeErrorRate = errors.Errorf("more than %.0f%% of the requests to the beacon node failed", beaconNodeMaxErrorRate*100)
+	// error when the beacon node is too far behind the other beacon nodes.
+	errBeaconNodeBehind = errors.Errorf("beacon node is more than %d slots behind the best beacon node", beaconNodeMaxSlotsBehind)
+)
+
+// BeaconNodeHealth is the result of the latest health check of a beacon node.
+type BeaconNodeHealth struct {
+	Endpoint    string
+	Active      bool
+	Healthy     bool
+	Score       float64
+	Syncing     bool
+	HeadSlot    types.Slot
+	SlotsBehind uint64
+	Peers       uint64
+	ErrorRate   float64
+	Err         error
+	LastChecked time.Time
+}
+
+// beaconNode holds the clients of a configured beacon node, and counts the requests sent to it
+// between health checks.
+type beaconNode struct {
+	endpoint        string
+	conn            *grpc.ClientConn
+	validatorClient iface.ValidatorClient
+	beaconClient    iface.BeaconChainClient
+	nodeClient      iface.NodeClient
+	requests        uint64
+	failures        uint64
+}
+
+// record counts a request to the beacon node and whether it failed because of the beacon node.
+func (n *beaconNode) record(err error) {
+	atomic.AddUint64(&n.requests, 1)
+	if isBeaconNodeError(err) {
+		atomic.AddUint64(&n.failures, 1)
+	}
+}
+
+// isBeaconNodeError returns whether the error is caused by the beacon node being unreachable or
+// failing, rather than by the request.
+func isBeaconNodeError(err error) bool {
+	if err == nil {
+		return false
+	}
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
+
+// beaconNodeFailover sends the requests of the validator client to the healthiest of the
+// configured beacon nodes. It implements the beacon node clients of the validator client.
+type beaconNodeFailover struct {
+	ctx    context.Context
+	nodes  []*beaconNode
+	lock   sync.RWMutex
+	active int
+	health []*BeaconNodeHealth
+	// switched is closed when the failover switches to another beacon node, which ends the
+	// streams opened on the previous one.
+	switched chan struct{}
+	// broadcastSignedDuties sends the signed blocks, attestations and aggregates to all the
+	// beacon nodes rather than only to the current one.
+	broadcastSignedDuties bool
+	// dutiesRequest is the last successful duties request, and dutiesNode the beacon node which
+	// served it. Some beacon node clients need the duties to subscribe to the committee subnets,
+	// so they are requested again from the current beacon node when it is another one.
+	dutiesRequest *ethpb.DutiesRequest
+	dutiesNode    *beaconNode
+}
+
+func newBeaconNodeFailover(ctx context.Context, nodes []*beaconNode, broadcastSignedDuties bool) *beaconNodeFailover {
+	return &beaconNodeFailover{
+		ctx:                   ctx,
+		nodes:                 nodes,
+		broadcastSignedDuties: broadcastSignedDuties,
+		health:                make([]*BeaconNodeHealth, len(nodes)),
+		switched:              make(chan struct{}),
+	}
+}
+
+// node returns the beacon node the requests are currently sent to.
+func (f *beaconNodeFailover) node() *beaconNode {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	return f.nodes[f.active]
+}
+
+// streamContext returns a context which is canceled when the failover switches to another beacon
+// node, so that the streams are opened again on the new one.
+func (f *beaconNodeFailover) streamContext(ctx context.Context) context.Context {
+	f.lock.RLock()
+	switched := f.switched
+	f.lock.RUnlock()
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		select {
+		case <-switched:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+	return ctx
+}
+
+// BeaconNodeHealth returns the health of the configured beacon nodes, in the order they are
+// configured in. Beacon nodes which have not been checked yet are reported as unhealthy.
+func (f *beaconNodeFailover) BeaconNodeHealth() []*BeaconNodeHealth {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	health := make([]*BeaconNodeHealth, len(f.nodes))
+	for i, n := range f.nodes {
+		h := &BeaconNodeHealth{
+			Endpoint: n.endpoint,
+			Err:      errors.New("beacon node has not been checked yet"),
+		}
+		if f.health[i] != nil {
+			copied := *f.health[i]
+			h = &copied
+		}
+		h.Active = i == f.active
+		health[i] = h
+	}
+	return health
+}
+
+// monitorHealth checks the health of the beacon nodes every slot, and switches to the healthiest one.
+func (f *beaconNodeFailover) monitorHealth() {
+	f.checkHealth()
+	runutil.RunEvery(f.ctx, time.Duration(params.BeaconConfig().SecondsPerSlot)*time.Second, f.checkHealth)
+}
+
+// checkHealth checks all the beacon nodes concurrently, scores them against each other and
+// switches to the best one.
+func (f *beaconNodeFailover) checkHealth() {
+	health := make([]*BeaconNodeHealth, len(f.nodes))
+	var wg sync.WaitGroup
+	for i, n := range f.nodes {
+		wg.Add(1)
+		go func(i int, n *beaconNode) {
+			defer wg.Done()
+			health[i] = checkBeaconNodeHealth(f.ctx, n)
+		}(i, n)
+	}
+	wg.Wait()
+	scoreBeaconNodes(health)
+
+	f.lock.Lock()
+	f.health = health
+	f.lock.Unlock()
+	f.switchToBestNode()
+
+	for _, h := range f.BeaconNodeHealth() {
+		updateBeaconNodeMetrics(h)
+		if !h.Healthy {
+			log.WithError(h.Err).WithField("endpoint", h.Endpoint).Debug("Beacon node is unhealthy")
+		}
+	}
+}
+
+// checkBeaconNodeHealth checks the sync status, head and peers of the beacon node, and the share
+// of the requests to it which failed since its previous health check.
+func checkBeaconNodeHealth(ctx context.Context, n *beaconNode) *BeaconNodeHealth {
+	ctx, cancel := context.WithTimeout(ctx, beaconNodeHealthCheckTimeout)
+	defer cancel()
+	h := &BeaconNodeHealth{
+		Endpoint:    n.endpoint,
+		LastChecked: timeutils.Now(),
+	}
+	requests, failures := atomic.SwapUint64(&n.requests, 0), atomic.SwapUint64(&n.failures, 0)
+	if requests >= beaconNodeMinRequests {
+		h.ErrorRate = float64(failures) / float64(requests)
+	}
+
+	syncStatus, err := n.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not get sync status")
+		return h
+	}
+	h.Syncing = syncStatus.Syncing
+	head, err := n.beaconClient.GetChainHead(ctx, &emptypb.Empty{})
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not get chain head")
+		return h
+	}
+	h.HeadSlot = head.HeadSlot
+	peers, err := n.nodeClient.ListPeers(ctx, &emptypb.Empty{})
+	if err != nil {
+		h.Err = errors.Wrap(err, "could not list peers")
+		return h
+	}
+	for _, p := range peers.Peers {
+		if p.ConnectionState == ethpb.ConnectionState_CONNECTED {
+			h.Peers++
+		}
+	}
+
+	switch {
+	case h.Syncing:
+		h.Err = errBeaconNodeSyncing
+	case h.Peers < beaconNodeMinPeers:
+		h.Err = errBeaconNodeFewPeers
+	case h.ErrorRate > beaconNodeMaxErrorRate:
+		h.Err = errBeaconNodeErrorRate
+	}
+	return h
+}
+
+// scoreBeaconNodes scores the beacon nodes between 0 and 1, based on how far behind the head of the
+// best beacon node they are, their error rate and their number of peers. Beacon nodes which failed
+// their health check get a score of 0.
+func scoreBeaconNodes(health []*BeaconNodeHealth) {
+	bestHead := types.Slot(0)
+	for _, h := range health {
+		if h.Err == nil && h.HeadSlot > bestHead {
+			bestHead = h.HeadSlot
+		}
+	}
+	for _, h := range health {
+		h.SlotsBehind = 0
+		if h.HeadSlot < bestHead {
+			h.SlotsBehind = uint64(bestHead - h.HeadSlot)
+		}
+		if h.Err == nil && h.SlotsBehind > beaconNodeMaxSlotsBehind {
+			h.Err = errBeaconNodeBehind
+		}
+		h.Healthy = h.Err == nil
+		if !h.Healthy {
+			h.Score = 0
+			continue
+		}
+		peers := h.Peers
+		if peers > beaconNodeTargetPeers {
+			peers = beaconNodeTargetPeers
+		}
+		h.Score = 1 -
+			0.5*float64(h.SlotsBehind)/float64(beaconNodeMaxSlotsBehind+1) -
+			0.25*h.ErrorRate -
+			0.25*float64(beaconNodeTargetPeers-peers)/float64(beaconNodeTargetPeers)
+	}
+}
+
+// bestNode returns the index of the beacon node to send the requests to, which is the first
+// configured beacon node whose score is within the margin of the best score. It returns false if
+// no beacon node is healthy.
+func bestNode(health []*BeaconNodeHealth) (int, bool) {
+	bestScore := 0.0
+	for _, h := range health {
+		if h.Healthy && h.Score > bestScore {
+			bestScore = h.Score
+		}
+	}
+	for i, h := range health {
+		if h.Healthy && h.Score >= bestScore-beaconNodeScoreMargin {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// switchToBestNode sends the requests to the best beacon node, if it is not the current one. The
+// current beacon node is kept if no beacon node is healthy.
+func (f *beaconNodeFailover) switchToBestNode() {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	best, ok := bestNode(f.health)
+	if !ok {
+		if len(f.nodes) == 1 {
+			return
+		}
+		log.WithField("endpoint", f.nodes[f.active].endpoint).Warn("No healthy beacon node available")
+		return
+	}
+	if best == f.active {
+		return
+	}
+	fields := logrus.Fields{
+		"from": f.nodes[f.active].endpoint,
+		"to":   f.nodes[best].endpoint,
+	}
+	if err := f.health[f.active].Err; err != nil {
+		fields["reason"] = err.Error()
+	}
+	log.WithFields(fields).Info("Switching to healthier beacon node")
+	f.active = best
+	close(f.switched)
+	f.switched = make(chan struct{})
+}
+
+// close closes the connections to the beacon nodes.
+func (f *beaconNodeFailover) close() error {
+	var err error
+	for _, n := range f.nodes {
+		if n.conn == nil {
+			continue
+		}
+		if closeErr := n.conn.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	return err
+}
+
+func updateBeaconNodeMetrics(h *BeaconNodeHealth) {
+	boolToFloat := func(b bool) float64 {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	beaconNodeHealthyGauge.WithLabelValues(h.Endpoint).Set(boolToFloat(h.Healthy))
+	beaconNodeActiveGauge.WithLabelValues(h.Endpoint).Set(boolToFloat(h.Active))
+	beaconNodeScoreGauge.WithLabelValues(h.Endpoint).Set(h.Score)
+	beaconNodeSlotsBehindGauge.WithLabelValues(h.Endpoint).Set(float64(h.SlotsBehind))
+	beaconNodePeersGauge.WithLabelValues(h.Endpoint).Set(float64(h.Peers))
+}
@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/mock"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	logTest "github.com/sirupsen/logrus/hooks/test"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type mockBeaconNodeState struct {
+	syncing  bool
+	headSlot types.Slot
+	peers    int
+	err      error
+}
+
+func newMockBeaconNode(ctrl *gomock.Controller, endpoint string, state *mockBeaconNodeState) *beaconNode {
+	nodeClient := mock.NewMockNodeClient(ctrl)
+	beaconClient := mock.NewMockBeaconChainClient(ctrl)
+	nodeClient.EXPECT().GetSyncStatus(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.SyncStatus, error) {
+			if state.err != nil {
+				return nil, state.err
+			}
+			return &ethpb.SyncStatus{Syncing: state.syncing}, nil
+		},
+	).AnyTimes()
+	beaconClient.EXPECT().GetChainHead(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.ChainHead, error) {
+			return &ethpb.ChainHead{HeadSlot: state.headSlot}, nil
+		},
+	).AnyTimes()
+	nodeClient.EXPECT().ListPeers(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ *emptypb.Empty, _ ...grpc.CallOption) (*ethpb.Peers, error) {
+			peers := &ethpb.Peers{}
+			for i := 0; i < state.peers; i++ {
+				peers.Peers = append(peers.Peers, &ethpb.Peer{ConnectionState: ethpb.ConnectionState_CONNECTED})
+			}
+			return peers, nil
+		},
+	).AnyTimes()
+	return &beaconNode{
+		endpoint:     endpoint,
+		beaconClient: beaconClient,
+		nodeClient:   nodeClient,
+	}
+}
+
+func TestScoreBeaconNodes(t *testing.T) {
+	health := []*BeaconNodeHealth{
+		{HeadSlot: 100, Peers: 50},
+		{HeadSlot: 98, Peers: 50},
+		{HeadSlot: 90, Peers: 50},
+		{HeadSlot: 100, Peers: 4, ErrorRate: 0.2},
+		{HeadSlot: 200, Err: errBeaconNodeSyncing},
+	}
+	scoreBeaconNodes(health)
+
+	assert.Equal(t, true, health[0].Healthy)
+	assert.Equal(t, 1.0, health[0].Score)
+	assert.Equal(t, true, health[1].Healthy)
+	assert.Equal(t, uint64(2), health[1].SlotsBehind)
+	assert.Equal(t, 0.8, health[1].Score)
+	assert.Equal(t, false, health[2].Healthy)
+	assert.Equal(t, errBeaconNodeBehind, health[2].Err)
+	assert.Equal(t, true, health[3].Healthy)
+	assert.Equal(t, 1-0.25*0.2-0.25*12.0/16, health[3].Score)
+	assert.Equal(t, false, health[4].Healthy)
+	assert.Equal(t, 0.0, health[4].Score)
+
+	best, ok := bestNode(health)
+	require.Equal(t, true, ok)
+	assert.Equal(t, 0, best)
+	_, ok = bestNode([]*BeaconNodeHealth{{Err: errBeaconNodeSyncing}})
+	assert.Equal(t, false, ok)
+}
+
+func TestBeaconNodeFailover_SwitchesFromSyncingNode(t *testing.T) {
+	hook := logTest.NewGlobal()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	primary := &mockBeaconNodeState{syncing: true, headSlot: 10, peers: 20}
+	backup := &mockBeaconNodeState{headSlot: 100, peers: 20}
+	f := newBeaconNodeFailover(ctx, []*beaconNode{
+		newMockBeaconNode(ctrl, "primary:4000", primary),
+		newMockBeaconNode(ctrl, "backup:4000", backup),
+	}, false /* broadcastSignedDuties */)
+	streamCtx := f.streamContext(ctx)
+
+	f.checkHealth()
+	assert.Equal(t, "backup:4000", f.node().endpoint)
+	assert.LogsContain(t, hook, "Switching to healthier beacon node")
+	assert.LogsContain(t, hook, "beacon node is syncing")
+	select {
+	case <-streamCtx.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Streams of the previous beacon node were not ended")
+	}
+
+	health := f.BeaconNodeHealth()
+	require.Equal(t, 2, len(health))
+	assert.Equal(t, false, health[0].Active)
+	assert.Equal(t, true, health[0].Syncing)
+	assert.Equal(t, errBeaconNodeSyncing, health[0].Err)
+	assert.Equal(t, true, health[1].Active)
+	assert.Equal(t, true, health[1].Healthy)
+	assert.Equal(t, uint64(20), health[1].Peers)
+
+	// The primary beacon node is preferred again once it has synced.
+	primary.syncing, primary.headSlot = false, 100
+	f.checkHealth()
+	assert.Equal(t, "primary:4000", f.node().endpoint)
+
+	// No beacon node is healthy, so the current one is kept.
+	hook.Reset()
+	primary.err = status.Error(codes.Unavailable, "connection refused")
+	backup.peers = 0
+	f.checkHealth()
+	assert.Equal(t, "primary:4000", f.node().endpoint)
+	assert.LogsContain(t, hook, "No healthy beacon node available")
+}
+
+func TestBeaconNodeFailover_ErrorRate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	state := &mockBeaconNodeState{headSlot: 100, peers: 20}
+	n := newMockBeaconNode(ctrl, "localhost:4000", state)
+	n.record(nil)
+	n.record(status.Error(codes.NotFound, "no aggregate"))
+	n.record(status.Error(codes.Unavailable, "connection refused"))
+	n.record(errors.New("unknown"))
+	n.record(status.Error(codes.DeadlineExceeded, "timeout"))
+
+	h := checkBeaconNodeHealth(ctx, n)
+	assert.Equal(t, 0.6, h.ErrorRate)
+	assert.Equal(t, errBeaconNodeErrorRate, h.Err)
+
+	// The requests are counted from one health check to the next.
+	h = checkBeaconNodeHealth(ctx, n)
+	assert.Equal(t, 0.0, h.ErrorRate)
+	assert.NoError(t, h.Err)
+}
@@ -0,0 +1,49 @@
+package iface
+
+import (
+	"context"
+
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ValidatorClient defines the beacon node validator API used by the validator client. Its
+// methods match those of the Prysm gRPC client, so that the generated gRPC client implements it
+// as well as the clients of other beacon node APIs.
+type ValidatorClient interface {
+	GetDuties(ctx context.Context, in *ethpb.DutiesRequest, opts ...grpc.CallOption) (*ethpb.DutiesResponse, error)
+	DomainData(ctx context.Context, in *ethpb.DomainRequest, opts ...grpc.CallOption) (*ethpb.DomainResponse, error)
+	WaitForChainStart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForChainStartClient, error)
+	WaitForActivation(ctx context.Context, in *ethpb.ValidatorActivationRequest, opts ...grpc.CallOption) (ethpb.BeaconNodeValidator_WaitForActivationClient, error)
+	ValidatorIndex(ctx context.Context, in *ethpb.ValidatorIndexRequest, opts ...grpc.CallOption) (*ethpb.ValidatorIndexResponse, error)
+	MultipleValidatorStatus(ctx context.Context, in *ethpb.MultipleValidatorStatusRequest, opts ...grpc.CallOption) (*ethpb.MultipleValidatorStatusResponse, error)
+	GetBlock(ctx context.Context, in *ethpb.BlockRequest, opts ...grpc.CallOption) (*ethpb.BeaconBlock, error)
+	ProposeBlock(ctx context.Context, in *ethpb.SignedBeaconBlock, opts ...grpc.CallOption) (*ethpb.ProposeResponse, error)
+	GetAttestationData(ctx context.Context, in *ethpb.AttestationDataRequest, opts ...grpc.CallOption) (*ethpb.AttestationData, error)
+	ProposeAttestation(ctx context.Context, in *ethpb.Attestation, opts ...grpc.CallOption) (*ethpb.AttestResponse, error)
+	SubmitAggregateSelectionProof(ctx context.Context, in *ethpb.AggregateSelectionRequest, opts ...grpc.CallOption) (*ethpb.AggregateSelectionResponse, error)
+	SubmitSignedAggregateSelectionProof(ctx context.Context, in *ethpb.SignedAggregateSubmitRequest, opts ...grpc.CallOption) (*ethpb.SignedAggregateSubmitResponse, error)
+	ProposeExit(ctx context.Context, in *ethpb.SignedVoluntaryExit, opts ...grpc.CallOption) (*ethpb.ProposeExitResponse, error)
+	SubscribeCommitteeSubnets(ctx context.Context, in *ethpb.CommitteeSubnetsSubscribeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CheckDoppelGanger(ctx context.Context, in *ethpb.DoppelGangerRequest, opts ...grpc.CallOption) (*ethpb.DoppelGangerResponse, error)
+	GetSyncMessageBlockRoot(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.SyncMessageBlockRootResponse, error)
+	SubmitSyncMessage(ctx context.Context, in *ethpb.SyncCommitteeMessage, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	GetSyncSubcommitteeIndex(ctx context.Context, in *ethpb.SyncSubcommitteeIndexRequest, opts ...grpc.CallOption) (*ethpb.SyncSubcommitteeIndexResponse, error)
+	GetSyncCommitteeContribution(ctx context.Context, in *ethpb.SyncCommitteeContributionRequest, opts ...grpc.CallOption) (*ethpb.SyncCommitteeContribution, error)
+	SubmitSignedContributionAndProof(ctx context.Context, in *ethpb.SignedContributionAndProof, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+// BeaconChainClient defines the beacon chain API used by the validator client.
+type BeaconChainClient interface {
+	StreamBlocks(ctx context.Context, in *ethpb.StreamBlocksRequest, opts ...grpc.CallOption) (ethpb.BeaconChain_StreamBlocksClient, error)
+	GetChainHead(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.ChainHead, error)
+	GetValidatorPerformance(ctx context.Context, in *ethpb.ValidatorPerformanceRequest, opts ...grpc.CallOption) (*ethpb.ValidatorPerformanceResponse, error)
+}
+
+// NodeClient defines the beacon node API used by the validator client.
+type NodeClient interface {
+	GetSyncStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.SyncStatus, error)
+	GetGenesis(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.Genesis, error)
+	ListPeers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ethpb.Peers, error)
+}
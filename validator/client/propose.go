@@ -50,6 +50,11 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 	span.AddAttributes(trace.StringAttribute("validator", fmt.Sprintf("%#x", pubKey)))
 	log := log.WithField("pubKey", fmt.Sprintf("%#x", bytesutil.Trunc(pubKey[:])))
 
+	if v.proposerSettings != nil && !v.proposerSettings.Settings(pubKey).Enabled {
+		log.WithField("slot", slot).Warn("Block proposals are disabled for validator in proposer settings, skipping proposal")
+		return
+	}
+
 	// Sign randao reveal, it's used to request block from beacon node
 	epoch := types.Epoch(slot / params.BeaconConfig().SlotsPerEpoch)
 	randaoReveal, err := v.signRandaoReveal(ctx, pubKey, epoch)
@@ -113,7 +118,7 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 		return
 	}
 
-	if err := v.postBlockSignUpdate(ctx, pubKey, wrapperv1.WrappedPhase0SignedBeaconBlock(blk), signingRoot); err != nil {
+	if err := v.postBlockSignUpdate(ctx, pubKey, wrapperv1.WrappedPhase0SignedBeaconBlock(blk)); err != nil {
 		log.WithFields(
 			blockLogFields(pubKey, wrapperv1.WrappedPhase0BeaconBlock(b), sig),
 		).WithError(err).Error("Failed block slashing protection check")
@@ -154,8 +159,8 @@ func (v *validator) ProposeBlock(ctx context.Context, slot types.Slot, pubKey [4
 // The exit is signed by the validator before being sent to the beacon node for broadcasting.
 func ProposeExit(
 	ctx context.Context,
-	validatorClient ethpb.BeaconNodeValidatorClient,
-	nodeClient ethpb.NodeClient,
+	validatorClient iface.ValidatorClient,
+	nodeClient iface.NodeClient,
 	signer signingFunc,
 	pubKey []byte,
 ) error {
@@ -213,6 +218,7 @@ func (v *validator) signRandaoReveal(ctx context.Context, pubKey [48]byte, epoch
 		SigningRoot:     root[:],
 		SignatureDomain: domain.SignatureDomain,
 		Object:          &validatorpb.SignRequest_Epoch{Epoch: epoch},
+		SigningSlot:     params.BeaconConfig().SlotsPerEpoch.Mul(uint64(epoch)),
 	})
 	if err != nil {
 		return nil, err
@@ -240,6 +246,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch types.
 		SigningRoot:     blockRoot[:],
 		SignatureDomain: domain.SignatureDomain,
 		Object:          &validatorpb.SignRequest_Block{Block: b},
+		SigningSlot:     b.Slot,
 	})
 	if err != nil {
 		return nil, nil, errors.Wrap(err, "could not sign block proposal")
@@ -250,7 +257,7 @@ func (v *validator) signBlock(ctx context.Context, pubKey [48]byte, epoch types.
 // Sign voluntary exit with proposer domain and private key.
 func signVoluntaryExit(
 	ctx context.Context,
-	validatorClient ethpb.BeaconNodeValidatorClient,
+	validatorClient iface.ValidatorClient,
 	signer signingFunc,
 	pubKey []byte,
 	exit *ethpb.VoluntaryExit,
@@ -278,6 +285,7 @@ func signVoluntaryExit(
 		SigningRoot:     exitRoot[:],
 		SignatureDomain: domain.SignatureDomain,
 		Object:          &validatorpb.SignRequest_Exit{Exit: exit},
+		SigningSlot:     params.BeaconConfig().SlotsPerEpoch.Mul(uint64(exit.Epoch)),
 	})
 	if err != nil {
 		return nil, errors.Wrap(err, signExitErr)
@@ -285,9 +293,17 @@ func signVoluntaryExit(
 	return sig.Marshal(), nil
 }
 
-// Gets the graffiti from cli or file for the validator public key.
+// Gets the graffiti from proposer settings, cli or file for the validator public key.
 func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, error) {
-	// When specified, default graffiti from the command line takes the first priority.
+	// When specified, graffiti from the proposer settings takes the first priority,
+	// as the settings can be changed without restarting the validator.
+	if v.proposerSettings != nil {
+		if g := v.proposerSettings.Settings(pubKey).Graffiti; len(g) != 0 {
+			return g, nil
+		}
+	}
+
+	// When specified, default graffiti from the command line takes the second priority.
 	if len(v.graffiti) != 0 {
 		return v.graffiti, nil
 	}
@@ -296,7 +312,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, e
 		return nil, errors.New("graffitiStruct can't be nil")
 	}
 
-	// When specified, individual validator specified graffiti takes the second priority.
+	// When specified, individual validator specified graffiti takes the third priority.
 	idx, err := v.validatorClient.ValidatorIndex(ctx, &ethpb.ValidatorIndexRequest{PublicKey: pubKey[:]})
 	if err != nil {
 		return []byte{}, err
@@ -306,7 +322,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, e
 		return []byte(g), nil
 	}
 
-	// When specified, a graffiti from the ordered list in the file take third priority.
+	// When specified, a graffiti from the ordered list in the file take fourth priority.
 	if v.graffitiOrderedIndex < uint64(len(v.graffitiStruct.Ordered)) {
 		graffiti := v.graffitiStruct.Ordered[v.graffitiOrderedIndex]
 		v.graffitiOrderedIndex = v.graffitiOrderedIndex + 1
@@ -317,7 +333,7 @@ func (v *validator) getGraffiti(ctx context.Context, pubKey [48]byte) ([]byte, e
 		return []byte(graffiti), nil
 	}
 
-	// When specified, a graffiti from the random list in the file take fourth priority.
+	// When specified, a graffiti from the random list in the file take fifth priority.
 	if len(v.graffitiStruct.Random) != 0 {
 		r := rand.NewGenerator()
 		r.Seed(time.Now().Unix())
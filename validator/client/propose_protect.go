@@ -21,6 +21,38 @@ func (v *validator) preBlockSignValidations(
 ) error {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 
+	// The block is checked and saved holding the lock of the public key, so that no other
+	// validator client sharing the database can sign a conflicting block before it is saved
+	// after signing.
+	if err := v.db.WithPublicKeyLock(ctx, pubKey, func(ctx context.Context) error {
+		return v.localBlockSignValidations(ctx, pubKey, block, signingRoot)
+	}); err != nil {
+		return err
+	}
+
+	if featureconfig.Get().SlasherProtection && v.protector != nil {
+		blockHdr, err := blockutil.BeaconBlockHeaderFromBlockInterface(block)
+		if err != nil {
+			return errors.Wrap(err, "failed to get block header from block")
+		}
+		if !v.protector.CheckBlockSafety(ctx, blockHdr) {
+			if v.emitAccountMetrics {
+				ValidatorProposeFailVecSlasher.WithLabelValues(fmtKey).Inc()
+			}
+			return errors.New(failedPreBlockSignExternalErr)
+		}
+	}
+
+	return nil
+}
+
+// Checks the block against the proposal history of the public key in our DB,
+// and saves it if it is not slashable.
+func (v *validator) localBlockSignValidations(
+	ctx context.Context, pubKey [48]byte, block block.BeaconBlock, signingRoot [32]byte,
+) error {
+	fmtKey := fmt.Sprintf("%#x", pubKey[:])
+
 	prevSigningRoot, proposalAtSlotExists, err := v.db.ProposalHistoryForSlot(ctx, pubKey, block.Slot())
 	if err != nil {
 		if v.emitAccountMetrics {
@@ -58,27 +90,21 @@ func (v *validator) preBlockSignValidations(
 		)
 	}
 
-	if featureconfig.Get().SlasherProtection && v.protector != nil {
-		blockHdr, err := blockutil.BeaconBlockHeaderFromBlockInterface(block)
-		if err != nil {
-			return errors.Wrap(err, "failed to get block header from block")
-		}
-		if !v.protector.CheckBlockSafety(ctx, blockHdr) {
-			if v.emitAccountMetrics {
-				ValidatorProposeFailVecSlasher.WithLabelValues(fmtKey).Inc()
-			}
-			return errors.New(failedPreBlockSignExternalErr)
+	if err := v.db.SaveProposalHistoryForSlot(ctx, pubKey, block.Slot(), signingRoot[:]); err != nil {
+		if v.emitAccountMetrics {
+			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
 		}
+		return errors.Wrap(err, "failed to save proposal history")
 	}
-
 	return nil
 }
 
+// Commits the signed block to the remote slashing protection, if enabled. The proposal history is
+// not saved again here, as it was saved holding the lock of the public key before signing.
 func (v *validator) postBlockSignUpdate(
 	ctx context.Context,
 	pubKey [48]byte,
 	block block.SignedBeaconBlock,
-	signingRoot [32]byte,
 ) error {
 	fmtKey := fmt.Sprintf("%#x", pubKey[:])
 	if featureconfig.Get().SlasherProtection && v.protector != nil {
@@ -97,12 +123,6 @@ func (v *validator) postBlockSignUpdate(
 			return fmt.Errorf(failedPostBlockSignErr)
 		}
 	}
-	if err := v.db.SaveProposalHistoryForSlot(ctx, pubKey, block.Block().Slot(), signingRoot[:]); err != nil {
-		if v.emitAccountMetrics {
-			ValidatorProposeFailVec.WithLabelValues(fmtKey).Inc()
-		}
-		return errors.Wrap(err, "failed to save updated proposal history")
-	}
 	return nil
 }
 
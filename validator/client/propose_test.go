@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/hex"
 	"errors"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -22,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	testing2 "github.com/prysmaticlabs/prysm/validator/db/testing"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	logTest "github.com/sirupsen/logrus/hooks/test"
 	grpc "google.golang.org/grpc"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -753,6 +755,49 @@ func TestGetGraffiti_Ok(t *testing.T) {
 	}
 }
 
+func TestGetGraffiti_ProposerSettings(t *testing.T) {
+	pubKey := [48]byte{'a'}
+	settings, err := proposer.NewStore(filepath.Join(t.TempDir(), "proposer-settings.yaml"))
+	require.NoError(t, err)
+	v := &validator{
+		graffiti:         []byte{'b'},
+		graffitiStruct:   &graffiti.Graffiti{},
+		proposerSettings: settings,
+	}
+
+	// Without proposer settings graffiti, the cli graffiti is used.
+	got, err := v.getGraffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'b'}, got)
+
+	require.NoError(t, settings.SetDefaultOption(&proposer.Option{Graffiti: "c"}))
+	got, err = v.getGraffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'c'}, got)
+
+	require.NoError(t, settings.SetOption(pubKey, &proposer.Option{Graffiti: "d"}))
+	got, err = v.getGraffiti(context.Background(), pubKey)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{'d'}, got)
+}
+
+func TestProposeBlock_DisabledInProposerSettings(t *testing.T) {
+	hook := logTest.NewGlobal()
+	validator, _, validatorKey, finish := setup(t)
+	defer finish()
+	pubKey := [48]byte{}
+	copy(pubKey[:], validatorKey.PublicKey().Marshal())
+	settings, err := proposer.NewStore(filepath.Join(t.TempDir(), "proposer-settings.yaml"))
+	require.NoError(t, err)
+	disabled := false
+	require.NoError(t, settings.SetOption(pubKey, &proposer.Option{Enabled: &disabled}))
+	validator.proposerSettings = settings
+
+	// No calls to the beacon node are expected.
+	validator.ProposeBlock(context.Background(), 1, pubKey)
+	require.LogsContain(t, hook, "Block proposals are disabled for validator in proposer settings")
+}
+
 func TestGetGraffitiOrdered_Ok(t *testing.T) {
 	pubKey := [48]byte{'a'}
 	valDB := testing2.SetupDB(t, [][48]byte{pubKey})
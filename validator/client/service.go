@@ -17,15 +17,18 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/bytesutil"
 	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/grpcutils"
 	"github.com/prysmaticlabs/prysm/shared/params"
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	beaconapi "github.com/prysmaticlabs/prysm/validator/client/beacon-api"
 	"github.com/prysmaticlabs/prysm/validator/client/iface"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	slashingiface "github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -52,7 +55,11 @@ type ValidatorService struct {
 	emitAccountMetrics    bool
 	logValidatorBalances  bool
 	logDutyCountDown      bool
-	conn                  *grpc.ClientConn
+	broadcastSignedDuties bool
+	failover              *beaconNodeFailover
+	validatorClient       iface.ValidatorClient
+	beaconClient          iface.BeaconChainClient
+	nodeClient            iface.NodeClient
 	grpcRetryDelay        time.Duration
 	grpcRetries           uint
 	maxCallRecvMsgSize    int
@@ -62,6 +69,7 @@ type ValidatorService struct {
 	dataDir               string
 	withCert              string
 	endpoint              string
+	beaconApiEndpoint     string
 	validator             iface.Validator
 	protector             slashingiface.Protector
 	ctx                   context.Context
@@ -69,6 +77,7 @@ type ValidatorService struct {
 	grpcHeaders           []string
 	graffiti              []byte
 	graffitiStruct        *graffiti.Graffiti
+	proposerSettings      *proposer.Store
 }
 
 // Config for the validator service.
@@ -77,12 +86,14 @@ type Config struct {
 	LogValidatorBalances       bool
 	EmitAccountMetrics         bool
 	LogDutyCountDown           bool
+	BroadcastSignedDuties      bool
 	WalletInitializedFeed      *event.Feed
 	GrpcRetriesFlag            uint
 	GrpcRetryDelay             time.Duration
 	GrpcMaxCallRecvMsgSizeFlag int
 	Protector                  slashingiface.Protector
 	Endpoint                   string
+	BeaconApiEndpoint          string
 	Validator                  iface.Validator
 	ValDB                      db.Database
 	KeyManager                 keymanager.IKeymanager
@@ -91,16 +102,24 @@ type Config struct {
 	DataDir                    string
 	GrpcHeadersFlag            string
 	GraffitiStruct             *graffiti.Graffiti
+	ProposerSettings           *proposer.Store
 }
 
 // NewValidatorService creates a new validator service for the service
 // registry.
 func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, error) {
+	// The beacon node REST API has no equivalent of the doppelganger check, which would otherwise
+	// fail every time the validator client starts.
+	if cfg.BeaconApiEndpoint != "" && featureconfig.Get().EnableDoppelGanger {
+		return nil, errors.New("doppelganger protection is not supported with the beacon node REST API, " +
+			"remove --enable-doppelganger or use the gRPC endpoint of the beacon node")
+	}
 	ctx, cancel := context.WithCancel(ctx)
 	return &ValidatorService{
 		ctx:                   ctx,
 		cancel:                cancel,
 		endpoint:              cfg.Endpoint,
+		beaconApiEndpoint:     cfg.BeaconApiEndpoint,
 		withCert:              cfg.CertFlag,
 		dataDir:               cfg.DataDir,
 		graffiti:              []byte(cfg.GraffitiFlag),
@@ -117,35 +136,49 @@ func NewValidatorService(ctx context.Context, cfg *Config) (*ValidatorService, e
 		walletInitializedFeed: cfg.WalletInitializedFeed,
 		useWeb:                cfg.UseWeb,
 		graffitiStruct:        cfg.GraffitiStruct,
+		proposerSettings:      cfg.ProposerSettings,
 		logDutyCountDown:      cfg.LogDutyCountDown,
+		broadcastSignedDuties: cfg.BroadcastSignedDuties,
 	}, nil
 }
 
 // Start the validator service. Launches the main go routine for the validator
 // client.
 func (v *ValidatorService) Start() {
-	dialOpts := ConstructDialOptions(
-		v.maxCallRecvMsgSize,
-		v.withCert,
-		v.grpcRetries,
-		v.grpcRetryDelay,
-	)
-	if dialOpts == nil {
-		return
-	}
-
-	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
-
-	conn, err := grpc.DialContext(v.ctx, v.endpoint, dialOpts...)
-	if err != nil {
-		log.Errorf("Could not dial endpoint: %s, %v", v.endpoint, err)
-		return
+	var nodes []*beaconNode
+	if v.beaconApiEndpoint != "" {
+		for _, endpoint := range strings.Split(v.beaconApiEndpoint, ",") {
+			c := beaconapi.NewClient(endpoint)
+			nodes = append(nodes, &beaconNode{
+				endpoint:        endpoint,
+				validatorClient: c,
+				beaconClient:    c,
+				nodeClient:      c,
+			})
+		}
+		log.WithField("endpoint", v.beaconApiEndpoint).Info("Using the beacon node REST API")
+		if v.logValidatorBalances {
+			log.Warn("Validator balances and vote performance are not available from the beacon node REST API, " +
+				"disabling their logging")
+			v.logValidatorBalances = false
+		}
+	} else {
+		nodes = v.dialBeaconNodes()
+		if nodes == nil {
+			return
+		}
 	}
-	if v.withCert != "" {
-		log.Info("Established secure gRPC connection")
+	if v.broadcastSignedDuties {
+		if len(nodes) > 1 {
+			log.WithField("beaconNodes", len(nodes)).Info("Broadcasting signed duties to all the beacon nodes")
+		} else {
+			log.Warn("Broadcasting signed duties has no effect with a single beacon node")
+		}
 	}
+	v.failover = newBeaconNodeFailover(v.ctx, nodes, v.broadcastSignedDuties)
+	v.validatorClient, v.beaconClient, v.nodeClient = v.failover, v.failover, v.failover
+	go v.failover.monitorHealth()
 
-	v.conn = conn
 	cache, err := ristretto.NewCache(&ristretto.Config{
 		NumCounters: 1920, // number of keys to track.
 		MaxCost:     192,  // maximum cost of cache, 1 item = 1 cost.
@@ -179,9 +212,9 @@ func (v *ValidatorService) Start() {
 
 	v.validator = &validator{
 		db:                             v.db,
-		validatorClient:                ethpb.NewBeaconNodeValidatorClient(v.conn),
-		beaconClient:                   ethpb.NewBeaconChainClient(v.conn),
-		node:                           ethpb.NewNodeClient(v.conn),
+		validatorClient:                v.validatorClient,
+		beaconClient:                   v.beaconClient,
+		node:                           v.nodeClient,
 		keyManager:                     v.keyManager,
 		graffiti:                       v.graffiti,
 		logValidatorBalances:           v.logValidatorBalances,
@@ -198,31 +231,90 @@ func (v *ValidatorService) Start() {
 		blockFeed:                      new(event.Feed),
 		graffitiStruct:                 v.graffitiStruct,
 		graffitiOrderedIndex:           graffitiOrderedIndex,
+		proposerSettings:               v.proposerSettings,
 		eipImportBlacklistedPublicKeys: slashablePublicKeys,
 		logDutyCountDown:               v.logDutyCountDown,
 	}
 	go run(v.ctx, v.validator)
 	go v.recheckKeys(v.ctx)
+	if v.proposerSettings != nil {
+		go v.proposerSettings.Watch(v.ctx)
+	}
+}
+
+// dialBeaconNodes connects to the gRPC API of each of the configured beacon nodes. It returns nil
+// if a connection could not be set up.
+func (v *ValidatorService) dialBeaconNodes() []*beaconNode {
+	dialOpts := ConstructDialOptions(
+		v.maxCallRecvMsgSize,
+		v.withCert,
+		v.grpcRetries,
+		v.grpcRetryDelay,
+	)
+	if dialOpts == nil {
+		return nil
+	}
+
+	v.ctx = grpcutils.AppendHeaders(v.ctx, v.grpcHeaders)
+
+	var nodes []*beaconNode
+	for _, endpoint := range strings.Split(v.endpoint, ",") {
+		conn, err := grpc.DialContext(v.ctx, endpoint, dialOpts...)
+		if err != nil {
+			log.Errorf("Could not dial endpoint: %s, %v", endpoint, err)
+			for _, n := range nodes {
+				if err := n.conn.Close(); err != nil {
+					log.WithError(err).Error("Could not close connection to beacon node")
+				}
+			}
+			return nil
+		}
+		nodes = append(nodes, &beaconNode{
+			endpoint:        endpoint,
+			conn:            conn,
+			validatorClient: ethpb.NewBeaconNodeValidatorClient(conn),
+			beaconClient:    ethpb.NewBeaconChainClient(conn),
+			nodeClient:      ethpb.NewNodeClient(conn),
+		})
+	}
+	if v.withCert != "" {
+		log.Info("Established secure gRPC connection")
+	}
+	return nodes
 }
 
 // Stop the validator service.
 func (v *ValidatorService) Stop() error {
 	v.cancel()
 	log.Info("Stopping service")
-	if v.conn != nil {
-		return v.conn.Close()
+	if v.failover != nil {
+		return v.failover.close()
 	}
 	return nil
 }
 
 // Status of the validator service.
 func (v *ValidatorService) Status() error {
-	if v.conn == nil {
-		return errors.New("no connection to beacon RPC")
+	if v.nodeClient == nil {
+		return errors.New("no connection to beacon node")
 	}
 	return nil
 }
 
+// BeaconNodeHealth returns the health of the configured beacon nodes, or nil if the service is not
+// connected to the beacon nodes.
+func (v *ValidatorService) BeaconNodeHealth() []*BeaconNodeHealth {
+	if v.failover == nil {
+		return nil
+	}
+	return v.failover.BeaconNodeHealth()
+}
+
+// ProposerSettings returns the proposer settings of the validator service.
+func (v *ValidatorService) ProposerSettings() *proposer.Store {
+	return v.proposerSettings
+}
+
 func (v *ValidatorService) recheckKeys(ctx context.Context) {
 	var validatingKeys [][48]byte
 	var err error
@@ -311,8 +403,7 @@ func ConstructDialOptions(
 
 // Syncing returns whether or not the beacon node is currently synchronizing the chain.
 func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	resp, err := nc.GetSyncStatus(ctx, &emptypb.Empty{})
+	resp, err := v.nodeClient.GetSyncStatus(ctx, &emptypb.Empty{})
 	if err != nil {
 		return false, err
 	}
@@ -322,8 +413,7 @@ func (v *ValidatorService) Syncing(ctx context.Context) (bool, error) {
 // GenesisInfo queries the beacon node for the chain genesis info containing
 // the genesis time along with the validator deposit contract address.
 func (v *ValidatorService) GenesisInfo(ctx context.Context) (*ethpb.Genesis, error) {
-	nc := ethpb.NewNodeClient(v.conn)
-	return nc.GetGenesis(ctx, &emptypb.Empty{})
+	return v.nodeClient.GetGenesis(ctx, &emptypb.Empty{})
 }
 
 // to accounts changes in the keymanager, then updates those keys'
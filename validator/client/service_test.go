@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/shared"
+	"github.com/prysmaticlabs/prysm/shared/featureconfig"
 	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
 	logTest "github.com/sirupsen/logrus/hooks/test"
@@ -104,3 +105,14 @@ func TestStart_GrpcHeaders(t *testing.T) {
 		}
 	}
 }
+
+func TestNewValidatorService_DoppelGangerWithBeaconAPI(t *testing.T) {
+	resetCfg := featureconfig.InitWithReset(&featureconfig.Flags{EnableDoppelGanger: true})
+	defer resetCfg()
+
+	_, err := NewValidatorService(context.Background(), &Config{BeaconApiEndpoint: "http://localhost:3500"})
+	assert.ErrorContains(t, "doppelganger protection is not supported with the beacon node REST API", err)
+
+	_, err = NewValidatorService(context.Background(), &Config{Endpoint: "localhost:4000"})
+	require.NoError(t, err)
+}
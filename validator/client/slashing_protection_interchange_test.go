@@ -139,7 +139,7 @@ func TestEIP3076SpecTests(t *testing.T) {
 
 					// Only proceed post update if pre validation did not error.
 					if err == nil {
-						err = validator.postBlockSignUpdate(context.Background(), pk, wrapper.WrappedPhase0SignedBeaconBlock(b), signingRoot)
+						err = validator.postBlockSignUpdate(context.Background(), pk, wrapper.WrappedPhase0SignedBeaconBlock(b))
 						if sb.ShouldSucceed {
 							require.NoError(t, err)
 						} else {
@@ -9,10 +9,12 @@ import (
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/shared/backuputil"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/db/postgres"
 )
 
-// Ensure the kv store implements the interface.
+// Ensure the kv and postgres stores implement the interface.
 var _ = ValidatorDB(&kv.Store{})
+var _ = ValidatorDB(&postgres.Store{})
 
 // ValidatorDB defines the necessary methods for a Prysm validator DB.
 type ValidatorDB interface {
@@ -36,6 +38,11 @@ type ValidatorDB interface {
 	SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]byte, slot types.Slot, signingRoot []byte) error
 	ProposedPublicKeys(ctx context.Context) ([][48]byte, error)
 
+	// WithPublicKeyLock runs f holding the lock of the slashing protection history of
+	// the public key, so that checking and saving a signing is atomic. The database
+	// methods called with the context given to f run within the lock.
+	WithPublicKeyLock(ctx context.Context, pubKey [48]byte, f func(ctx context.Context) error) error
+
 	// Attester protection related methods.
 	// Methods to store and read blacklisted public keys from EIP-3076
 	// slashing protection imports.
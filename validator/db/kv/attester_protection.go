@@ -102,30 +102,43 @@ func (s *Store) AttestationHistoryForPubKey(ctx context.Context, pubKey [48]byte
 		if pkBucket == nil {
 			return nil
 		}
-		signingRootsBucket := pkBucket.Bucket(attestationSigningRootsBucket)
-		sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
+		var err error
+		records, err = attestationRecords(pkBucket, pubKey)
+		return err
+	})
+	return records, err
+}
 
-		return sourceEpochsBucket.ForEach(func(sourceBytes, targetEpochsList []byte) error {
-			targetEpochs := make([]types.Epoch, 0)
-			for i := 0; i < len(targetEpochsList); i += 8 {
-				epoch := bytesutil.BytesToEpochBigEndian(targetEpochsList[i : i+8])
-				targetEpochs = append(targetEpochs, epoch)
+// Reads the attestation records in the bucket of a validator public key.
+func attestationRecords(pkBucket *bolt.Bucket, pubKey [48]byte) ([]*AttestationRecord, error) {
+	records := make([]*AttestationRecord, 0)
+	signingRootsBucket := pkBucket.Bucket(attestationSigningRootsBucket)
+	sourceEpochsBucket := pkBucket.Bucket(attestationSourceEpochsBucket)
+	if sourceEpochsBucket == nil {
+		return records, nil
+	}
+	err := sourceEpochsBucket.ForEach(func(sourceBytes, targetEpochsList []byte) error {
+		targetEpochs := make([]types.Epoch, 0)
+		for i := 0; i < len(targetEpochsList); i += 8 {
+			epoch := bytesutil.BytesToEpochBigEndian(targetEpochsList[i : i+8])
+			targetEpochs = append(targetEpochs, epoch)
+		}
+		sourceEpoch := bytesutil.BytesToEpochBigEndian(sourceBytes)
+		for _, targetEpoch := range targetEpochs {
+			record := &AttestationRecord{
+				PubKey: pubKey,
+				Source: sourceEpoch,
+				Target: targetEpoch,
 			}
-			sourceEpoch := bytesutil.BytesToEpochBigEndian(sourceBytes)
-			for _, targetEpoch := range targetEpochs {
-				record := &AttestationRecord{
-					PubKey: pubKey,
-					Source: sourceEpoch,
-					Target: targetEpoch,
-				}
+			if signingRootsBucket != nil {
 				signingRoot := signingRootsBucket.Get(bytesutil.EpochToBytesBigEndian(targetEpoch))
 				if signingRoot != nil {
 					copy(record.SigningRoot[:], signingRoot)
 				}
-				records = append(records, record)
 			}
-			return nil
-		})
+			records = append(records, record)
+		}
+		return nil
 	})
 	return records, err
 }
@@ -398,6 +411,11 @@ func (s *Store) flushAttestationRecords(ctx context.Context, records []*Attestat
 func (s *Store) saveAttestationRecords(ctx context.Context, atts []*AttestationRecord) error {
 	ctx, span := trace.StartSpan(ctx, "Validator.saveAttestationRecords")
 	defer span.End()
+	if s.minimalSlashingProtection {
+		return s.update(func(tx *bolt.Tx) error {
+			return saveAttestationsWatermarks(tx, atts)
+		})
+	}
 	return s.update(func(tx *bolt.Tx) error {
 		// Initialize buckets for the lowest target and source epochs.
 		lowestSourceBucket, err := tx.CreateBucketIfNotExists(lowestSignedSourceBucket)
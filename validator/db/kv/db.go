@@ -54,6 +54,8 @@ var blockedBuckets = [][]byte{
 type Config struct {
 	PubKeys         [][48]byte
 	InitialMMapSize int
+	// MinimalSlashingProtection keeps only the watermarks of the signing history of each public key.
+	MinimalSlashingProtection bool
 }
 
 // Store defines an implementation of the Prysm Database interface
@@ -65,6 +67,7 @@ type Store struct {
 	batchedAttestationsChan            chan *AttestationRecord
 	batchAttestationsFlushedFeed       *event.Feed
 	batchedAttestationsFlushInProgress abool.AtomicBool
+	minimalSlashingProtection          bool
 }
 
 // Close closes the underlying boltdb database.
@@ -135,6 +138,9 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		batchedAttestationsChan:      make(chan *AttestationRecord, attestationBatchCapacity),
 		batchAttestationsFlushedFeed: new(event.Feed),
 	}
+	if config != nil {
+		kv.minimalSlashingProtection = config.MinimalSlashingProtection
+	}
 
 	if err := kv.db.Update(func(tx *bolt.Tx) error {
 		return createBuckets(
@@ -162,7 +168,13 @@ func NewKVStore(ctx context.Context, dirPath string, config *Config) (*Store, er
 		}
 	}
 
-	if featureconfig.Get().EnableSlashingProtectionPruning {
+	if kv.minimalSlashingProtection {
+		// Collapse the signing history of the public keys into their watermarks,
+		// in case the database was used in complete mode.
+		if err := kv.collapseSigningHistories(ctx); err != nil {
+			return nil, errors.Wrap(err, "could not collapse signing histories into watermarks")
+		}
+	} else if featureconfig.Get().EnableSlashingProtectionPruning {
 		// Prune attesting records older than the current weak subjectivity period.
 		if err := kv.PruneAttestations(ctx); err != nil {
 			return nil, errors.Wrap(err, "could not prune old attestations from DB")
@@ -203,3 +215,9 @@ func (s *Store) Size() (int64, error) {
 func createBoltCollector(db *bolt.DB) prometheus.Collector {
 	return prombolt.New("boltDB", db, blockedBuckets...)
 }
+
+// WithPublicKeyLock runs f. The boltDB database can only be opened by a single
+// process, so no other validator client can sign for the public key meanwhile.
+func (s *Store) WithPublicKeyLock(ctx context.Context, _ [48]byte, f func(ctx context.Context) error) error {
+	return f(ctx)
+}
@@ -0,0 +1,270 @@
+package kv
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	bolt "go.etcd.io/bbolt"
+	"go.opencensus.io/trace"
+)
+
+// In minimal slashing protection mode, only the watermarks of the signing history of each
+// validator public key are kept, as in the minimal interchange files of EIP-3076: its highest
+// signed source and target epochs, and its highest signed proposal slot. They are stored as the
+// only attestation record and proposal of the public key, and as its lowest signed epochs and
+// slot, so that the validator client refuses to sign anything at or below them. A database
+// in minimal mode can be used in complete mode again, from the watermarks on.
+
+// MinimalSlashingProtection returns whether only the watermarks of the signing histories are kept.
+func (s *Store) MinimalSlashingProtection() bool {
+	return s.minimalSlashingProtection
+}
+
+// SaveSigningWatermarks collapses the attesting and proposal histories of validator public keys
+// into their watermarks, and saves them all in a single transaction. It is only supported in
+// minimal slashing protection mode, in which the rest of the histories would be discarded.
+func (s *Store) SaveSigningWatermarks(
+	ctx context.Context,
+	attestingHistoryByPubKey map[[48]byte][]*AttestationRecord,
+	proposalHistoryByPubKey map[[48]byte]ProposalHistoryForPubkey,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveSigningWatermarks")
+	defer span.End()
+	if !s.minimalSlashingProtection {
+		return errors.New("signing watermarks can only be saved in minimal slashing protection mode")
+	}
+	return s.update(func(tx *bolt.Tx) error {
+		for pubKey, records := range attestingHistoryByPubKey {
+			if err := saveAttestationWatermark(tx, pubKey, records); err != nil {
+				return err
+			}
+		}
+		for pubKey, history := range proposalHistoryByPubKey {
+			proposals := make([]*Proposal, len(history.Proposals))
+			for i := range history.Proposals {
+				proposals[i] = &history.Proposals[i]
+			}
+			if err := saveProposalWatermark(tx, pubKey, proposals); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Collapses the signing histories of all the public keys in the database into their watermarks.
+func (s *Store) collapseSigningHistories(ctx context.Context) error {
+	_, span := trace.StartSpan(ctx, "Validator.collapseSigningHistories")
+	defer span.End()
+	return s.update(func(tx *bolt.Tx) error {
+		// Buckets cannot be modified while iterating over them, so the public keys are gathered first.
+		attesterKeys, err := bucketKeys(tx.Bucket(pubKeysBucket))
+		if err != nil {
+			return err
+		}
+		proposerKeys, err := bucketKeys(tx.Bucket(historicProposalsBucket))
+		if err != nil {
+			return err
+		}
+		for _, pubKey := range attesterKeys {
+			if err := saveAttestationWatermark(tx, pubKey, nil); err != nil {
+				return err
+			}
+		}
+		for _, pubKey := range proposerKeys {
+			if err := saveProposalWatermark(tx, pubKey, nil); err != nil {
+				return err
+			}
+		}
+		log.WithField("numPublicKeys", len(attesterKeys)).Debug("Collapsed signing histories into watermarks")
+		return nil
+	})
+}
+
+// Merges attestation records, possibly of several public keys, into their watermarks.
+func saveAttestationsWatermarks(tx *bolt.Tx, records []*AttestationRecord) error {
+	recordsByPubKey := make(map[[48]byte][]*AttestationRecord)
+	for _, record := range records {
+		recordsByPubKey[record.PubKey] = append(recordsByPubKey[record.PubKey], record)
+	}
+	for pubKey, records := range recordsByPubKey {
+		if err := saveAttestationWatermark(tx, pubKey, records); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Merges attestation records of a public key with its attesting history in the database,
+// and replaces its attesting history with the resulting watermark.
+func saveAttestationWatermark(tx *bolt.Tx, pubKey [48]byte, records []*AttestationRecord) error {
+	pkBucket, err := tx.Bucket(pubKeysBucket).CreateBucketIfNotExists(pubKey[:])
+	if err != nil {
+		return errors.Wrap(err, "could not create public key bucket")
+	}
+	existing, err := attestationRecords(pkBucket, pubKey)
+	if err != nil {
+		return err
+	}
+	watermark := attestationWatermark(pubKey, append(existing, records...))
+
+	// The lowest signed epochs are watermarks as well, which may be higher than
+	// the records left in the database if they were pruned.
+	lowestSourceBucket := tx.Bucket(lowestSignedSourceBucket)
+	lowestTargetBucket := tx.Bucket(lowestSignedTargetBucket)
+	if b := lowestSourceBucket.Get(pubKey[:]); len(b) >= 8 {
+		watermark = attestationWatermark(pubKey, []*AttestationRecord{
+			watermark, {PubKey: pubKey, Source: bytesutil.BytesToEpochBigEndian(b)},
+		})
+	}
+	if b := lowestTargetBucket.Get(pubKey[:]); len(b) >= 8 {
+		watermark = attestationWatermark(pubKey, []*AttestationRecord{
+			watermark, {PubKey: pubKey, Target: bytesutil.BytesToEpochBigEndian(b)},
+		})
+	}
+	if watermark == nil {
+		return nil
+	}
+
+	for _, bucket := range [][]byte{
+		attestationSigningRootsBucket, attestationSourceEpochsBucket, attestationTargetEpochsBucket,
+	} {
+		if err := pkBucket.DeleteBucket(bucket); err != nil && !errors.Is(err, bolt.ErrBucketNotFound) {
+			return errors.Wrap(err, "could not delete attesting history")
+		}
+	}
+	signingRootsBucket, err := pkBucket.CreateBucket(attestationSigningRootsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create signing roots bucket")
+	}
+	sourceEpochsBucket, err := pkBucket.CreateBucket(attestationSourceEpochsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create source epochs bucket")
+	}
+	targetEpochsBucket, err := pkBucket.CreateBucket(attestationTargetEpochsBucket)
+	if err != nil {
+		return errors.Wrap(err, "could not create target epochs bucket")
+	}
+	sourceEpochBytes := bytesutil.EpochToBytesBigEndian(watermark.Source)
+	targetEpochBytes := bytesutil.EpochToBytesBigEndian(watermark.Target)
+	if err := signingRootsBucket.Put(targetEpochBytes, watermark.SigningRoot[:]); err != nil {
+		return err
+	}
+	if err := sourceEpochsBucket.Put(sourceEpochBytes, targetEpochBytes); err != nil {
+		return err
+	}
+	if err := targetEpochsBucket.Put(targetEpochBytes, sourceEpochBytes); err != nil {
+		return err
+	}
+	if err := lowestSourceBucket.Put(pubKey[:], sourceEpochBytes); err != nil {
+		return err
+	}
+	return lowestTargetBucket.Put(pubKey[:], targetEpochBytes)
+}
+
+// Returns the highest source and target epochs of attestation records, with the signing root of
+// the record at both of them if there is a single one. It returns nil if there are no records.
+func attestationWatermark(pubKey [48]byte, records []*AttestationRecord) *AttestationRecord {
+	var watermark *AttestationRecord
+	for _, record := range records {
+		if record == nil {
+			continue
+		}
+		switch {
+		case watermark == nil:
+			watermark = &AttestationRecord{}
+			*watermark = *record
+		case record.Source == watermark.Source && record.Target == watermark.Target:
+			if record.SigningRoot != watermark.SigningRoot {
+				watermark.SigningRoot = [32]byte{}
+			}
+		case record.Source <= watermark.Source && record.Target <= watermark.Target:
+			// The record is below the watermark.
+		case record.Source >= watermark.Source && record.Target >= watermark.Target:
+			*watermark = *record
+		default:
+			// The watermark is not an attestation which was signed, so it has no signing root.
+			if record.Source > watermark.Source {
+				watermark.Source = record.Source
+			}
+			if record.Target > watermark.Target {
+				watermark.Target = record.Target
+			}
+			watermark.SigningRoot = [32]byte{}
+		}
+	}
+	if watermark != nil {
+		watermark.PubKey = pubKey
+	}
+	return watermark
+}
+
+// Merges proposals of a public key with its proposal history in the database,
+// and replaces its proposal history with the resulting watermark.
+func saveProposalWatermark(tx *bolt.Tx, pubKey [48]byte, proposals []*Proposal) error {
+	bucket := tx.Bucket(historicProposalsBucket)
+	if valBucket := bucket.Bucket(pubKey[:]); valBucket != nil {
+		if err := valBucket.ForEach(func(slotKey, signingRootBytes []byte) error {
+			proposals = append(proposals, &Proposal{
+				Slot:        bytesutil.BytesToSlotBigEndian(slotKey),
+				SigningRoot: bytesutil.SafeCopyBytes(signingRootBytes),
+			})
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	var watermark *Proposal
+	for _, proposal := range proposals {
+		switch {
+		case watermark == nil || proposal.Slot > watermark.Slot:
+			watermark = proposal
+		case proposal.Slot == watermark.Slot && !bytes.Equal(proposal.SigningRoot, watermark.SigningRoot):
+			watermark = &Proposal{Slot: proposal.Slot}
+		}
+	}
+
+	// The highest signed proposal may be higher than the proposals left in the database if they were pruned.
+	lowestSignedBkt := tx.Bucket(lowestSignedProposalsBucket)
+	highestSignedBkt := tx.Bucket(highestSignedProposalsBucket)
+	if b := highestSignedBkt.Get(pubKey[:]); len(b) >= 8 {
+		if slot := bytesutil.BytesToSlotBigEndian(b); watermark == nil || slot > watermark.Slot {
+			watermark = &Proposal{Slot: slot}
+		}
+	}
+	if watermark == nil {
+		return nil
+	}
+
+	if bucket.Bucket(pubKey[:]) != nil {
+		if err := bucket.DeleteBucket(pubKey[:]); err != nil {
+			return errors.Wrap(err, "could not delete proposal history")
+		}
+	}
+	valBucket, err := bucket.CreateBucket(pubKey[:])
+	if err != nil {
+		return errors.Wrapf(err, "could not create bucket for public key %#x", pubKey)
+	}
+	slotBytes := bytesutil.SlotToBytesBigEndian(watermark.Slot)
+	if err := valBucket.Put(slotBytes, watermark.SigningRoot); err != nil {
+		return err
+	}
+	if err := lowestSignedBkt.Put(pubKey[:], slotBytes); err != nil {
+		return err
+	}
+	return highestSignedBkt.Put(pubKey[:], slotBytes)
+}
+
+func bucketKeys(bucket *bolt.Bucket) ([][48]byte, error) {
+	keys := make([][48]byte, 0)
+	err := bucket.ForEach(func(key, _ []byte) error {
+		var pubKey [48]byte
+		copy(pubKey[:], key)
+		keys = append(keys, pubKey)
+		return nil
+	})
+	return keys, err
+}
@@ -0,0 +1,158 @@
+package kv
+
+import (
+	"context"
+	"testing"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_MinimalSlashingProtection_Attestations(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), &Config{MinimalSlashingProtection: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	pubKey := [48]byte{1}
+
+	require.NoError(t, db.SaveAttestationForPubKey(ctx, pubKey, [32]byte{1}, createAttestation(1, 2)))
+	require.NoError(t, db.SaveAttestationForPubKey(ctx, pubKey, [32]byte{2}, createAttestation(2, 3)))
+	history, err := db.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.DeepEqual(t, &AttestationRecord{PubKey: pubKey, Source: 2, Target: 3, SigningRoot: [32]byte{2}}, history[0])
+
+	// The watermarks are the lowest epochs which can be signed.
+	source, exists, err := db.LowestSignedSourceEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(2), source)
+	target, exists, err := db.LowestSignedTargetEpoch(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Epoch(3), target)
+
+	// Attestations which are not below one another collapse into a watermark without signing root.
+	require.NoError(t, db.SaveAttestationsForPubKey(
+		ctx, pubKey, [][32]byte{{3}, {4}}, []*ethpb.IndexedAttestation{createAttestation(5, 6), createAttestation(4, 7)},
+	))
+	history, err = db.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.DeepEqual(t, &AttestationRecord{PubKey: pubKey, Source: 5, Target: 7}, history[0])
+	slashingKind, err := db.CheckSlashableAttestation(ctx, pubKey, [32]byte{4}, createAttestation(4, 7))
+	assert.ErrorContains(t, "double vote found", err)
+	assert.Equal(t, DoubleVote, slashingKind)
+}
+
+func TestStore_MinimalSlashingProtection_Proposals(t *testing.T) {
+	ctx := context.Background()
+	db, err := NewKVStore(ctx, t.TempDir(), &Config{MinimalSlashingProtection: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	pubKey := [48]byte{1}
+
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{1}))
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 5, []byte{2}))
+	proposals, err := db.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	assert.Equal(t, types.Slot(10), proposals[0].Slot)
+	assert.DeepEqual(t, []byte{1, 31: 0}, proposals[0].SigningRoot)
+	lowest, exists, err := db.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(10), lowest)
+
+	// A conflicting proposal at the watermark keeps the watermark without signing root.
+	require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, 10, []byte{3}))
+	signingRoot, exists, err := db.ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, [32]byte{}, signingRoot)
+}
+
+func TestStore_MinimalSlashingProtection_CollapsesHistory(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	pubKey := [48]byte{1}
+	db, err := NewKVStore(ctx, dir, &Config{})
+	require.NoError(t, err)
+	require.NoError(t, db.SaveAttestationsForPubKey(
+		ctx,
+		pubKey,
+		[][32]byte{{1}, {2}, {3}},
+		[]*ethpb.IndexedAttestation{createAttestation(1, 2), createAttestation(2, 3), createAttestation(3, 4)},
+	))
+	for slot := types.Slot(1); slot <= 3; slot++ {
+		require.NoError(t, db.SaveProposalHistoryForSlot(ctx, pubKey, slot, []byte{byte(slot)}))
+	}
+	err = db.SaveSigningWatermarks(ctx, nil, nil)
+	assert.ErrorContains(t, "only be saved in minimal slashing protection mode", err)
+	require.NoError(t, db.Close())
+
+	db, err = NewKVStore(ctx, dir, &Config{MinimalSlashingProtection: true})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, db.Close())
+	}()
+	history, err := db.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(history))
+	assert.DeepEqual(t, &AttestationRecord{PubKey: pubKey, Source: 3, Target: 4, SigningRoot: [32]byte{3}}, history[0])
+	proposals, err := db.ProposalHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(proposals))
+	assert.Equal(t, types.Slot(3), proposals[0].Slot)
+	attestedKeys, err := db.AttestedPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, [][48]byte{pubKey}, attestedKeys)
+}
+
+func TestAttestationWatermark(t *testing.T) {
+	pubKey := [48]byte{1}
+	tests := []struct {
+		name    string
+		records []*AttestationRecord
+		want    *AttestationRecord
+	}{
+		{
+			name: "no records",
+		},
+		{
+			name: "highest record",
+			records: []*AttestationRecord{
+				{Source: 3, Target: 4, SigningRoot: [32]byte{2}},
+				{Source: 1, Target: 2, SigningRoot: [32]byte{1}},
+			},
+			want: &AttestationRecord{PubKey: pubKey, Source: 3, Target: 4, SigningRoot: [32]byte{2}},
+		},
+		{
+			name: "highest epochs of different records",
+			records: []*AttestationRecord{
+				{Source: 3, Target: 4, SigningRoot: [32]byte{2}},
+				{Source: 1, Target: 5, SigningRoot: [32]byte{1}},
+			},
+			want: &AttestationRecord{PubKey: pubKey, Source: 3, Target: 5},
+		},
+		{
+			name: "conflicting signing roots",
+			records: []*AttestationRecord{
+				{Source: 3, Target: 4, SigningRoot: [32]byte{2}},
+				{Source: 3, Target: 4, SigningRoot: [32]byte{1}},
+			},
+			want: &AttestationRecord{PubKey: pubKey, Source: 3, Target: 4},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.DeepEqual(t, tt.want, attestationWatermark(pubKey, tt.records))
+		})
+	}
+}
@@ -102,6 +102,11 @@ func (s *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]byte,
 	ctx, span := trace.StartSpan(ctx, "Validator.SaveProposalHistoryForEpoch")
 	defer span.End()
 
+	if s.minimalSlashingProtection {
+		return s.update(func(tx *bolt.Tx) error {
+			return saveProposalWatermark(tx, pubKey, []*Proposal{{Slot: slot, SigningRoot: signingRoot}})
+		})
+	}
 	err := s.update(func(tx *bolt.Tx) error {
 		bucket := tx.Bucket(historicProposalsBucket)
 		valBucket, err := bucket.CreateBucketIfNotExists(pubKey[:])
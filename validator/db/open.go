@@ -0,0 +1,47 @@
+package db
+
+import (
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/cmd"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"github.com/prysmaticlabs/prysm/validator/db/postgres"
+	"github.com/urfave/cli/v2"
+)
+
+// Open opens the slashing protection database, which is the PostgreSQL database of the
+// --slashing-protection-postgres-url flag if set, or the validator.db file of the data directory.
+func Open(cliCtx *cli.Context, dataDir string) (Database, error) {
+	if UsesPostgres(cliCtx) {
+		// The PostgreSQL database keeps the whole signing history of every public key.
+		if cliCtx.Bool(flags.MinimalSlashingProtectionFlag.Name) {
+			return nil, fmt.Errorf("--%s cannot be used with --%s",
+				flags.MinimalSlashingProtectionFlag.Name, flags.SlashingProtectionPostgresURLFlag.Name)
+		}
+		valDB, err := postgres.NewPostgresStore(
+			cliCtx.Context, cliCtx.String(flags.SlashingProtectionPostgresURLFlag.Name), &postgres.Config{},
+		)
+		if err != nil {
+			return nil, err
+		}
+		log.WithField("databasePath", valDB.DatabasePath()).Info("Checking DB")
+		return valDB, nil
+	}
+	log.WithField("databasePath", dataDir).Info("Checking DB")
+	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{
+		PubKeys:                   nil,
+		InitialMMapSize:           cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
+		MinimalSlashingProtection: cliCtx.Bool(flags.MinimalSlashingProtectionFlag.Name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return valDB, nil
+}
+
+// UsesPostgres returns true if the slashing protection database is a PostgreSQL database
+// rather than the validator.db file of the data directory.
+func UsesPostgres(cliCtx *cli.Context) bool {
+	return cliCtx.IsSet(flags.SlashingProtectionPostgresURLFlag.Name)
+}
@@ -0,0 +1,23 @@
+package db
+
+import (
+	"flag"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/urfave/cli/v2"
+)
+
+func TestOpen_MinimalSlashingProtectionWithPostgres(t *testing.T) {
+	app := cli.App{}
+	set := flag.NewFlagSet("test", 0)
+	set.Bool(flags.MinimalSlashingProtectionFlag.Name, false, "")
+	set.String(flags.SlashingProtectionPostgresURLFlag.Name, "", "")
+	require.NoError(t, set.Set(flags.MinimalSlashingProtectionFlag.Name, "true"))
+	require.NoError(t, set.Set(flags.SlashingProtectionPostgresURLFlag.Name, "postgres://user@127.0.0.1:1/validator"))
+	cliCtx := cli.NewContext(&app, set, nil)
+
+	_, err := Open(cliCtx, t.TempDir())
+	require.ErrorContains(t, "--minimal-slashing-protection cannot be used with --slashing-protection-postgres-url", err)
+}
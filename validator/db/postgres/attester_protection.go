@@ -0,0 +1,255 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/slashutil"
+	"github.com/prysmaticlabs/prysm/shared/traceutil"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"go.opencensus.io/trace"
+)
+
+var (
+	doubleVoteMessage      = "double vote found, existing attestation at target epoch %d with conflicting signing root %#x"
+	surroundingVoteMessage = "attestation with (source %d, target %d) surrounds another with (source %d, target %d)"
+	surroundedVoteMessage  = "attestation with (source %d, target %d) is surrounded by another with (source %d, target %d)"
+)
+
+const (
+	attestationHistoryQuery = `SELECT source_epoch, target_epoch, signing_root FROM attestations
+		WHERE public_key = $1 ORDER BY source_epoch, target_epoch`
+	signingRootsAtTargetQuery  = `SELECT signing_root FROM attestations WHERE public_key = $1 AND target_epoch = $2`
+	surroundedAttestationQuery = `SELECT source_epoch, target_epoch FROM attestations
+		WHERE public_key = $1 AND source_epoch > $2 AND target_epoch < $3 LIMIT 1`
+	surroundingAttestationQuery = `SELECT source_epoch, target_epoch FROM attestations
+		WHERE public_key = $1 AND source_epoch < $2 AND target_epoch > $3 LIMIT 1`
+	saveAttestationQuery = `INSERT INTO attestations (public_key, target_epoch, source_epoch, signing_root)
+		VALUES ($1, $2, $3, $4) ON CONFLICT (public_key, target_epoch, source_epoch)
+		DO UPDATE SET signing_root = EXCLUDED.signing_root`
+	attestedPublicKeysQuery      = `SELECT DISTINCT public_key FROM attestations`
+	lowestSignedSourceEpochQuery = `SELECT MIN(source_epoch) FROM attestations WHERE public_key = $1`
+	lowestSignedTargetEpochQuery = `SELECT MIN(target_epoch) FROM attestations WHERE public_key = $1`
+)
+
+// AttestationHistoryForPubKey retrieves a list of attestation records for data
+// we have stored in the database for the given validator public key.
+func (s *Store) AttestationHistoryForPubKey(ctx context.Context, pubKey [48]byte) ([]*kv.AttestationRecord, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AttestationHistoryForPubKey")
+	defer span.End()
+	rows, err := s.querier(ctx).QueryContext(ctx, attestationHistoryQuery, pubKey[:])
+	if err != nil {
+		return nil, err
+	}
+	defer closeRows(rows)
+	records := make([]*kv.AttestationRecord, 0)
+	for rows.Next() {
+		var source, target int64
+		var signingRoot []byte
+		if err := rows.Scan(&source, &target, &signingRoot); err != nil {
+			return nil, err
+		}
+		record := &kv.AttestationRecord{
+			PubKey: pubKey,
+			Source: types.Epoch(source),
+			Target: types.Epoch(target),
+		}
+		copy(record.SigningRoot[:], signingRoot)
+		records = append(records, record)
+	}
+	return records, rows.Err()
+}
+
+// CheckSlashableAttestation verifies an incoming attestation is
+// not a double vote for a validator public key nor a surround vote.
+func (s *Store) CheckSlashableAttestation(
+	ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.CheckSlashableAttestation")
+	defer span.End()
+	slashingKind, err := s.checkSlashableAttestation(ctx, pubKey, signingRoot, att)
+	traceutil.AnnotateError(span, err)
+	return slashingKind, err
+}
+
+func (s *Store) checkSlashableAttestation(
+	ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) (kv.SlashingKind, error) {
+	q := s.querier(ctx)
+	source, target := att.Data.Source.Epoch, att.Data.Target.Epoch
+
+	// First we check for double votes.
+	existingSigningRoots, err := s.signingRootsAtTargetEpoch(ctx, q, pubKey, target)
+	if err != nil {
+		return kv.NotSlashable, err
+	}
+	for _, existing := range existingSigningRoots {
+		if slashutil.SigningRootsDiffer(existing, signingRoot) {
+			return kv.DoubleVote, fmt.Errorf(doubleVoteMessage, target, existing)
+		}
+	}
+
+	// Is this attestation surrounding any other?
+	var existingSource, existingTarget int64
+	err = q.QueryRowContext(
+		ctx, surroundedAttestationQuery, pubKey[:], int64(source), int64(target),
+	).Scan(&existingSource, &existingTarget)
+	switch {
+	case err == nil:
+		return kv.SurroundingVote, fmt.Errorf(surroundingVoteMessage, source, target, existingSource, existingTarget)
+	case !errors.Is(err, sql.ErrNoRows):
+		return kv.NotSlashable, err
+	}
+
+	// Is this attestation surrounded by any other?
+	err = q.QueryRowContext(
+		ctx, surroundingAttestationQuery, pubKey[:], int64(source), int64(target),
+	).Scan(&existingSource, &existingTarget)
+	switch {
+	case err == nil:
+		return kv.SurroundedVote, fmt.Errorf(surroundedVoteMessage, source, target, existingSource, existingTarget)
+	case !errors.Is(err, sql.ErrNoRows):
+		return kv.NotSlashable, err
+	}
+	return kv.NotSlashable, nil
+}
+
+// SaveAttestationsForPubKey stores a batch of attestations all at once.
+func (s *Store) SaveAttestationsForPubKey(
+	ctx context.Context, pubKey [48]byte, signingRoots [][32]byte, atts []*ethpb.IndexedAttestation,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAttestationsForPubKey")
+	defer span.End()
+	if len(signingRoots) != len(atts) {
+		return fmt.Errorf(
+			"number of signing roots %d does not match number of attestations %d",
+			len(signingRoots),
+			len(atts),
+		)
+	}
+	return s.update(ctx, func(q querier) error {
+		if _, err := q.ExecContext(ctx, insertValidatorQuery, pubKey[:]); err != nil {
+			return errors.Wrapf(err, "could not save public key %#x", pubKey)
+		}
+		for i, att := range atts {
+			if _, err := q.ExecContext(
+				ctx,
+				saveAttestationQuery,
+				pubKey[:],
+				int64(att.Data.Target.Epoch),
+				int64(att.Data.Source.Epoch),
+				signingRoots[i][:],
+			); err != nil {
+				return errors.Wrapf(err, "could not save attestation for target epoch %d", att.Data.Target.Epoch)
+			}
+		}
+		return nil
+	})
+}
+
+// SaveAttestationForPubKey saves an attestation for a validator public
+// key for local validator slashing protection.
+func (s *Store) SaveAttestationForPubKey(
+	ctx context.Context, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation,
+) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveAttestationForPubKey")
+	defer span.End()
+	return s.SaveAttestationsForPubKey(ctx, pubKey, [][32]byte{signingRoot}, []*ethpb.IndexedAttestation{att})
+}
+
+// AttestedPublicKeys retrieves all public keys that have attested.
+func (s *Store) AttestedPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.AttestedPublicKeys")
+	defer span.End()
+	return s.publicKeys(ctx, attestedPublicKeysQuery)
+}
+
+// SigningRootAtTargetEpoch checks for an existing signing root at a specified
+// target epoch for a given validator public key.
+func (s *Store) SigningRootAtTargetEpoch(ctx context.Context, pubKey [48]byte, target types.Epoch) ([32]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.SigningRootAtTargetEpoch")
+	defer span.End()
+	signingRoots, err := s.signingRootsAtTargetEpoch(ctx, s.querier(ctx), pubKey, target)
+	if err != nil || len(signingRoots) == 0 {
+		return [32]byte{}, err
+	}
+	return signingRoots[0], nil
+}
+
+func (s *Store) signingRootsAtTargetEpoch(
+	ctx context.Context, q querier, pubKey [48]byte, target types.Epoch,
+) ([][32]byte, error) {
+	rows, err := q.QueryContext(ctx, signingRootsAtTargetQuery, pubKey[:], int64(target))
+	if err != nil {
+		return nil, err
+	}
+	defer closeRows(rows)
+	var signingRoots [][32]byte
+	for rows.Next() {
+		var signingRootBytes []byte
+		if err := rows.Scan(&signingRootBytes); err != nil {
+			return nil, err
+		}
+		var signingRoot [32]byte
+		copy(signingRoot[:], signingRootBytes)
+		signingRoots = append(signingRoots, signingRoot)
+	}
+	return signingRoots, rows.Err()
+}
+
+// LowestSignedSourceEpoch returns the lowest signed source epoch for a validator public key.
+// If no data exists, returning 0 is a sensible default.
+func (s *Store) LowestSignedSourceEpoch(ctx context.Context, publicKey [48]byte) (types.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedSourceEpoch")
+	defer span.End()
+	epoch, exists, err := s.queryUint64(ctx, lowestSignedSourceEpochQuery, publicKey[:])
+	return types.Epoch(epoch), exists, err
+}
+
+// LowestSignedTargetEpoch returns the lowest signed target epoch for a validator public key.
+// If no data exists, returning 0 is a sensible default.
+func (s *Store) LowestSignedTargetEpoch(ctx context.Context, publicKey [48]byte) (types.Epoch, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedTargetEpoch")
+	defer span.End()
+	epoch, exists, err := s.queryUint64(ctx, lowestSignedTargetEpochQuery, publicKey[:])
+	return types.Epoch(epoch), exists, err
+}
+
+// queryUint64 runs a query returning a single nullable integer, and
+// returns whether the integer exists.
+func (s *Store) queryUint64(ctx context.Context, query string, args ...interface{}) (uint64, bool, error) {
+	var value sql.NullInt64
+	if err := s.querier(ctx).QueryRowContext(ctx, query, args...).Scan(&value); err != nil {
+		return 0, false, err
+	}
+	return uint64(value.Int64), value.Valid, nil
+}
+
+func (s *Store) publicKeys(ctx context.Context, query string) ([][48]byte, error) {
+	rows, err := s.querier(ctx).QueryContext(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	defer closeRows(rows)
+	publicKeys := make([][48]byte, 0)
+	for rows.Next() {
+		var pubKeyBytes []byte
+		if err := rows.Scan(&pubKeyBytes); err != nil {
+			return nil, err
+		}
+		var pubKey [48]byte
+		copy(pubKey[:], pubKeyBytes)
+		publicKeys = append(publicKeys, pubKey)
+	}
+	return publicKeys, rows.Err()
+}
+
+func closeRows(rows *sql.Rows) {
+	if err := rows.Close(); err != nil {
+		log.WithError(err).Error("Could not close rows")
+	}
+}
@@ -0,0 +1,184 @@
+// Package postgres defines a slashing protection backend for the validator service
+// which is stored in a PostgreSQL database, and can be shared by several validator clients.
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"net/url"
+
+	// Registers the PostgreSQL driver of database/sql.
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.opencensus.io/trace"
+)
+
+// Config represents store's config object.
+type Config struct {
+	PubKeys [][48]byte
+}
+
+// Store defines an implementation of the Prysm validator database interface
+// using PostgreSQL as the underlying relational database. Checking and saving
+// the signing history of a public key is made atomic across all the validator
+// clients sharing the database by locking the row of the public key.
+type Store struct {
+	db           *sql.DB
+	databasePath string
+}
+
+// querier is implemented by both a database and a transaction, so that the methods of the
+// store run within the transaction holding the lock of a public key when there is one.
+type querier interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+type txKey struct{}
+
+// NewPostgresStore connects to the PostgreSQL database at the given connection URL,
+// creates the tables of the schema and initializes the given public keys.
+func NewPostgresStore(ctx context.Context, dataSourceName string, config *Config) (*Store, error) {
+	db, err := sql.Open("postgres", dataSourceName)
+	if err != nil {
+		return nil, err
+	}
+	return newStore(ctx, db, redactDataSourceName(dataSourceName), config)
+}
+
+func newStore(ctx context.Context, db *sql.DB, databasePath string, config *Config) (*Store, error) {
+	if err := db.PingContext(ctx); err != nil {
+		return nil, errors.Wrap(err, "could not connect to database")
+	}
+	s := &Store{
+		db:           db,
+		databasePath: databasePath,
+	}
+	for _, stmt := range schema {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, errors.Wrap(err, "could not create schema")
+		}
+	}
+	if config != nil {
+		if err := s.UpdatePublicKeysBuckets(config.PubKeys); err != nil {
+			return nil, err
+		}
+	}
+	return s, nil
+}
+
+// Close closes the connections to the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// DatabasePath returns the connection URL of the database, without its password.
+func (s *Store) DatabasePath() string {
+	return s.databasePath
+}
+
+// ClearDB removes all the data stored in the database.
+func (s *Store) ClearDB() error {
+	_, err := s.db.Exec(clearTablesQuery)
+	return err
+}
+
+// Backup is not supported, as backups of a PostgreSQL database are made with its own tools.
+func (s *Store) Backup(_ context.Context, _ string, _ bool) error {
+	return errors.New("backups of a PostgreSQL database must be made with pg_dump")
+}
+
+// RunUpMigrations is a no-op, as the schema is created in its latest version.
+func (s *Store) RunUpMigrations(_ context.Context) error {
+	return nil
+}
+
+// RunDownMigrations is a no-op, as the schema is created in its latest version.
+func (s *Store) RunDownMigrations(_ context.Context) error {
+	return nil
+}
+
+// UpdatePublicKeysBuckets for a specified list of keys.
+func (s *Store) UpdatePublicKeysBuckets(pubKeys [][48]byte) error {
+	return s.update(context.Background(), func(q querier) error {
+		for _, pubKey := range pubKeys {
+			if _, err := q.ExecContext(context.Background(), insertValidatorQuery, pubKey[:]); err != nil {
+				return errors.Wrapf(err, "could not save public key %#x", pubKey)
+			}
+		}
+		return nil
+	})
+}
+
+// WithPublicKeyLock runs f in a transaction holding the lock of the row of the public key.
+// The other validator clients sharing the database wait for the transaction to end before
+// checking the signing history of the public key, so that checking and saving it is atomic.
+// The transaction is rolled back if f fails.
+func (s *Store) WithPublicKeyLock(ctx context.Context, pubKey [48]byte, f func(ctx context.Context) error) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.WithPublicKeyLock")
+	defer span.End()
+	if _, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return errors.New("lock of a public key already held")
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := lockPublicKey(ctx, tx, pubKey); err != nil {
+		return rollback(tx, err)
+	}
+	if err := f(context.WithValue(ctx, txKey{}, tx)); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func lockPublicKey(ctx context.Context, tx *sql.Tx, pubKey [48]byte) error {
+	if _, err := tx.ExecContext(ctx, insertValidatorQuery, pubKey[:]); err != nil {
+		return errors.Wrapf(err, "could not save public key %#x", pubKey)
+	}
+	if _, err := tx.ExecContext(ctx, lockValidatorQuery, pubKey[:]); err != nil {
+		return errors.Wrapf(err, "could not lock public key %#x", pubKey)
+	}
+	return nil
+}
+
+// querier returns the transaction of the context if there is one, or the database otherwise.
+func (s *Store) querier(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return tx
+	}
+	return s.db
+}
+
+// update runs f in the transaction of the context if there is one, or in a new transaction otherwise.
+func (s *Store) update(ctx context.Context, f func(q querier) error) error {
+	if tx, ok := ctx.Value(txKey{}).(*sql.Tx); ok {
+		return f(tx)
+	}
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
+
+func rollback(tx *sql.Tx, err error) error {
+	if rollbackErr := tx.Rollback(); rollbackErr != nil {
+		log.WithError(rollbackErr).Error("Could not roll back transaction")
+	}
+	return err
+}
+
+func redactDataSourceName(dataSourceName string) string {
+	u, err := url.Parse(dataSourceName)
+	if err != nil || u.Scheme == "" {
+		// Connection strings of key/value pairs may contain a password, so they are not shown.
+		return "postgres"
+	}
+	return u.Redacted()
+}
@@ -0,0 +1,116 @@
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"go.opencensus.io/trace"
+)
+
+const (
+	metadataQuery       = `SELECT value FROM metadata WHERE key = $1`
+	insertMetadataQuery = `INSERT INTO metadata (key, value) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	saveMetadataQuery   = `INSERT INTO metadata (key, value) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value`
+	blacklistedPublicKeysQuery    = `SELECT public_key FROM blacklisted_public_keys`
+	saveBlacklistedPublicKeyQuery = `INSERT INTO blacklisted_public_keys (public_key) VALUES ($1) ON CONFLICT DO NOTHING`
+)
+
+// SaveGenesisValidatorsRoot saves the genesis validator root to db.
+func (s *Store) SaveGenesisValidatorsRoot(ctx context.Context, genValRoot []byte) error {
+	return s.update(ctx, func(q querier) error {
+		res, err := q.ExecContext(ctx, insertMetadataQuery, genesisValidatorsRootKey, genValRoot)
+		if err != nil {
+			return err
+		}
+		inserted, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if inserted == 1 {
+			return nil
+		}
+		existing, err := s.metadata(ctx, q, genesisValidatorsRootKey)
+		if err != nil {
+			return err
+		}
+		return fmt.Errorf("cannot overwite existing genesis validators root: %#x", existing)
+	})
+}
+
+// GenesisValidatorsRoot retrieves the genesis validator root from db.
+func (s *Store) GenesisValidatorsRoot(ctx context.Context) ([]byte, error) {
+	return s.metadata(ctx, s.querier(ctx), genesisValidatorsRootKey)
+}
+
+// SaveGraffitiOrderedIndex writes the current graffiti index to the db
+func (s *Store) SaveGraffitiOrderedIndex(ctx context.Context, index uint64) error {
+	_, err := s.querier(ctx).ExecContext(
+		ctx, saveMetadataQuery, graffitiOrderedIndexKey, bytesutil.Uint64ToBytesBigEndian(index),
+	)
+	return err
+}
+
+// GraffitiOrderedIndex fetches the ordered index, resetting if the file hash changed
+func (s *Store) GraffitiOrderedIndex(ctx context.Context, fileHash [32]byte) (uint64, error) {
+	orderedIndex := uint64(0)
+	err := s.update(ctx, func(q querier) error {
+		dbFileHash, err := s.metadata(ctx, q, graffitiFileHashKey)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(dbFileHash, fileHash[:]) {
+			indexBytes, err := s.metadata(ctx, q, graffitiOrderedIndexKey)
+			if err != nil {
+				return err
+			}
+			orderedIndex = bytesutil.BytesToUint64BigEndian(indexBytes)
+			return nil
+		}
+		if _, err := q.ExecContext(
+			ctx, saveMetadataQuery, graffitiOrderedIndexKey, bytesutil.Uint64ToBytesBigEndian(0),
+		); err != nil {
+			return err
+		}
+		_, err = q.ExecContext(ctx, saveMetadataQuery, graffitiFileHashKey, fileHash[:])
+		return err
+	})
+	return orderedIndex, err
+}
+
+// EIPImportBlacklistedPublicKeys returns keys that were marked as blacklisted during EIP-3076 slashing
+// protection imports, ensuring that we can prevent these keys from having duties at runtime.
+func (s *Store) EIPImportBlacklistedPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.EIPImportBlacklistedPublicKeys")
+	defer span.End()
+	return s.publicKeys(ctx, blacklistedPublicKeysQuery)
+}
+
+// SaveEIPImportBlacklistedPublicKeys stores a list of blacklisted public keys that
+// were determined during EIP-3076 slashing protection imports.
+func (s *Store) SaveEIPImportBlacklistedPublicKeys(ctx context.Context, publicKeys [][48]byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveEIPImportBlacklistedPublicKeys")
+	defer span.End()
+	return s.update(ctx, func(q querier) error {
+		for _, pubKey := range publicKeys {
+			if _, err := q.ExecContext(ctx, saveBlacklistedPublicKeyQuery, pubKey[:]); err != nil {
+				return errors.Wrapf(err, "could not save blacklisted public key %#x", pubKey)
+			}
+		}
+		return nil
+	})
+}
+
+// metadata returns the value of a key of the metadata table, or nil if there is none.
+func (s *Store) metadata(ctx context.Context, q querier, key string) ([]byte, error) {
+	var value []byte
+	err := q.QueryRowContext(ctx, metadataQuery, key).Scan(&value)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, nil
+	}
+	return value, err
+}
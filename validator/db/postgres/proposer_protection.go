@@ -0,0 +1,106 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+	"go.opencensus.io/trace"
+)
+
+const (
+	proposedPublicKeysQuery = `SELECT DISTINCT public_key FROM proposals`
+	proposalAtSlotQuery     = `SELECT signing_root FROM proposals WHERE public_key = $1 AND slot = $2`
+	proposalHistoryQuery    = `SELECT slot, signing_root FROM proposals WHERE public_key = $1 ORDER BY slot`
+	saveProposalQuery       = `INSERT INTO proposals (public_key, slot, signing_root) VALUES ($1, $2, $3)
+		ON CONFLICT (public_key, slot) DO UPDATE SET signing_root = EXCLUDED.signing_root`
+	lowestSignedProposalQuery  = `SELECT MIN(slot) FROM proposals WHERE public_key = $1`
+	highestSignedProposalQuery = `SELECT MAX(slot) FROM proposals WHERE public_key = $1`
+)
+
+// ProposedPublicKeys retrieves all public keys which have proposed blocks.
+func (s *Store) ProposedPublicKeys(ctx context.Context) ([][48]byte, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposedPublicKeys")
+	defer span.End()
+	return s.publicKeys(ctx, proposedPublicKeysQuery)
+}
+
+// ProposalHistoryForSlot accepts a validator public key and returns the corresponding signing root as well
+// as a boolean that tells us if we have a proposal history stored at the slot. It is possible we have proposed
+// a slot but stored a nil signing root, so the boolean helps give full information.
+func (s *Store) ProposalHistoryForSlot(ctx context.Context, publicKey [48]byte, slot types.Slot) ([32]byte, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForSlot")
+	defer span.End()
+	var signingRoot [32]byte
+	var signingRootBytes []byte
+	err := s.querier(ctx).QueryRowContext(ctx, proposalAtSlotQuery, publicKey[:], int64(slot)).Scan(&signingRootBytes)
+	if errors.Is(err, sql.ErrNoRows) {
+		return signingRoot, false, nil
+	}
+	if err != nil {
+		return signingRoot, false, err
+	}
+	copy(signingRoot[:], signingRootBytes)
+	return signingRoot, true, nil
+}
+
+// ProposalHistoryForPubKey returns the entire proposal history for a given public key.
+func (s *Store) ProposalHistoryForPubKey(ctx context.Context, publicKey [48]byte) ([]*kv.Proposal, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.ProposalHistoryForPubKey")
+	defer span.End()
+	rows, err := s.querier(ctx).QueryContext(ctx, proposalHistoryQuery, publicKey[:])
+	if err != nil {
+		return nil, err
+	}
+	defer closeRows(rows)
+	proposals := make([]*kv.Proposal, 0)
+	for rows.Next() {
+		var slot int64
+		var signingRootBytes []byte
+		if err := rows.Scan(&slot, &signingRootBytes); err != nil {
+			return nil, err
+		}
+		signingRoot := make([]byte, 32)
+		copy(signingRoot, signingRootBytes)
+		proposals = append(proposals, &kv.Proposal{
+			Slot:        types.Slot(slot),
+			SigningRoot: signingRoot,
+		})
+	}
+	return proposals, rows.Err()
+}
+
+// SaveProposalHistoryForSlot saves the proposal history for the requested validator public key.
+func (s *Store) SaveProposalHistoryForSlot(ctx context.Context, pubKey [48]byte, slot types.Slot, signingRoot []byte) error {
+	ctx, span := trace.StartSpan(ctx, "Validator.SaveProposalHistoryForSlot")
+	defer span.End()
+	return s.update(ctx, func(q querier) error {
+		if _, err := q.ExecContext(ctx, insertValidatorQuery, pubKey[:]); err != nil {
+			return errors.Wrapf(err, "could not save public key %#x", pubKey)
+		}
+		if _, err := q.ExecContext(ctx, saveProposalQuery, pubKey[:], int64(slot), signingRoot); err != nil {
+			return errors.Wrapf(err, "could not save proposal for slot %d", slot)
+		}
+		return nil
+	})
+}
+
+// LowestSignedProposal returns the lowest signed proposal slot for a validator public key.
+// If no data exists, a boolean of value false is returned.
+func (s *Store) LowestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.LowestSignedProposal")
+	defer span.End()
+	slot, exists, err := s.queryUint64(ctx, lowestSignedProposalQuery, publicKey[:])
+	return types.Slot(slot), exists, err
+}
+
+// HighestSignedProposal returns the highest signed proposal slot for a validator public key.
+// If no data exists, a boolean of value false is returned.
+func (s *Store) HighestSignedProposal(ctx context.Context, publicKey [48]byte) (types.Slot, bool, error) {
+	ctx, span := trace.StartSpan(ctx, "Validator.HighestSignedProposal")
+	defer span.End()
+	slot, exists, err := s.queryUint64(ctx, highestSignedProposalQuery, publicKey[:])
+	return types.Slot(slot), exists, err
+}
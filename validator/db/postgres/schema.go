@@ -0,0 +1,45 @@
+package postgres
+
+// The tables of the slashing protection database. Epochs and slots are stored as signed
+// 64-bit integers, which is plenty for the epochs and slots validators sign.
+var schema = []string{
+	`CREATE TABLE IF NOT EXISTS validators (
+		public_key BYTEA PRIMARY KEY
+	)`,
+	`CREATE TABLE IF NOT EXISTS attestations (
+		public_key BYTEA NOT NULL REFERENCES validators (public_key) ON DELETE CASCADE,
+		target_epoch BIGINT NOT NULL,
+		source_epoch BIGINT NOT NULL,
+		signing_root BYTEA,
+		PRIMARY KEY (public_key, target_epoch, source_epoch)
+	)`,
+	`CREATE INDEX IF NOT EXISTS attestations_source_epoch_idx ON attestations (public_key, source_epoch)`,
+	`CREATE TABLE IF NOT EXISTS proposals (
+		public_key BYTEA NOT NULL REFERENCES validators (public_key) ON DELETE CASCADE,
+		slot BIGINT NOT NULL,
+		signing_root BYTEA,
+		PRIMARY KEY (public_key, slot)
+	)`,
+	`CREATE TABLE IF NOT EXISTS blacklisted_public_keys (
+		public_key BYTEA PRIMARY KEY
+	)`,
+	`CREATE TABLE IF NOT EXISTS metadata (
+		key TEXT PRIMARY KEY,
+		value BYTEA NOT NULL
+	)`,
+}
+
+const (
+	clearTablesQuery = `TRUNCATE validators, attestations, proposals, blacklisted_public_keys, metadata`
+
+	insertValidatorQuery = `INSERT INTO validators (public_key) VALUES ($1) ON CONFLICT DO NOTHING`
+	// Locks the row of a public key until the end of the transaction.
+	lockValidatorQuery = `SELECT public_key FROM validators WHERE public_key = $1 FOR UPDATE`
+)
+
+// Keys of the metadata table.
+const (
+	genesisValidatorsRootKey = "genesis-validators-root"
+	graffitiOrderedIndexKey  = "graffiti-ordered-index"
+	graffitiFileHashKey      = "graffiti-file-hash"
+)
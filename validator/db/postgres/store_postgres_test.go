@@ -0,0 +1,221 @@
+// +build postgres
+
+package postgres
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/db/kv"
+)
+
+// The tests of this file run against the PostgreSQL database at the connection URL of this
+// environment variable, which they clear, with: go test -tags postgres
+const testDataSourceNameEnv = "PRYSM_POSTGRES_TEST_URL"
+
+// setupDB returns a store of the test database, cleared of all data.
+func setupDB(t *testing.T) *Store {
+	dataSourceName := os.Getenv(testDataSourceNameEnv)
+	if dataSourceName == "" {
+		t.Fatalf("%s must be set to the connection URL of a PostgreSQL test database", testDataSourceNameEnv)
+	}
+	s, err := NewPostgresStore(context.Background(), dataSourceName, &Config{})
+	require.NoError(t, err)
+	require.NoError(t, s.ClearDB())
+	t.Cleanup(func() {
+		assert.NoError(t, s.Close())
+	})
+	return s
+}
+
+func createAttestation(source, target types.Epoch) *ethpb.IndexedAttestation {
+	return &ethpb.IndexedAttestation{
+		Data: &ethpb.AttestationData{
+			Source: &ethpb.Checkpoint{Epoch: source},
+			Target: &ethpb.Checkpoint{Epoch: target},
+		},
+	}
+}
+
+// checkAndSave checks an attestation is not slashable and saves it, as the validator client does
+// in the transaction holding the lock of the public key.
+func checkAndSave(s *Store, pubKey [48]byte, signingRoot [32]byte, att *ethpb.IndexedAttestation) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		if _, err := s.CheckSlashableAttestation(ctx, pubKey, signingRoot, att); err != nil {
+			return err
+		}
+		return s.SaveAttestationForPubKey(ctx, pubKey, signingRoot, att)
+	}
+}
+
+func TestStore_WithPublicKeyLock(t *testing.T) {
+	ctx := context.Background()
+	s := setupDB(t)
+	pubKey := [48]byte{1}
+	att := createAttestation(1, 2)
+
+	require.NoError(t, s.WithPublicKeyLock(ctx, pubKey, checkAndSave(s, pubKey, [32]byte{1}, att)))
+
+	// A double vote is not saved, and the transaction is rolled back.
+	err := s.WithPublicKeyLock(ctx, pubKey, func(ctx context.Context) error {
+		if err := s.SaveAttestationForPubKey(ctx, pubKey, [32]byte{3}, createAttestation(2, 3)); err != nil {
+			return err
+		}
+		return checkAndSave(s, pubKey, [32]byte{2}, att)(ctx)
+	})
+	require.ErrorContains(t, "double vote found", err)
+	records, err := s.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, types.Epoch(2), records[0].Target)
+
+	// The lock of a public key cannot be taken again within its transaction.
+	err = s.WithPublicKeyLock(ctx, pubKey, func(ctx context.Context) error {
+		return s.WithPublicKeyLock(ctx, pubKey, func(_ context.Context) error {
+			return nil
+		})
+	})
+	require.ErrorContains(t, "already held", err)
+}
+
+func TestStore_WithPublicKeyLock_ConcurrentStores(t *testing.T) {
+	ctx := context.Background()
+	// Two validator clients sharing the database.
+	first, second := setupDB(t), setupDB(t)
+	pubKey := [48]byte{2}
+	att := createAttestation(1, 2)
+
+	locked := make(chan struct{})
+	release := make(chan struct{})
+	firstDone := make(chan error, 1)
+	go func() {
+		firstDone <- first.WithPublicKeyLock(ctx, pubKey, func(ctx context.Context) error {
+			close(locked)
+			<-release
+			return checkAndSave(first, pubKey, [32]byte{1}, att)(ctx)
+		})
+	}()
+	<-locked
+
+	secondDone := make(chan error, 1)
+	go func() {
+		secondDone <- second.WithPublicKeyLock(ctx, pubKey, checkAndSave(second, pubKey, [32]byte{2}, att))
+	}()
+	// The second client waits for the first one to release the lock of the public key.
+	select {
+	case err := <-secondDone:
+		t.Fatalf("Second client did not wait for the lock of the public key: %v", err)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	close(release)
+	require.NoError(t, <-firstDone)
+	// The second client then sees the attestation of the first one, and its own is slashable.
+	require.ErrorContains(t, "double vote found", <-secondDone)
+	records, err := second.AttestationHistoryForPubKey(ctx, pubKey)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, [32]byte{1}, records[0].SigningRoot)
+}
+
+func TestStore_CheckSlashableAttestation(t *testing.T) {
+	ctx := context.Background()
+	pubKey := [48]byte{1}
+	tests := []struct {
+		name         string
+		existing     *ethpb.IndexedAttestation
+		slashingKind kv.SlashingKind
+		err          string
+	}{
+		{
+			name:         "surrounding vote",
+			existing:     createAttestation(4, 5),
+			slashingKind: kv.SurroundingVote,
+			err:          "attestation with (source 3, target 6) surrounds another with (source 4, target 5)",
+		},
+		{
+			name:         "surrounded vote",
+			existing:     createAttestation(2, 7),
+			slashingKind: kv.SurroundedVote,
+			err:          "attestation with (source 3, target 6) is surrounded by another with (source 2, target 7)",
+		},
+		{
+			name:         "double vote",
+			existing:     createAttestation(2, 6),
+			slashingKind: kv.DoubleVote,
+			err:          "double vote found",
+		},
+		{
+			// The same signing root at the target epoch is not a double vote.
+			name:         "not slashable",
+			existing:     createAttestation(3, 6),
+			slashingKind: kv.NotSlashable,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := setupDB(t)
+			signingRoot := [32]byte{1}
+			if tt.slashingKind == kv.DoubleVote {
+				signingRoot = [32]byte{2}
+			}
+			require.NoError(t, s.SaveAttestationForPubKey(ctx, pubKey, signingRoot, tt.existing))
+			slashingKind, err := s.CheckSlashableAttestation(ctx, pubKey, [32]byte{1}, createAttestation(3, 6))
+			if tt.err != "" {
+				require.ErrorContains(t, tt.err, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.slashingKind, slashingKind)
+		})
+	}
+}
+
+func TestStore_SignedProposals(t *testing.T) {
+	ctx := context.Background()
+	s := setupDB(t)
+	pubKey := [48]byte{1}
+
+	_, exists, err := s.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKey, 10, nil))
+	require.NoError(t, s.SaveProposalHistoryForSlot(ctx, pubKey, 12, []byte{1}))
+	slot, exists, err := s.LowestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(10), slot)
+	slot, exists, err = s.HighestSignedProposal(ctx, pubKey)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, types.Slot(12), slot)
+
+	// A proposal saved with a nil signing root exists, with a zero signing root.
+	signingRoot, exists, err := s.ProposalHistoryForSlot(ctx, pubKey, 10)
+	require.NoError(t, err)
+	assert.Equal(t, true, exists)
+	assert.Equal(t, [32]byte{}, signingRoot)
+
+	_, exists, err = s.ProposalHistoryForSlot(ctx, pubKey, 11)
+	require.NoError(t, err)
+	assert.Equal(t, false, exists)
+}
+
+func TestStore_SaveGenesisValidatorsRoot(t *testing.T) {
+	ctx := context.Background()
+	s := setupDB(t)
+
+	require.NoError(t, s.SaveGenesisValidatorsRoot(ctx, []byte{1}))
+	err := s.SaveGenesisValidatorsRoot(ctx, []byte{2})
+	require.ErrorContains(t, "cannot overwite existing genesis validators root: 0x01", err)
+	root, err := s.GenesisValidatorsRoot(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []byte{1}, root)
+}
@@ -0,0 +1,190 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth2/publicKeys"
+	signPath       = "/api/v1/eth2/sign/"
+	// maxErrorBodySize limits how much of an error response is included in the returned error.
+	maxErrorBodySize = 1024
+)
+
+// httpSignerClient talks to a Web3Signer server over its HTTP API.
+type httpSignerClient struct {
+	baseURL *url.URL
+	client  *http.Client
+}
+
+func newHTTPSignerClient(endpoint string, certCfg *CertificateConfig) (*httpSignerClient, error) {
+	baseURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid base endpoint")
+	}
+	if baseURL.Scheme != "http" && baseURL.Scheme != "https" {
+		return nil, fmt.Errorf("base endpoint %s must use the http or https scheme", endpoint)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if baseURL.Scheme == "https" {
+		tlsCfg, err := tlsConfig(certCfg)
+		if err != nil {
+			return nil, err
+		}
+		transport.TLSClientConfig = tlsCfg
+	} else if certCfg != nil && *certCfg != (CertificateConfig{}) {
+		return nil, errors.New("TLS certificates are configured but the base endpoint does not use https")
+	}
+	return &httpSignerClient{
+		baseURL: baseURL,
+		client:  &http.Client{Transport: transport},
+	}, nil
+}
+
+// tlsConfig loads the certificate authority used to verify the server certificate, and the
+// client certificate and key used for mutual TLS, when configured. Without a certificate
+// authority, the server certificate is verified against the system certificate pool.
+func tlsConfig(cfg *CertificateConfig) (*tls.Config, error) {
+	tlsCfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if cfg == nil {
+		return tlsCfg, nil
+	}
+	if cfg.CACertPath != "" {
+		serverCA, err := ioutil.ReadFile(cfg.CACertPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain server's CA certificate")
+		}
+		cp := x509.NewCertPool()
+		if !cp.AppendCertsFromPEM(serverCA) {
+			return nil, errors.New("failed to add server's CA certificate to pool")
+		}
+		tlsCfg.RootCAs = cp
+	}
+	if (cfg.ClientCertPath == "") != (cfg.ClientKeyPath == "") {
+		return nil, errors.New("client certificate and client key must be provided together")
+	}
+	if cfg.ClientCertPath != "" {
+		clientPair, err := tls.LoadX509KeyPair(cfg.ClientCertPath, cfg.ClientKeyPath)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to obtain client's certificate and/or key")
+		}
+		tlsCfg.Certificates = []tls.Certificate{clientPair}
+	}
+	return tlsCfg, nil
+}
+
+// publicKeys lists the public keys of the keys available for signing in the remote signer.
+func (c *httpSignerClient) publicKeys(ctx context.Context) ([][48]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp)
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("could not list public keys: %s", errorFromResponse(resp))
+	}
+	var encodedKeys []string
+	if err := json.NewDecoder(resp.Body).Decode(&encodedKeys); err != nil {
+		return nil, errors.Wrap(err, "could not decode public keys")
+	}
+	pubKeys := make([][48]byte, len(encodedKeys))
+	for i, k := range encodedKeys {
+		pubKey, err := hexutil.Decode(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not decode public key %s", k)
+		}
+		if len(pubKey) != 48 {
+			return nil, fmt.Errorf("public key %s has length %d, expected 48", k, len(pubKey))
+		}
+		pubKeys[i] = bytesutil.ToBytes48(pubKey)
+	}
+	return pubKeys, nil
+}
+
+// sign requests the signature of a request with the key of the given public key.
+func (c *httpSignerClient) sign(ctx context.Context, pubKey []byte, req *signRequestJson) ([]byte, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal sign request")
+	}
+	resp, err := c.do(ctx, http.MethodPost, signPath+hexutil.Encode(pubKey), body)
+	if err != nil {
+		return nil, err
+	}
+	defer closeBody(resp)
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusPreconditionFailed:
+		// The remote signer refuses to sign slashable data.
+		return nil, ErrSigningDenied
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("public key %#x is not available in the remote signer", pubKey)
+	default:
+		return nil, errors.Wrap(ErrSigningFailed, errorFromResponse(resp))
+	}
+
+	enc, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read sign response")
+	}
+	// The signature is returned as JSON or as plain text, depending on the version of the remote signer.
+	signature := strings.TrimSpace(string(enc))
+	if strings.HasPrefix(resp.Header.Get("Content-Type"), "application/json") {
+		var signResp signResponseJson
+		if err := json.Unmarshal(enc, &signResp); err != nil {
+			return nil, errors.Wrap(err, "could not decode sign response")
+		}
+		signature = signResp.Signature
+	}
+	sig, err := hexutil.Decode(signature)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode signature")
+	}
+	return sig, nil
+}
+
+func (c *httpSignerClient) do(ctx context.Context, method, path string, body []byte) (*http.Response, error) {
+	u := *c.baseURL
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not create request")
+	}
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not send request to remote signer at %s", c.baseURL.Host)
+	}
+	return resp, nil
+}
+
+func errorFromResponse(resp *http.Response) string {
+	enc, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+	if err != nil || len(enc) == 0 {
+		return resp.Status
+	}
+	return fmt.Sprintf("%s: %s", resp.Status, strings.TrimSpace(string(enc)))
+}
+
+func closeBody(resp *http.Response) {
+	if err := resp.Body.Close(); err != nil {
+		log.WithError(err).Error("Could not close response body")
+	}
+}
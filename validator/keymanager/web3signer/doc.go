@@ -0,0 +1,16 @@
+/*
+Package web3signer defines a keymanager implementation which signs with the keys of a
+Web3Signer server, over the HTTP signing API for Ethereum consensus clients defined by
+EIP-3030.
+
+The public keys available for signing are listed with the /api/v1/eth2/publicKeys
+endpoint, and every sign request is sent to the /api/v1/eth2/sign/{pubkey} endpoint
+as a typed JSON object (block, attestation, aggregate and proof, randao reveal,
+voluntary exit, sync committee message, selection proof or contribution and proof)
+along with the fork information of the signing slot. This allows the remote signer
+to apply its own slashing protection before signing.
+
+Connections to an https endpoint are verified against a configured certificate
+authority, and can authenticate with a client certificate for mutual TLS.
+*/
+package web3signer
@@ -0,0 +1,404 @@
+package web3signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/logrusorgru/aurora"
+	"github.com/pkg/errors"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/event"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+)
+
+var (
+	// ErrSigningFailed defines a failure from the remote signer
+	// when performing a signing operation.
+	ErrSigningFailed = errors.New("signing failed in the remote signer")
+	// ErrSigningDenied defines a failure from the remote signer when
+	// performing a signing operation was denied by its slashing protection.
+	ErrSigningDenied = errors.New("signing request was denied by remote signer")
+	// ErrRemoteKeyExists defines a remote key being added which is already known to the keymanager.
+	ErrRemoteKeyExists = errors.New("remote key already exists")
+	// ErrRemoteKeyNotFound defines a remote key being deleted which is not known to the keymanager.
+	ErrRemoteKeyNotFound = errors.New("remote key not found")
+	// ErrRemoteKeyReadonly defines a remote key being deleted which is listed by the
+	// Web3Signer server at the base endpoint, and so can't be removed from the keymanager.
+	ErrRemoteKeyReadonly = errors.New("remote key is listed by the base endpoint and can't be deleted")
+)
+
+// KeymanagerOpts for a Web3Signer keymanager.
+type KeymanagerOpts struct {
+	BaseEndpoint          string             `json:"base_endpoint"`
+	GenesisValidatorsRoot string             `json:"genesis_validators_root"`
+	RemoteCertificate     *CertificateConfig `json:"remote_cert,omitempty"`
+	RemoteKeys            []*RemoteKey       `json:"remote_keys,omitempty"`
+}
+
+// RemoteKey is a validating key added to the keymanager, held by the Web3Signer
+// server at URL, or by the server at the base endpoint when URL is empty.
+type RemoteKey struct {
+	PublicKey string `json:"pubkey"`
+	URL       string `json:"url,omitempty"`
+	// Readonly is set on keys listed by the server at the base endpoint,
+	// which are not part of the keymanager options.
+	Readonly bool `json:"-"`
+}
+
+// CertificateConfig defines configuration options for the certificate authority
+// cert used to verify the remote signer, and the client cert and key used for
+// mutual TLS. They are only used with an https base endpoint.
+type CertificateConfig struct {
+	ClientCertPath string `json:"crt_path"`
+	ClientKeyPath  string `json:"key_path"`
+	CACertPath     string `json:"ca_crt_path"`
+}
+
+// SetupConfig includes configuration values for initializing a Web3Signer keymanager.
+type SetupConfig struct {
+	Opts *KeymanagerOpts
+	// Wallet is used to persist the options when remote keys are added or deleted.
+	// Without a wallet, such changes only last for the lifetime of the keymanager.
+	Wallet iface.Wallet
+}
+
+// Keymanager implementation using remote signing keys of a Web3Signer server,
+// over the HTTP signing API defined by EIP-3030.
+type Keymanager struct {
+	opts                  *KeymanagerOpts
+	wallet                iface.Wallet
+	client                *httpSignerClient
+	genesisValidatorsRoot []byte
+	orderedPubKeys        [][48]byte
+	accountsChangedFeed   *event.Feed
+	remoteKeysLock        sync.RWMutex
+	remoteKeys            map[[48]byte]*httpSignerClient
+}
+
+// NewKeymanager instantiates a new Web3Signer keymanager from configuration options.
+func NewKeymanager(_ context.Context, cfg *SetupConfig) (*Keymanager, error) {
+	if cfg.Opts == nil {
+		return nil, errors.New("keymanager options are missing")
+	}
+	genesisValidatorsRoot, err := hexutil.Decode(cfg.Opts.GenesisValidatorsRoot)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid genesis validators root")
+	}
+	if len(genesisValidatorsRoot) != 32 {
+		return nil, fmt.Errorf("genesis validators root has length %d, expected 32", len(genesisValidatorsRoot))
+	}
+	client, err := newHTTPSignerClient(cfg.Opts.BaseEndpoint, cfg.Opts.RemoteCertificate)
+	if err != nil {
+		return nil, err
+	}
+	km := &Keymanager{
+		opts:                  cfg.Opts,
+		wallet:                cfg.Wallet,
+		client:                client,
+		genesisValidatorsRoot: genesisValidatorsRoot,
+		orderedPubKeys:        make([][48]byte, 0),
+		accountsChangedFeed:   new(event.Feed),
+		remoteKeys:            make(map[[48]byte]*httpSignerClient, len(cfg.Opts.RemoteKeys)),
+	}
+	for _, k := range cfg.Opts.RemoteKeys {
+		pubKey, keyClient, err := km.remoteKeyClient(k)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid remote key %s", k.PublicKey)
+		}
+		km.remoteKeys[pubKey] = keyClient
+	}
+	return km, nil
+}
+
+// UnmarshalOptionsFile attempts to JSON unmarshal a keymanager
+// options file into a struct.
+func UnmarshalOptionsFile(r io.ReadCloser) (*KeymanagerOpts, error) {
+	enc, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read config")
+	}
+	defer func() {
+		if err := r.Close(); err != nil {
+			log.Errorf("Could not close keymanager config file: %v", err)
+		}
+	}()
+	opts := &KeymanagerOpts{}
+	if err := json.Unmarshal(enc, opts); err != nil {
+		return nil, errors.Wrap(err, "could not JSON unmarshal")
+	}
+	return opts, nil
+}
+
+// MarshalOptionsFile for the keymanager.
+func MarshalOptionsFile(_ context.Context, cfg *KeymanagerOpts) ([]byte, error) {
+	return json.MarshalIndent(cfg, "", "\t")
+}
+
+// String pretty-print of Web3Signer keymanager options.
+func (opts *KeymanagerOpts) String() string {
+	au := aurora.NewAurora(true)
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta("Web3Signer URL"), opts.BaseEndpoint))
+	b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta("Genesis validators root"), opts.GenesisValidatorsRoot))
+	if opts.RemoteCertificate != nil {
+		b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta("Client cert path"), opts.RemoteCertificate.ClientCertPath))
+		b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta("Client key path"), opts.RemoteCertificate.ClientKeyPath))
+		b.WriteString(fmt.Sprintf("%s: %s\n", au.BrightMagenta("CA cert path"), opts.RemoteCertificate.CACertPath))
+	}
+	if len(opts.RemoteKeys) > 0 {
+		b.WriteString(fmt.Sprintf("%s: %d\n", au.BrightMagenta("Added remote keys"), len(opts.RemoteKeys)))
+	}
+	return b.String()
+}
+
+// KeymanagerOpts for the Web3Signer keymanager.
+func (km *Keymanager) KeymanagerOpts() *KeymanagerOpts {
+	return km.opts
+}
+
+// ReloadPublicKeys reloads public keys.
+func (km *Keymanager) ReloadPublicKeys(ctx context.Context) ([][48]byte, error) {
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not reload public keys")
+	}
+
+	sort.Slice(pubKeys, func(i, j int) bool { return bytes.Compare(pubKeys[i][:], pubKeys[j][:]) == -1 })
+	if len(km.orderedPubKeys) != len(pubKeys) {
+		log.Info(keymanager.KeysReloaded)
+		km.accountsChangedFeed.Send(pubKeys)
+	} else {
+		for i := range km.orderedPubKeys {
+			if !bytes.Equal(km.orderedPubKeys[i][:], pubKeys[i][:]) {
+				log.Info(keymanager.KeysReloaded)
+				km.accountsChangedFeed.Send(pubKeys)
+				break
+			}
+		}
+	}
+
+	km.orderedPubKeys = pubKeys
+	return km.orderedPubKeys, nil
+}
+
+// FetchValidatingPublicKeys fetches the list of public keys that should be used to validate with,
+// which are the keys listed by the server at the base endpoint and the added remote keys.
+func (km *Keymanager) FetchValidatingPublicKeys(ctx context.Context) ([][48]byte, error) {
+	pubKeys, err := km.client.publicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list accounts from remote signer")
+	}
+	km.remoteKeysLock.RLock()
+	defer km.remoteKeysLock.RUnlock()
+	listed := make(map[[48]byte]bool, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		listed[pubKey] = true
+	}
+	for _, k := range km.opts.RemoteKeys {
+		pubKey, err := decodePublicKey(k.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		if !listed[pubKey] {
+			pubKeys = append(pubKeys, pubKey)
+		}
+	}
+	return pubKeys, nil
+}
+
+// Sign signs a message for a validator key via a request to the Web3Signer signing API.
+func (km *Keymanager) Sign(ctx context.Context, req *validatorpb.SignRequest) (bls.Signature, error) {
+	signReq, err := signRequestToJson(req, km.genesisValidatorsRoot)
+	if err != nil {
+		return nil, err
+	}
+	client := km.client
+	km.remoteKeysLock.RLock()
+	if keyClient, ok := km.remoteKeys[bytesutil.ToBytes48(req.PublicKey)]; ok {
+		client = keyClient
+	}
+	km.remoteKeysLock.RUnlock()
+	sig, err := client.sign(ctx, req.PublicKey, signReq)
+	if err != nil {
+		return nil, err
+	}
+	return bls.SignatureFromBytes(sig)
+}
+
+// ListRemoteKeys lists the keys listed by the server at the base endpoint, which are read-only,
+// followed by the added remote keys.
+func (km *Keymanager) ListRemoteKeys(ctx context.Context) ([]*RemoteKey, error) {
+	pubKeys, err := km.client.publicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list accounts from remote signer")
+	}
+	keys := make([]*RemoteKey, 0, len(pubKeys))
+	for _, pubKey := range pubKeys {
+		keys = append(keys, &RemoteKey{
+			PublicKey: hexutil.Encode(pubKey[:]),
+			URL:       km.opts.BaseEndpoint,
+			Readonly:  true,
+		})
+	}
+	km.remoteKeysLock.RLock()
+	defer km.remoteKeysLock.RUnlock()
+	for _, k := range km.opts.RemoteKeys {
+		url := k.URL
+		if url == "" {
+			url = km.opts.BaseEndpoint
+		}
+		keys = append(keys, &RemoteKey{PublicKey: k.PublicKey, URL: url})
+	}
+	return keys, nil
+}
+
+// AddRemoteKeys adds remote keys to the keymanager, persisting them in the wallet's keymanager
+// options. It returns an error per key, which is ErrRemoteKeyExists for keys already known to
+// the keymanager, or nil if the key was added.
+func (km *Keymanager) AddRemoteKeys(ctx context.Context, keys []*RemoteKey) ([]error, error) {
+	listed, err := km.client.publicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list accounts from remote signer")
+	}
+	existing := make(map[[48]byte]bool, len(listed))
+	for _, pubKey := range listed {
+		existing[pubKey] = true
+	}
+
+	km.remoteKeysLock.Lock()
+	defer km.remoteKeysLock.Unlock()
+	errs := make([]error, len(keys))
+	added := false
+	for i, k := range keys {
+		pubKey, client, err := km.remoteKeyClient(k)
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if _, ok := km.remoteKeys[pubKey]; ok || existing[pubKey] {
+			errs[i] = ErrRemoteKeyExists
+			continue
+		}
+		km.remoteKeys[pubKey] = client
+		km.opts.RemoteKeys = append(km.opts.RemoteKeys, &RemoteKey{
+			PublicKey: hexutil.Encode(pubKey[:]),
+			URL:       k.URL,
+		})
+		added = true
+	}
+	if added {
+		if err := km.saveOptions(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return errs, nil
+}
+
+// DeleteRemoteKeys removes added remote keys from the keymanager, persisting the change in the
+// wallet's keymanager options. It returns an error per key, which is ErrRemoteKeyNotFound for
+// unknown keys, ErrRemoteKeyReadonly for keys listed by the server at the base endpoint, or
+// nil if the key was deleted.
+func (km *Keymanager) DeleteRemoteKeys(ctx context.Context, pubKeys [][]byte) ([]error, error) {
+	listed, err := km.client.publicKeys(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not list accounts from remote signer")
+	}
+	readonly := make(map[[48]byte]bool, len(listed))
+	for _, pubKey := range listed {
+		readonly[pubKey] = true
+	}
+
+	km.remoteKeysLock.Lock()
+	defer km.remoteKeysLock.Unlock()
+	errs := make([]error, len(pubKeys))
+	deleted := false
+	for i, pk := range pubKeys {
+		pubKey := bytesutil.ToBytes48(pk)
+		if _, ok := km.remoteKeys[pubKey]; !ok || len(pk) != 48 {
+			if readonly[pubKey] {
+				errs[i] = ErrRemoteKeyReadonly
+			} else {
+				errs[i] = ErrRemoteKeyNotFound
+			}
+			continue
+		}
+		delete(km.remoteKeys, pubKey)
+		for j, k := range km.opts.RemoteKeys {
+			if decoded, err := decodePublicKey(k.PublicKey); err == nil && decoded == pubKey {
+				km.opts.RemoteKeys = append(km.opts.RemoteKeys[:j], km.opts.RemoteKeys[j+1:]...)
+				break
+			}
+		}
+		deleted = true
+	}
+	if deleted {
+		if err := km.saveOptions(ctx); err != nil {
+			return nil, err
+		}
+	}
+	return errs, nil
+}
+
+// remoteKeyClient validates a remote key, returning its public key and the client
+// used to sign with it.
+func (km *Keymanager) remoteKeyClient(k *RemoteKey) ([48]byte, *httpSignerClient, error) {
+	pubKey, err := decodePublicKey(k.PublicKey)
+	if err != nil {
+		return [48]byte{}, nil, err
+	}
+	if _, err := bls.PublicKeyFromBytes(pubKey[:]); err != nil {
+		return [48]byte{}, nil, errors.Wrap(err, "invalid public key")
+	}
+	if k.URL == "" || k.URL == km.opts.BaseEndpoint {
+		return pubKey, km.client, nil
+	}
+	client, err := newHTTPSignerClient(k.URL, km.opts.RemoteCertificate)
+	if err != nil {
+		return [48]byte{}, nil, err
+	}
+	return pubKey, client, nil
+}
+
+// saveOptions persists the keymanager options in the wallet, if any.
+func (km *Keymanager) saveOptions(ctx context.Context) error {
+	if km.wallet == nil {
+		return nil
+	}
+	encoded, err := MarshalOptionsFile(ctx, km.opts)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal keymanager options")
+	}
+	if err := km.wallet.WriteKeymanagerConfigToDisk(ctx, encoded); err != nil {
+		return errors.Wrap(err, "could not write keymanager options")
+	}
+	return nil
+}
+
+func decodePublicKey(encoded string) ([48]byte, error) {
+	pubKey, err := hexutil.Decode(encoded)
+	if err != nil {
+		return [48]byte{}, errors.Wrapf(err, "could not decode public key %s", encoded)
+	}
+	if len(pubKey) != 48 {
+		return [48]byte{}, fmt.Errorf("public key %s has length %d, expected 48", encoded, len(pubKey))
+	}
+	return bytesutil.ToBytes48(pubKey), nil
+}
+
+// SubscribeAccountChanges creates an event subscription for a channel
+// to listen for public key changes at runtime, such as when new validator keys
+// are added to the remote signer while the validator process is running.
+func (km *Keymanager) SubscribeAccountChanges(pubKeysChan chan [][48]byte) event.Subscription {
+	return km.accountsChangedFeed.Subscribe(pubKeysChan)
+}
@@ -0,0 +1,372 @@
+package web3signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	mock "github.com/prysmaticlabs/prysm/validator/accounts/testing"
+)
+
+var genesisValidatorsRoot = hexutil.Encode(bytesutil.PadTo([]byte("genesis"), 32))
+
+// mockSigner is a minimal Web3Signer server signing with a single key.
+type mockSigner struct {
+	t        *testing.T
+	key      bls.SecretKey
+	status   int
+	jsonResp bool
+	lastReq  *signRequestJson
+}
+
+func (m *mockSigner) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	pubKey := hexutil.Encode(m.key.PublicKey().Marshal())
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == publicKeysPath:
+		require.NoError(m.t, json.NewEncoder(w).Encode([]string{pubKey}))
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, signPath):
+		if r.URL.Path != signPath+pubKey {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if m.status != 0 {
+			w.WriteHeader(m.status)
+			_, err := w.Write([]byte("slashable"))
+			require.NoError(m.t, err)
+			return
+		}
+		m.lastReq = &signRequestJson{}
+		require.NoError(m.t, json.NewDecoder(r.Body).Decode(m.lastReq))
+		root, err := hexutil.Decode(m.lastReq.SigningRoot)
+		require.NoError(m.t, err)
+		sig := hexutil.Encode(m.key.Sign(root).Marshal())
+		if m.jsonResp {
+			w.Header().Set("Content-Type", "application/json")
+			require.NoError(m.t, json.NewEncoder(w).Encode(&signResponseJson{Signature: sig}))
+			return
+		}
+		w.Header().Set("Content-Type", "text/plain")
+		_, err = w.Write([]byte(sig))
+		require.NoError(m.t, err)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newMockSigner(t *testing.T) *mockSigner {
+	key, err := bls.RandKey()
+	require.NoError(t, err)
+	return &mockSigner{t: t, key: key}
+}
+
+func TestNewKeymanager(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    *KeymanagerOpts
+		wantErr string
+	}{
+		{
+			name:    "missing options",
+			wantErr: "keymanager options are missing",
+		},
+		{
+			name:    "invalid genesis validators root",
+			opts:    &KeymanagerOpts{BaseEndpoint: "http://localhost:9000", GenesisValidatorsRoot: "0x1234"},
+			wantErr: "genesis validators root has length 2, expected 32",
+		},
+		{
+			name:    "unsupported scheme",
+			opts:    &KeymanagerOpts{BaseEndpoint: "localhost:9000", GenesisValidatorsRoot: genesisValidatorsRoot},
+			wantErr: "must use the http or https scheme",
+		},
+		{
+			name: "certificates without https",
+			opts: &KeymanagerOpts{
+				BaseEndpoint:          "http://localhost:9000",
+				GenesisValidatorsRoot: genesisValidatorsRoot,
+				RemoteCertificate:     &CertificateConfig{CACertPath: "/path/to/ca.crt"},
+			},
+			wantErr: "the base endpoint does not use https",
+		},
+		{
+			name: "client certificate without key",
+			opts: &KeymanagerOpts{
+				BaseEndpoint:          "https://localhost:9000",
+				GenesisValidatorsRoot: genesisValidatorsRoot,
+				RemoteCertificate:     &CertificateConfig{ClientCertPath: "/path/to/client.crt"},
+			},
+			wantErr: "client certificate and client key must be provided together",
+		},
+		{
+			name: "missing CA certificate",
+			opts: &KeymanagerOpts{
+				BaseEndpoint:          "https://localhost:9000",
+				GenesisValidatorsRoot: genesisValidatorsRoot,
+				RemoteCertificate:     &CertificateConfig{CACertPath: "/does/not/exist/ca.crt"},
+			},
+			wantErr: "failed to obtain server's CA certificate",
+		},
+		{
+			name: "http",
+			opts: &KeymanagerOpts{BaseEndpoint: "http://localhost:9000", GenesisValidatorsRoot: genesisValidatorsRoot},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewKeymanager(context.Background(), &SetupConfig{Opts: tt.opts})
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestKeymanager_FetchValidatingPublicKeys(t *testing.T) {
+	signer := newMockSigner(t)
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}})
+	require.NoError(t, err)
+
+	pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pubKeys))
+	assert.DeepEqual(t, signer.key.PublicKey().Marshal(), pubKeys[0][:])
+}
+
+func TestKeymanager_Sign(t *testing.T) {
+	signer := newMockSigner(t)
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}})
+	require.NoError(t, err)
+	root := bytesutil.PadTo([]byte("signing root"), 32)
+	req := &validatorpb.SignRequest{
+		PublicKey:   signer.key.PublicKey().Marshal(),
+		SigningRoot: root,
+		Object: &validatorpb.SignRequest_AttestationData{AttestationData: &ethpb.AttestationData{
+			Slot:            5,
+			BeaconBlockRoot: make([]byte, 32),
+			Source:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+			Target:          &ethpb.Checkpoint{Root: make([]byte, 32)},
+		}},
+		SigningSlot: 5,
+	}
+
+	for _, jsonResp := range []bool{false, true} {
+		t.Run(fmt.Sprintf("json response %t", jsonResp), func(t *testing.T) {
+			signer.jsonResp = jsonResp
+			sig, err := km.Sign(context.Background(), req)
+			require.NoError(t, err)
+			assert.Equal(t, true, sig.Verify(signer.key.PublicKey(), root))
+			assert.Equal(t, attestationSignType, signer.lastReq.Type)
+			assert.Equal(t, "5", signer.lastReq.Attestation.Slot)
+			assert.Equal(t, genesisValidatorsRoot, signer.lastReq.ForkInfo.GenesisValidatorsRoot)
+		})
+	}
+	t.Run("unknown public key", func(t *testing.T) {
+		unknownReq := &validatorpb.SignRequest{
+			PublicKey:   make([]byte, 48),
+			SigningRoot: root,
+			Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+		}
+		_, err := km.Sign(context.Background(), unknownReq)
+		assert.ErrorContains(t, "is not available in the remote signer", err)
+	})
+	t.Run("slashable request", func(t *testing.T) {
+		signer.status = http.StatusPreconditionFailed
+		defer func() { signer.status = 0 }()
+		_, err := km.Sign(context.Background(), req)
+		assert.Equal(t, true, errors.Is(err, ErrSigningDenied))
+	})
+	t.Run("signer error", func(t *testing.T) {
+		signer.status = http.StatusInternalServerError
+		defer func() { signer.status = 0 }()
+		_, err := km.Sign(context.Background(), req)
+		assert.Equal(t, true, errors.Is(err, ErrSigningFailed))
+		assert.ErrorContains(t, "500 Internal Server Error: slashable", err)
+	})
+}
+
+func TestKeymanager_TLS(t *testing.T) {
+	signer := newMockSigner(t)
+	srv := httptest.NewUnstartedServer(signer)
+	srv.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	srv.StartTLS()
+	defer srv.Close()
+
+	dir := t.TempDir()
+	caPath := filepath.Join(dir, "ca.crt")
+	require.NoError(t, ioutil.WriteFile(caPath, pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: srv.Certificate().Raw,
+	}), 0600))
+	// The server certificate of the test server doubles as the client certificate.
+	keyDER, err := x509.MarshalPKCS8PrivateKey(srv.TLS.Certificates[0].PrivateKey)
+	require.NoError(t, err)
+	keyPath := filepath.Join(dir, "client.key")
+	require.NoError(t, ioutil.WriteFile(keyPath, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}), 0600))
+
+	t.Run("mutual TLS", func(t *testing.T) {
+		km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+			BaseEndpoint:          srv.URL,
+			GenesisValidatorsRoot: genesisValidatorsRoot,
+			RemoteCertificate: &CertificateConfig{
+				CACertPath:     caPath,
+				ClientCertPath: caPath,
+				ClientKeyPath:  keyPath,
+			},
+		}})
+		require.NoError(t, err)
+		pubKeys, err := km.FetchValidatingPublicKeys(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, 1, len(pubKeys))
+	})
+	t.Run("unknown certificate authority", func(t *testing.T) {
+		km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+			BaseEndpoint:          srv.URL,
+			GenesisValidatorsRoot: genesisValidatorsRoot,
+		}})
+		require.NoError(t, err)
+		_, err = km.FetchValidatingPublicKeys(context.Background())
+		assert.ErrorContains(t, "certificate", err)
+	})
+}
+
+func TestKeymanager_ReloadPublicKeys(t *testing.T) {
+	signer := newMockSigner(t)
+	srv := httptest.NewServer(signer)
+	defer srv.Close()
+	km, err := NewKeymanager(context.Background(), &SetupConfig{Opts: &KeymanagerOpts{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+	}})
+	require.NoError(t, err)
+	pubKeysChan := make(chan [][48]byte, 1)
+	sub := km.SubscribeAccountChanges(pubKeysChan)
+	defer sub.Unsubscribe()
+
+	pubKeys, err := km.ReloadPublicKeys(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 1, len(pubKeys))
+	assert.DeepEqual(t, pubKeys, <-pubKeysChan)
+
+	// Reloading the same keys does not notify subscribers.
+	_, err = km.ReloadPublicKeys(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(pubKeysChan))
+}
+
+func TestUnmarshalOptionsFile(t *testing.T) {
+	opts := &KeymanagerOpts{
+		BaseEndpoint:          "https://localhost:9000",
+		GenesisValidatorsRoot: genesisValidatorsRoot,
+		RemoteCertificate:     &CertificateConfig{CACertPath: "/path/to/ca.crt"},
+	}
+	enc, err := MarshalOptionsFile(context.Background(), opts)
+	require.NoError(t, err)
+	decoded, err := UnmarshalOptionsFile(ioutil.NopCloser(strings.NewReader(string(enc))))
+	require.NoError(t, err)
+	assert.DeepEqual(t, opts, decoded)
+}
+
+func TestKeymanager_RemoteKeys(t *testing.T) {
+	ctx := context.Background()
+	baseSigner := newMockSigner(t)
+	baseSrv := httptest.NewServer(baseSigner)
+	defer baseSrv.Close()
+	otherSigner := newMockSigner(t)
+	otherSrv := httptest.NewServer(otherSigner)
+	defer otherSrv.Close()
+
+	wallet := &mock.Wallet{}
+	km, err := NewKeymanager(ctx, &SetupConfig{
+		Opts: &KeymanagerOpts{
+			BaseEndpoint:          baseSrv.URL,
+			GenesisValidatorsRoot: genesisValidatorsRoot,
+		},
+		Wallet: wallet,
+	})
+	require.NoError(t, err)
+
+	basePubKey := hexutil.Encode(baseSigner.key.PublicKey().Marshal())
+	otherPubKey := hexutil.Encode(otherSigner.key.PublicKey().Marshal())
+	errs, err := km.AddRemoteKeys(ctx, []*RemoteKey{
+		{PublicKey: otherPubKey, URL: otherSrv.URL},
+		{PublicKey: basePubKey},
+		{PublicKey: "0x1234"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(errs))
+	assert.NoError(t, errs[0])
+	assert.Equal(t, true, errors.Is(errs[1], ErrRemoteKeyExists))
+	assert.ErrorContains(t, "has length 2, expected 48", errs[2])
+
+	keys, err := km.ListRemoteKeys(ctx)
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*RemoteKey{
+		{PublicKey: basePubKey, URL: baseSrv.URL, Readonly: true},
+		{PublicKey: otherPubKey, URL: otherSrv.URL},
+	}, keys)
+	pubKeys, err := km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(pubKeys))
+
+	// Added keys sign with the server at their own URL.
+	root := bytesutil.PadTo([]byte("signing root"), 32)
+	sig, err := km.Sign(ctx, &validatorpb.SignRequest{
+		PublicKey:   otherSigner.key.PublicKey().Marshal(),
+		SigningRoot: root,
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, true, sig.Verify(otherSigner.key.PublicKey(), root))
+
+	// The added key is persisted in the wallet.
+	persisted, err := UnmarshalOptionsFile(ioutil.NopCloser(strings.NewReader(string(wallet.KeymanagerConfig))))
+	require.NoError(t, err)
+	assert.DeepEqual(t, []*RemoteKey{{PublicKey: otherPubKey, URL: otherSrv.URL}}, persisted.RemoteKeys)
+
+	errs, err = km.DeleteRemoteKeys(ctx, [][]byte{
+		otherSigner.key.PublicKey().Marshal(),
+		baseSigner.key.PublicKey().Marshal(),
+		make([]byte, 48),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(errs))
+	assert.NoError(t, errs[0])
+	assert.Equal(t, true, errors.Is(errs[1], ErrRemoteKeyReadonly))
+	assert.Equal(t, true, errors.Is(errs[2], ErrRemoteKeyNotFound))
+
+	pubKeys, err = km.FetchValidatingPublicKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(pubKeys))
+	persisted, err = UnmarshalOptionsFile(ioutil.NopCloser(strings.NewReader(string(wallet.KeymanagerConfig))))
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(persisted.RemoteKeys))
+}
@@ -0,0 +1,244 @@
+package web3signer
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	types "github.com/prysmaticlabs/eth2-types"
+	"github.com/prysmaticlabs/prysm/beacon-chain/core/helpers"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/shared/p2putils"
+)
+
+// signRequestToJson maps a sign request to the Web3Signer request of its object type. The fork
+// information of the request is derived from the signing slot of the request and the fork
+// schedule of the beacon chain configuration.
+func signRequestToJson(req *validatorpb.SignRequest, genesisValidatorsRoot []byte) (*signRequestJson, error) {
+	fork, err := p2putils.Fork(helpers.SlotToEpoch(req.SigningSlot))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get fork of signing slot")
+	}
+	r := &signRequestJson{
+		ForkInfo: &forkInfoJson{
+			Fork: &forkJson{
+				PreviousVersion: hexutil.Encode(fork.PreviousVersion),
+				CurrentVersion:  hexutil.Encode(fork.CurrentVersion),
+				Epoch:           uint64ToString(uint64(fork.Epoch)),
+			},
+			GenesisValidatorsRoot: hexutil.Encode(genesisValidatorsRoot),
+		},
+		SigningRoot: hexutil.Encode(req.SigningRoot),
+	}
+
+	switch obj := req.Object.(type) {
+	case *validatorpb.SignRequest_Block:
+		r.Type = blockSignType
+		r.Block = beaconBlockToJson(obj.Block.Slot, obj.Block.ProposerIndex, obj.Block.ParentRoot, obj.Block.StateRoot)
+		r.Block.Body = beaconBlockBodyToJson(obj.Block.Body)
+	case *validatorpb.SignRequest_BlockV2:
+		blk := beaconBlockToJson(obj.BlockV2.Slot, obj.BlockV2.ProposerIndex, obj.BlockV2.ParentRoot, obj.BlockV2.StateRoot)
+		blk.Body = beaconBlockBodyAltairToJson(obj.BlockV2.Body)
+		r.Type = blockV2SignType
+		r.BeaconBlock = &beaconBlockV2Json{Version: "ALTAIR", Block: blk}
+	case *validatorpb.SignRequest_AttestationData:
+		r.Type = attestationSignType
+		r.Attestation = attestationDataToJson(obj.AttestationData)
+	case *validatorpb.SignRequest_Slot:
+		r.Type = aggregationSlotSignType
+		r.AggregationSlot = &aggregationSlotJson{Slot: uint64ToString(uint64(obj.Slot))}
+	case *validatorpb.SignRequest_AggregateAttestationAndProof:
+		r.Type = aggregateAndProofSignType
+		r.AggregateAndProof = &aggregateAndProofJson{
+			AggregatorIndex: uint64ToString(uint64(obj.AggregateAttestationAndProof.AggregatorIndex)),
+			Aggregate:       attestationToJson(obj.AggregateAttestationAndProof.Aggregate),
+			SelectionProof:  hexutil.Encode(obj.AggregateAttestationAndProof.SelectionProof),
+		}
+	case *validatorpb.SignRequest_Epoch:
+		r.Type = randaoRevealSignType
+		r.RandaoReveal = &randaoRevealJson{Epoch: uint64ToString(uint64(obj.Epoch))}
+	case *validatorpb.SignRequest_Exit:
+		r.Type = voluntaryExitSignType
+		r.VoluntaryExit = voluntaryExitToJson(obj.Exit)
+	case *validatorpb.SignRequest_SyncMessageBlockRoot:
+		r.Type = syncCommitteeMessageSignType
+		r.SyncCommitteeMessage = &syncCommitteeMessageJson{
+			BeaconBlockRoot: hexutil.Encode(obj.SyncMessageBlockRoot),
+			Slot:            uint64ToString(uint64(req.SigningSlot)),
+		}
+	case *validatorpb.SignRequest_SyncAggregatorSelectionData:
+		r.Type = syncCommitteeSelectionProofSignType
+		r.SyncAggregatorSelectionData = &syncAggregatorSelectionDataJson{
+			Slot:              uint64ToString(uint64(obj.SyncAggregatorSelectionData.Slot)),
+			SubcommitteeIndex: uint64ToString(obj.SyncAggregatorSelectionData.SubcommitteeIndex),
+		}
+	case *validatorpb.SignRequest_ContributionAndProof:
+		c := obj.ContributionAndProof.Contribution
+		r.Type = syncCommitteeContributionAndProofType
+		r.ContributionAndProof = &contributionAndProofJson{
+			AggregatorIndex: uint64ToString(uint64(obj.ContributionAndProof.AggregatorIndex)),
+			SelectionProof:  hexutil.Encode(obj.ContributionAndProof.SelectionProof),
+			Contribution: &syncCommitteeContributionJson{
+				Slot:              uint64ToString(uint64(c.Slot)),
+				BeaconBlockRoot:   hexutil.Encode(c.BlockRoot),
+				SubcommitteeIndex: uint64ToString(c.SubcommitteeIndex),
+				AggregationBits:   hexutil.Encode(c.AggregationBits),
+				Signature:         hexutil.Encode(c.Signature),
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unsupported sign request object type %T", req.Object)
+	}
+	return r, nil
+}
+
+func beaconBlockToJson(slot types.Slot, proposerIndex types.ValidatorIndex, parentRoot, stateRoot []byte) *beaconBlockJson {
+	return &beaconBlockJson{
+		Slot:          uint64ToString(uint64(slot)),
+		ProposerIndex: uint64ToString(uint64(proposerIndex)),
+		ParentRoot:    hexutil.Encode(parentRoot),
+		StateRoot:     hexutil.Encode(stateRoot),
+	}
+}
+
+func beaconBlockBodyToJson(b *ethpb.BeaconBlockBody) *beaconBlockBodyJson {
+	return operationsToJson(
+		b.RandaoReveal, b.Eth1Data, b.Graffiti, b.ProposerSlashings, b.AttesterSlashings, b.Attestations, b.Deposits, b.VoluntaryExits,
+	)
+}
+
+func beaconBlockBodyAltairToJson(b *ethpb.BeaconBlockBodyAltair) *beaconBlockBodyJson {
+	body := operationsToJson(
+		b.RandaoReveal, b.Eth1Data, b.Graffiti, b.ProposerSlashings, b.AttesterSlashings, b.Attestations, b.Deposits, b.VoluntaryExits,
+	)
+	body.SyncAggregate = &syncAggregateJson{
+		SyncCommitteeBits:      hexutil.Encode(b.SyncAggregate.SyncCommitteeBits),
+		SyncCommitteeSignature: hexutil.Encode(b.SyncAggregate.SyncCommitteeSignature),
+	}
+	return body
+}
+
+func operationsToJson(
+	randaoReveal []byte,
+	eth1Data *ethpb.Eth1Data,
+	graffiti []byte,
+	proposerSlashings []*ethpb.ProposerSlashing,
+	attesterSlashings []*ethpb.AttesterSlashing,
+	attestations []*ethpb.Attestation,
+	deposits []*ethpb.Deposit,
+	exits []*ethpb.SignedVoluntaryExit,
+) *beaconBlockBodyJson {
+	body := &beaconBlockBodyJson{
+		RandaoReveal: hexutil.Encode(randaoReveal),
+		Eth1Data: &eth1DataJson{
+			DepositRoot:  hexutil.Encode(eth1Data.DepositRoot),
+			DepositCount: uint64ToString(eth1Data.DepositCount),
+			BlockHash:    hexutil.Encode(eth1Data.BlockHash),
+		},
+		Graffiti:          hexutil.Encode(graffiti),
+		ProposerSlashings: make([]*proposerSlashingJson, len(proposerSlashings)),
+		AttesterSlashings: make([]*attesterSlashingJson, len(attesterSlashings)),
+		Attestations:      make([]*attestationJson, len(attestations)),
+		Deposits:          make([]*depositJson, len(deposits)),
+		VoluntaryExits:    make([]*signedVoluntaryExitJson, len(exits)),
+	}
+	for i, s := range proposerSlashings {
+		body.ProposerSlashings[i] = &proposerSlashingJson{
+			Header1: signedBeaconBlockHeaderToJson(s.Header_1),
+			Header2: signedBeaconBlockHeaderToJson(s.Header_2),
+		}
+	}
+	for i, s := range attesterSlashings {
+		body.AttesterSlashings[i] = &attesterSlashingJson{
+			Attestation1: indexedAttestationToJson(s.Attestation_1),
+			Attestation2: indexedAttestationToJson(s.Attestation_2),
+		}
+	}
+	for i, a := range attestations {
+		body.Attestations[i] = attestationToJson(a)
+	}
+	for i, d := range deposits {
+		proof := make([]string, len(d.Proof))
+		for j, p := range d.Proof {
+			proof[j] = hexutil.Encode(p)
+		}
+		body.Deposits[i] = &depositJson{
+			Proof: proof,
+			Data: &depositDataJson{
+				PublicKey:             hexutil.Encode(d.Data.PublicKey),
+				WithdrawalCredentials: hexutil.Encode(d.Data.WithdrawalCredentials),
+				Amount:                uint64ToString(d.Data.Amount),
+				Signature:             hexutil.Encode(d.Data.Signature),
+			},
+		}
+	}
+	for i, e := range exits {
+		body.VoluntaryExits[i] = &signedVoluntaryExitJson{
+			Exit:      voluntaryExitToJson(e.Exit),
+			Signature: hexutil.Encode(e.Signature),
+		}
+	}
+	return body
+}
+
+func signedBeaconBlockHeaderToJson(h *ethpb.SignedBeaconBlockHeader) *signedBeaconBlockHeaderJson {
+	return &signedBeaconBlockHeaderJson{
+		Header: &beaconBlockHeaderJson{
+			Slot:          uint64ToString(uint64(h.Header.Slot)),
+			ProposerIndex: uint64ToString(uint64(h.Header.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(h.Header.ParentRoot),
+			StateRoot:     hexutil.Encode(h.Header.StateRoot),
+			BodyRoot:      hexutil.Encode(h.Header.BodyRoot),
+		},
+		Signature: hexutil.Encode(h.Signature),
+	}
+}
+
+func indexedAttestationToJson(a *ethpb.IndexedAttestation) *indexedAttestationJson {
+	indices := make([]string, len(a.AttestingIndices))
+	for i, index := range a.AttestingIndices {
+		indices[i] = uint64ToString(index)
+	}
+	return &indexedAttestationJson{
+		AttestingIndices: indices,
+		Data:             attestationDataToJson(a.Data),
+		Signature:        hexutil.Encode(a.Signature),
+	}
+}
+
+func attestationToJson(a *ethpb.Attestation) *attestationJson {
+	return &attestationJson{
+		AggregationBits: hexutil.Encode(a.AggregationBits),
+		Data:            attestationDataToJson(a.Data),
+		Signature:       hexutil.Encode(a.Signature),
+	}
+}
+
+func attestationDataToJson(d *ethpb.AttestationData) *attestationDataJson {
+	return &attestationDataJson{
+		Slot:            uint64ToString(uint64(d.Slot)),
+		CommitteeIndex:  uint64ToString(uint64(d.CommitteeIndex)),
+		BeaconBlockRoot: hexutil.Encode(d.BeaconBlockRoot),
+		Source: &checkpointJson{
+			Epoch: uint64ToString(uint64(d.Source.Epoch)),
+			Root:  hexutil.Encode(d.Source.Root),
+		},
+		Target: &checkpointJson{
+			Epoch: uint64ToString(uint64(d.Target.Epoch)),
+			Root:  hexutil.Encode(d.Target.Root),
+		},
+	}
+}
+
+func voluntaryExitToJson(e *ethpb.VoluntaryExit) *voluntaryExitJson {
+	return &voluntaryExitJson{
+		Epoch:          uint64ToString(uint64(e.Epoch)),
+		ValidatorIndex: uint64ToString(uint64(e.ValidatorIndex)),
+	}
+}
+
+func uint64ToString(i uint64) string {
+	return strconv.FormatUint(i, 10)
+}
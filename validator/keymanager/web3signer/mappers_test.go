@@ -0,0 +1,176 @@
+package web3signer
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	types "github.com/prysmaticlabs/eth2-types"
+	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
+	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"github.com/prysmaticlabs/prysm/shared/testutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestSignRequestToJson_ForkInfo(t *testing.T) {
+	params.SetupTestConfigCleanup(t)
+	cfg := params.BeaconConfig().Copy()
+	cfg.AltairForkEpoch = 10
+	cfg.ForkVersionSchedule = map[[4]byte]types.Epoch{
+		bytesutil.ToBytes4(cfg.GenesisForkVersion): 0,
+		bytesutil.ToBytes4(cfg.AltairForkVersion):  10,
+	}
+	params.OverrideBeaconConfig(cfg)
+	root := bytesutil.PadTo([]byte("genesis"), 32)
+
+	r, err := signRequestToJson(&validatorpb.SignRequest{
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 1},
+		SigningSlot: params.BeaconConfig().SlotsPerEpoch,
+	}, root)
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(cfg.GenesisForkVersion), r.ForkInfo.Fork.CurrentVersion)
+	assert.Equal(t, "0", r.ForkInfo.Fork.Epoch)
+	assert.Equal(t, hexutil.Encode(root), r.ForkInfo.GenesisValidatorsRoot)
+
+	r, err = signRequestToJson(&validatorpb.SignRequest{
+		SigningRoot: make([]byte, 32),
+		Object:      &validatorpb.SignRequest_Epoch{Epoch: 11},
+		SigningSlot: params.BeaconConfig().SlotsPerEpoch.Mul(11),
+	}, root)
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(cfg.GenesisForkVersion), r.ForkInfo.Fork.PreviousVersion)
+	assert.Equal(t, hexutil.Encode(cfg.AltairForkVersion), r.ForkInfo.Fork.CurrentVersion)
+	assert.Equal(t, "10", r.ForkInfo.Fork.Epoch)
+}
+
+func TestSignRequestToJson_ObjectTypes(t *testing.T) {
+	root := bytesutil.PadTo([]byte("root"), 32)
+	tests := []struct {
+		name     string
+		req      *validatorpb.SignRequest
+		wantType string
+		check    func(t *testing.T, r *signRequestJson)
+	}{
+		{
+			name:     "block",
+			req:      &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_Block{Block: testutil.NewBeaconBlock().Block}},
+			wantType: blockSignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				require.NotNil(t, r.Block)
+				assert.NotNil(t, r.Block.Body)
+			},
+		},
+		{
+			name:     "altair block",
+			req:      &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_BlockV2{BlockV2: testutil.NewBeaconBlockAltair().Block}},
+			wantType: blockV2SignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				require.NotNil(t, r.BeaconBlock)
+				assert.Equal(t, "ALTAIR", r.BeaconBlock.Version)
+				assert.NotNil(t, r.BeaconBlock.Block.Body.SyncAggregate)
+			},
+		},
+		{
+			name:     "attestation",
+			req:      &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_AttestationData{AttestationData: testutil.HydrateAttestationData(&ethpb.AttestationData{Slot: 3})}},
+			wantType: attestationSignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				assert.Equal(t, "3", r.Attestation.Slot)
+			},
+		},
+		{
+			name:     "aggregation slot",
+			req:      &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_Slot{Slot: 4}},
+			wantType: aggregationSlotSignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				assert.Equal(t, "4", r.AggregationSlot.Slot)
+			},
+		},
+		{
+			name: "aggregate and proof",
+			req: &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_AggregateAttestationAndProof{AggregateAttestationAndProof: &ethpb.AggregateAttestationAndProof{
+				AggregatorIndex: 5,
+				Aggregate:       testutil.NewAttestation(),
+				SelectionProof:  make([]byte, 96),
+			}}},
+			wantType: aggregateAndProofSignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				assert.Equal(t, "5", r.AggregateAndProof.AggregatorIndex)
+				assert.NotNil(t, r.AggregateAndProof.Aggregate)
+			},
+		},
+		{
+			name:     "randao reveal",
+			req:      &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_Epoch{Epoch: 6}},
+			wantType: randaoRevealSignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				assert.Equal(t, "6", r.RandaoReveal.Epoch)
+			},
+		},
+		{
+			name:     "voluntary exit",
+			req:      &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_Exit{Exit: &ethpb.VoluntaryExit{Epoch: 7, ValidatorIndex: 8}}},
+			wantType: voluntaryExitSignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				assert.Equal(t, "7", r.VoluntaryExit.Epoch)
+				assert.Equal(t, "8", r.VoluntaryExit.ValidatorIndex)
+			},
+		},
+		{
+			name:     "sync committee message",
+			req:      &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_SyncMessageBlockRoot{SyncMessageBlockRoot: root}},
+			wantType: syncCommitteeMessageSignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				assert.Equal(t, hexutil.Encode(root), r.SyncCommitteeMessage.BeaconBlockRoot)
+				assert.Equal(t, "9", r.SyncCommitteeMessage.Slot)
+			},
+		},
+		{
+			name: "sync committee selection proof",
+			req: &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_SyncAggregatorSelectionData{SyncAggregatorSelectionData: &ethpb.SyncAggregatorSelectionData{
+				Slot:              9,
+				SubcommitteeIndex: 2,
+			}}},
+			wantType: syncCommitteeSelectionProofSignType,
+			check: func(t *testing.T, r *signRequestJson) {
+				assert.Equal(t, "2", r.SyncAggregatorSelectionData.SubcommitteeIndex)
+			},
+		},
+		{
+			name: "sync committee contribution and proof",
+			req: &validatorpb.SignRequest{SigningRoot: root, SigningSlot: 9, Object: &validatorpb.SignRequest_ContributionAndProof{ContributionAndProof: &ethpb.ContributionAndProof{
+				AggregatorIndex: 10,
+				Contribution: &ethpb.SyncCommitteeContribution{
+					Slot:              9,
+					BlockRoot:         root,
+					SubcommitteeIndex: 1,
+					AggregationBits:   []byte{0xff},
+					Signature:         make([]byte, 96),
+				},
+				SelectionProof: make([]byte, 96),
+			}}},
+			wantType: syncCommitteeContributionAndProofType,
+			check: func(t *testing.T, r *signRequestJson) {
+				assert.Equal(t, "10", r.ContributionAndProof.AggregatorIndex)
+				assert.Equal(t, "0xff", r.ContributionAndProof.Contribution.AggregationBits)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r, err := signRequestToJson(tt.req, root)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantType, r.Type)
+			assert.Equal(t, hexutil.Encode(root), r.SigningRoot)
+			tt.check(t, r)
+		})
+	}
+}
+
+func TestSignRequestToJson_UnsupportedObject(t *testing.T) {
+	_, err := signRequestToJson(&validatorpb.SignRequest{SigningRoot: make([]byte, 32)}, make([]byte, 32))
+	assert.ErrorContains(t, "unsupported sign request object type", err)
+}
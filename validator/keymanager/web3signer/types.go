@@ -0,0 +1,193 @@
+package web3signer
+
+// Sign request types of the Web3Signer Ethereum consensus signing API, as defined by EIP-3030.
+const (
+	blockSignType                         = "BLOCK"
+	blockV2SignType                       = "BLOCK_V2"
+	attestationSignType                   = "ATTESTATION"
+	aggregationSlotSignType               = "AGGREGATION_SLOT"
+	aggregateAndProofSignType             = "AGGREGATE_AND_PROOF"
+	randaoRevealSignType                  = "RANDAO_REVEAL"
+	voluntaryExitSignType                 = "VOLUNTARY_EXIT"
+	syncCommitteeMessageSignType          = "SYNC_COMMITTEE_MESSAGE"
+	syncCommitteeSelectionProofSignType   = "SYNC_COMMITTEE_SELECTION_PROOF"
+	syncCommitteeContributionAndProofType = "SYNC_COMMITTEE_CONTRIBUTION_AND_PROOF"
+)
+
+// signRequestJson is the body of a request to the /api/v1/eth2/sign/{identifier} endpoint.
+// Exactly one of the object fields is set, depending on the type of the request.
+type signRequestJson struct {
+	Type                        string                           `json:"type"`
+	ForkInfo                    *forkInfoJson                    `json:"fork_info,omitempty"`
+	SigningRoot                 string                           `json:"signingRoot,omitempty"`
+	Block                       *beaconBlockJson                 `json:"block,omitempty"`
+	BeaconBlock                 *beaconBlockV2Json               `json:"beacon_block,omitempty"`
+	Attestation                 *attestationDataJson             `json:"attestation,omitempty"`
+	AggregationSlot             *aggregationSlotJson             `json:"aggregation_slot,omitempty"`
+	AggregateAndProof           *aggregateAndProofJson           `json:"aggregate_and_proof,omitempty"`
+	RandaoReveal                *randaoRevealJson                `json:"randao_reveal,omitempty"`
+	VoluntaryExit               *voluntaryExitJson               `json:"voluntary_exit,omitempty"`
+	SyncCommitteeMessage        *syncCommitteeMessageJson        `json:"sync_committee_message,omitempty"`
+	SyncAggregatorSelectionData *syncAggregatorSelectionDataJson `json:"sync_aggregator_selection_data,omitempty"`
+	ContributionAndProof        *contributionAndProofJson        `json:"contribution_and_proof,omitempty"`
+}
+
+// signResponseJson is the body of a successful response of the signing endpoint.
+type signResponseJson struct {
+	Signature string `json:"signature"`
+}
+
+type forkInfoJson struct {
+	Fork                  *forkJson `json:"fork"`
+	GenesisValidatorsRoot string    `json:"genesis_validators_root"`
+}
+
+type forkJson struct {
+	PreviousVersion string `json:"previous_version"`
+	CurrentVersion  string `json:"current_version"`
+	Epoch           string `json:"epoch"`
+}
+
+type beaconBlockV2Json struct {
+	Version string           `json:"version"`
+	Block   *beaconBlockJson `json:"block"`
+}
+
+type beaconBlockJson struct {
+	Slot          string               `json:"slot"`
+	ProposerIndex string               `json:"proposer_index"`
+	ParentRoot    string               `json:"parent_root"`
+	StateRoot     string               `json:"state_root"`
+	Body          *beaconBlockBodyJson `json:"body"`
+}
+
+type beaconBlockBodyJson struct {
+	RandaoReveal      string                     `json:"randao_reveal"`
+	Eth1Data          *eth1DataJson              `json:"eth1_data"`
+	Graffiti          string                     `json:"graffiti"`
+	ProposerSlashings []*proposerSlashingJson    `json:"proposer_slashings"`
+	AttesterSlashings []*attesterSlashingJson    `json:"attester_slashings"`
+	Attestations      []*attestationJson         `json:"attestations"`
+	Deposits          []*depositJson             `json:"deposits"`
+	VoluntaryExits    []*signedVoluntaryExitJson `json:"voluntary_exits"`
+	SyncAggregate     *syncAggregateJson         `json:"sync_aggregate,omitempty"`
+}
+
+type eth1DataJson struct {
+	DepositRoot  string `json:"deposit_root"`
+	DepositCount string `json:"deposit_count"`
+	BlockHash    string `json:"block_hash"`
+}
+
+type proposerSlashingJson struct {
+	Header1 *signedBeaconBlockHeaderJson `json:"signed_header_1"`
+	Header2 *signedBeaconBlockHeaderJson `json:"signed_header_2"`
+}
+
+type signedBeaconBlockHeaderJson struct {
+	Header    *beaconBlockHeaderJson `json:"message"`
+	Signature string                 `json:"signature"`
+}
+
+type beaconBlockHeaderJson struct {
+	Slot          string `json:"slot"`
+	ProposerIndex string `json:"proposer_index"`
+	ParentRoot    string `json:"parent_root"`
+	StateRoot     string `json:"state_root"`
+	BodyRoot      string `json:"body_root"`
+}
+
+type attesterSlashingJson struct {
+	Attestation1 *indexedAttestationJson `json:"attestation_1"`
+	Attestation2 *indexedAttestationJson `json:"attestation_2"`
+}
+
+type indexedAttestationJson struct {
+	AttestingIndices []string             `json:"attesting_indices"`
+	Data             *attestationDataJson `json:"data"`
+	Signature        string               `json:"signature"`
+}
+
+type attestationJson struct {
+	AggregationBits string               `json:"aggregation_bits"`
+	Data            *attestationDataJson `json:"data"`
+	Signature       string               `json:"signature"`
+}
+
+type attestationDataJson struct {
+	Slot            string          `json:"slot"`
+	CommitteeIndex  string          `json:"index"`
+	BeaconBlockRoot string          `json:"beacon_block_root"`
+	Source          *checkpointJson `json:"source"`
+	Target          *checkpointJson `json:"target"`
+}
+
+type checkpointJson struct {
+	Epoch string `json:"epoch"`
+	Root  string `json:"root"`
+}
+
+type depositJson struct {
+	Proof []string         `json:"proof"`
+	Data  *depositDataJson `json:"data"`
+}
+
+type depositDataJson struct {
+	PublicKey             string `json:"pubkey"`
+	WithdrawalCredentials string `json:"withdrawal_credentials"`
+	Amount                string `json:"amount"`
+	Signature             string `json:"signature"`
+}
+
+type signedVoluntaryExitJson struct {
+	Exit      *voluntaryExitJson `json:"message"`
+	Signature string             `json:"signature"`
+}
+
+type voluntaryExitJson struct {
+	Epoch          string `json:"epoch"`
+	ValidatorIndex string `json:"validator_index"`
+}
+
+type syncAggregateJson struct {
+	SyncCommitteeBits      string `json:"sync_committee_bits"`
+	SyncCommitteeSignature string `json:"sync_committee_signature"`
+}
+
+type aggregationSlotJson struct {
+	Slot string `json:"slot"`
+}
+
+type aggregateAndProofJson struct {
+	AggregatorIndex string           `json:"aggregator_index"`
+	Aggregate       *attestationJson `json:"aggregate"`
+	SelectionProof  string           `json:"selection_proof"`
+}
+
+type randaoRevealJson struct {
+	Epoch string `json:"epoch"`
+}
+
+type syncCommitteeMessageJson struct {
+	BeaconBlockRoot string `json:"beacon_block_root"`
+	Slot            string `json:"slot"`
+}
+
+type syncAggregatorSelectionDataJson struct {
+	Slot              string `json:"slot"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+}
+
+type contributionAndProofJson struct {
+	AggregatorIndex string                         `json:"aggregator_index"`
+	SelectionProof  string                         `json:"selection_proof"`
+	Contribution    *syncCommitteeContributionJson `json:"contribution"`
+}
+
+type syncCommitteeContributionJson struct {
+	Slot              string `json:"slot"`
+	BeaconBlockRoot   string `json:"beacon_block_root"`
+	SubcommitteeIndex string `json:"subcommittee_index"`
+	AggregationBits   string `json:"aggregation_bits"`
+	Signature         string `json:"signature"`
+}
@@ -17,6 +17,7 @@ import (
 	gwruntime "github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/pkg/errors"
 	"github.com/prysmaticlabs/prysm/cmd/validator/flags"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/shared"
@@ -35,11 +36,14 @@ import (
 	accountsiface "github.com/prysmaticlabs/prysm/validator/accounts/iface"
 	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
 	"github.com/prysmaticlabs/prysm/validator/client"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	g "github.com/prysmaticlabs/prysm/validator/graffiti"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
 	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	"github.com/prysmaticlabs/prysm/validator/rpc"
+	"github.com/prysmaticlabs/prysm/validator/rpc/apimiddleware"
 	slashingprotection "github.com/prysmaticlabs/prysm/validator/slashing-protection"
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/iface"
 	"github.com/prysmaticlabs/prysm/validator/web"
@@ -54,7 +58,7 @@ type ValidatorClient struct {
 	cliCtx            *cli.Context
 	ctx               context.Context
 	cancel            context.CancelFunc
-	db                *kv.Store
+	db                db.Database
 	services          *shared.ServiceRegistry // Lifecycle and service store.
 	lock              sync.RWMutex
 	wallet            *wallet.Wallet
@@ -221,7 +225,7 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 		if err := clearDB(cliCtx.Context, dataDir, forceClearFlag); err != nil {
 			return err
 		}
-	} else {
+	} else if !db.UsesPostgres(cliCtx) {
 		dataFile := filepath.Join(dataDir, kv.ProtectionDbFileName)
 		if !fileutil.FileExists(dataFile) {
 			log.Warnf("Slashing protection file %s is missing.\n"+
@@ -229,12 +233,7 @@ func (c *ValidatorClient) initializeFromCLI(cliCtx *cli.Context) error {
 				"Disregard this warning if this is the first time you are running this set of keys.", dataFile)
 		}
 	}
-	log.WithField("databasePath", dataDir).Info("Checking DB")
-
-	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{
-		PubKeys:         nil,
-		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
-	})
+	valDB, err := db.Open(cliCtx, dataDir)
 	if err != nil {
 		return errors.Wrap(err, "could not initialize db")
 	}
@@ -319,11 +318,7 @@ func (c *ValidatorClient) initializeForWeb(cliCtx *cli.Context) error {
 			return err
 		}
 	}
-	log.WithField("databasePath", dataDir).Info("Checking DB")
-	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{
-		PubKeys:         nil,
-		InitialMMapSize: cliCtx.Int(cmd.BoltMMapInitialSizeFlag.Name),
-	})
+	valDB, err := db.Open(cliCtx, dataDir)
 	if err != nil {
 		return errors.Wrap(err, "could not initialize db")
 	}
@@ -408,8 +403,14 @@ func (c *ValidatorClient) registerValidatorService(
 		}
 	}
 
+	proposerSettings, err := proposer.NewStore(c.cliCtx.String(flags.ProposerSettingsFileFlag.Name))
+	if err != nil {
+		return errors.Wrap(err, "could not load proposer settings")
+	}
+
 	v, err := client.NewValidatorService(c.cliCtx.Context, &client.Config{
 		Endpoint:                   endpoint,
+		BeaconApiEndpoint:          c.cliCtx.String(flags.BeaconRESTApiProviderFlag.Name),
 		DataDir:                    dataDir,
 		KeyManager:                 keyManager,
 		LogValidatorBalances:       logValidatorBalances,
@@ -425,7 +426,9 @@ func (c *ValidatorClient) registerValidatorService(
 		UseWeb:                     c.cliCtx.Bool(flags.EnableWebFlag.Name),
 		WalletInitializedFeed:      c.walletInitialized,
 		GraffitiStruct:             gStruct,
+		ProposerSettings:           proposerSettings,
 		LogDutyCountDown:           c.cliCtx.Bool(flags.EnableDutyCountDown.Name),
+		BroadcastSignedDuties:      c.cliCtx.Bool(flags.BroadcastSignedDutiesFlag.Name),
 	})
 	if err != nil {
 		return errors.Wrap(err, "could not initialize validator service")
@@ -476,6 +479,10 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, km keymanager.
 	walletDir := cliCtx.String(flags.WalletDirFlag.Name)
 	grpcHeaders := c.cliCtx.String(flags.GrpcHeadersFlag.Name)
 	clientCert := c.cliCtx.String(flags.CertFlag.Name)
+	keymanagerAPITokenPath := cliCtx.String(flags.KeymanagerAPITokenFile.Name)
+	if keymanagerAPITokenPath == "" {
+		keymanagerAPITokenPath = filepath.Join(walletDir, rpc.KeymanagerAPITokenFileName)
+	}
 	server := rpc.NewServer(cliCtx.Context, &rpc.Config{
 		ValDB:                    c.db,
 		Host:                     rpcHost,
@@ -498,6 +505,8 @@ func (c *ValidatorClient) registerRPCService(cliCtx *cli.Context, km keymanager.
 		ClientGrpcRetryDelay:     grpcRetryDelay,
 		ClientGrpcHeaders:        strings.Split(grpcHeaders, ","),
 		ClientWithCert:           clientCert,
+		KeymanagerAPITokenPath:   keymanagerAPITokenPath,
+		ProposerSettings:         vs.ProposerSettings(),
 	})
 	return c.services.RegisterService(server)
 }
@@ -525,6 +534,7 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		validatorpb.RegisterAccountsHandler,
 		validatorpb.RegisterBeaconHandler,
 		validatorpb.RegisterSlashingProtectionHandler,
+		validatorpb.RegisterProposerSettingsHandler,
 	}
 	mux := gwruntime.NewServeMux(
 		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
@@ -554,14 +564,37 @@ func (c *ValidatorClient) registerRPCGatewayService(cliCtx *cli.Context) error {
 		Patterns:      []string{"/accounts/", "/v2/"},
 		Mux:           mux,
 	}
+	// The standard keymanager API uses proto field names in its JSON, and is served
+	// with spec-compliant encoding through the API middleware.
+	keymanagerMux := gwruntime.NewServeMux(
+		gwruntime.WithMarshalerOption(gwruntime.MIMEWildcard, &gwruntime.HTTPBodyMarshaler{
+			Marshaler: &gwruntime.JSONPb{
+				MarshalOptions: protojson.MarshalOptions{
+					UseProtoNames:   true,
+					EmitUnpopulated: true,
+				},
+				UnmarshalOptions: protojson.UnmarshalOptions{
+					DiscardUnknown: true,
+				},
+			},
+		}),
+	)
+	keymanagerPbHandler := gateway.PbMux{
+		Registrations: []gateway.PbHandlerRegistration{ethpbservice.RegisterKeyManagementHandler},
+		Patterns:      []string{"/eth/v1/"},
+		Mux:           keymanagerMux,
+	}
+	keymanagerAPIAddress := fmt.Sprintf("%s:%d", gatewayHost, cliCtx.Int(flags.KeymanagerAPIPort.Name))
 
 	gw := gateway.New(
 		cliCtx.Context,
-		[]gateway.PbMux{pbHandler},
+		[]gateway.PbMux{pbHandler, keymanagerPbHandler},
 		muxHandler,
 		rpcAddr,
 		gatewayAddress,
-	).WithAllowedOrigins(allowedOrigins).WithMaxCallRecvMsgSize(maxCallSize)
+	).WithAllowedOrigins(allowedOrigins).
+		WithMaxCallRecvMsgSize(maxCallSize).
+		WithApiMiddleware(keymanagerAPIAddress, &apimiddleware.ValidatorEndpointFactory{})
 
 	return c.services.RegisterService(gw)
 }
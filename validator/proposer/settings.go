@@ -0,0 +1,178 @@
+// Package proposer defines the proposer settings file of the validator client, which configures
+// the graffiti, fee recipient, gas limit and enabled flag of each validator by public key.
+//
+// An example file, where the default config applies to validators without their own entry
+// and to the unset fields of the entries:
+//
+//  default_config:
+//    fee_recipient: "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"
+//    graffiti: "prysm"
+//  proposer_config:
+//    "0xa057816155ad77931185101128655c0191bd0214c201ca48ed887f6c4c6adf334070efcd75140eada5ac83a92506dd7a":
+//      graffiti: "hex:0x1234"
+//      enabled: false
+package proposer
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/graffiti"
+	"gopkg.in/yaml.v2"
+)
+
+// maxGraffitiLength is the size of the graffiti field of a beacon block.
+const maxGraffitiLength = 32
+
+// Option holds the proposer settings of a validator, or the default settings of all validators.
+type Option struct {
+	FeeRecipient string `yaml:"fee_recipient,omitempty"`
+	Graffiti     string `yaml:"graffiti,omitempty"`
+	GasLimit     uint64 `yaml:"gas_limit,omitempty"`
+	// Enabled is a pointer so that an unset value falls back to the default settings.
+	Enabled *bool `yaml:"enabled,omitempty"`
+}
+
+// SettingsFile is the content of a proposer settings file. Proposer configs are keyed
+// by the hex encoded public key of the validator.
+type SettingsFile struct {
+	DefaultConfig  *Option            `yaml:"default_config,omitempty"`
+	ProposerConfig map[string]*Option `yaml:"proposer_config,omitempty"`
+}
+
+// Settings are the proposer settings of a validator, resolved from its own
+// proposer config and the default config.
+type Settings struct {
+	FeeRecipient common.Address
+	Graffiti     []byte
+	GasLimit     uint64
+	Enabled      bool
+}
+
+// ParseSettingsFile parses and validates the proposer settings file at the given path.
+func ParseSettingsFile(f string) (*SettingsFile, error) {
+	enc, err := ioutil.ReadFile(f) // #nosec G304
+	if err != nil {
+		return nil, err
+	}
+	s := &SettingsFile{}
+	if err := yaml.UnmarshalStrict(enc, s); err != nil {
+		return nil, errors.Wrap(err, "could not unmarshal proposer settings")
+	}
+	if err := s.normalize(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Validate checks the fields of a proposer option.
+func (o *Option) Validate() error {
+	if o.FeeRecipient != "" && !common.IsHexAddress(o.FeeRecipient) {
+		return fmt.Errorf("fee recipient %s is not a valid address", o.FeeRecipient)
+	}
+	if len(graffiti.ParseHexGraffiti(o.Graffiti)) > maxGraffitiLength {
+		return fmt.Errorf("graffiti %s is longer than %d bytes", o.Graffiti, maxGraffitiLength)
+	}
+	return nil
+}
+
+// Copy returns a deep copy of the option.
+func (o *Option) Copy() *Option {
+	if o == nil {
+		return nil
+	}
+	cp := *o
+	if o.Enabled != nil {
+		enabled := *o.Enabled
+		cp.Enabled = &enabled
+	}
+	return &cp
+}
+
+// Copy returns a deep copy of the settings file.
+func (s *SettingsFile) Copy() *SettingsFile {
+	cp := &SettingsFile{
+		DefaultConfig:  s.DefaultConfig.Copy(),
+		ProposerConfig: make(map[string]*Option, len(s.ProposerConfig)),
+	}
+	for k, o := range s.ProposerConfig {
+		cp.ProposerConfig[k] = o.Copy()
+	}
+	return cp
+}
+
+// Settings resolves the proposer settings of a validator.
+func (s *SettingsFile) Settings(pubKey [48]byte) *Settings {
+	settings := &Settings{Enabled: true}
+	for _, o := range []*Option{s.DefaultConfig, s.ProposerConfig[publicKeyToString(pubKey)]} {
+		if o == nil {
+			continue
+		}
+		if o.FeeRecipient != "" {
+			settings.FeeRecipient = common.HexToAddress(o.FeeRecipient)
+		}
+		if o.Graffiti != "" {
+			settings.Graffiti = []byte(graffiti.ParseHexGraffiti(o.Graffiti))
+		}
+		if o.GasLimit != 0 {
+			settings.GasLimit = o.GasLimit
+		}
+		if o.Enabled != nil {
+			settings.Enabled = *o.Enabled
+		}
+	}
+	return settings
+}
+
+// normalize validates the settings and lowercases the public keys of the proposer configs,
+// so that they can be looked up by key.
+func (s *SettingsFile) normalize() error {
+	if s.DefaultConfig != nil {
+		if err := s.DefaultConfig.Validate(); err != nil {
+			return errors.Wrap(err, "invalid default config")
+		}
+	}
+	normalized := make(map[string]*Option, len(s.ProposerConfig))
+	for k, o := range s.ProposerConfig {
+		pubKey, err := publicKeyFromString(k)
+		if err != nil {
+			return err
+		}
+		if o == nil {
+			o = &Option{}
+		}
+		if err := o.Validate(); err != nil {
+			return errors.Wrapf(err, "invalid proposer config for %s", k)
+		}
+		key := publicKeyToString(pubKey)
+		if _, ok := normalized[key]; ok {
+			return fmt.Errorf("duplicate proposer config for %s", k)
+		}
+		normalized[key] = o
+	}
+	s.ProposerConfig = normalized
+	return nil
+}
+
+func publicKeyFromString(s string) ([48]byte, error) {
+	if !strings.HasPrefix(s, "0x") {
+		s = "0x" + s
+	}
+	pubKey, err := hexutil.Decode(strings.ToLower(s))
+	if err != nil {
+		return [48]byte{}, errors.Wrapf(err, "could not decode public key %s", s)
+	}
+	if len(pubKey) != 48 {
+		return [48]byte{}, fmt.Errorf("public key %s has length %d, expected 48", s, len(pubKey))
+	}
+	return bytesutil.ToBytes48(pubKey), nil
+}
+
+func publicKeyToString(pubKey [48]byte) string {
+	return hexutil.Encode(pubKey[:])
+}
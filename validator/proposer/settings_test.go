@@ -0,0 +1,91 @@
+package proposer
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+var (
+	pubKey1 = bytesutil.ToBytes48([]byte{1})
+	pubKey2 = bytesutil.ToBytes48([]byte{2})
+)
+
+func writeSettingsFile(t *testing.T, content string) string {
+	path := filepath.Join(t.TempDir(), "proposer-settings.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestParseSettingsFile(t *testing.T) {
+	path := writeSettingsFile(t, `
+default_config:
+  fee_recipient: "0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"
+  graffiti: "default"
+  gas_limit: 30000000
+proposer_config:
+  "`+hexutil.Encode(pubKey1[:])[2:]+`":
+    graffiti: "hex:0x6b6579"
+    enabled: false
+`)
+	s, err := ParseSettingsFile(path)
+	require.NoError(t, err)
+
+	settings := s.Settings(pubKey1)
+	assert.Equal(t, common.HexToAddress("0x50155530FCE8a85ec7055A5F8b2bE214B3DaeFd3"), settings.FeeRecipient)
+	assert.Equal(t, "key", string(settings.Graffiti))
+	assert.Equal(t, uint64(30000000), settings.GasLimit)
+	assert.Equal(t, false, settings.Enabled)
+
+	settings = s.Settings(pubKey2)
+	assert.Equal(t, "default", string(settings.Graffiti))
+	assert.Equal(t, true, settings.Enabled)
+}
+
+func TestParseSettingsFile_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr string
+	}{
+		{
+			name:    "unknown field",
+			content: "default_config:\n  fee: 1\n",
+			wantErr: "could not unmarshal proposer settings",
+		},
+		{
+			name:    "invalid fee recipient",
+			content: "default_config:\n  fee_recipient: \"0x1234\"\n",
+			wantErr: "fee recipient 0x1234 is not a valid address",
+		},
+		{
+			name:    "graffiti too long",
+			content: "default_config:\n  graffiti: \"" + strings.Repeat("a", 33) + "\"\n",
+			wantErr: "is longer than 32 bytes",
+		},
+		{
+			name:    "invalid public key",
+			content: "proposer_config:\n  \"0x1234\":\n    enabled: false\n",
+			wantErr: "public key 0x1234 has length 2, expected 48",
+		},
+		{
+			name: "duplicate public key",
+			content: "proposer_config:\n  \"" + hexutil.Encode(pubKey1[:]) + "\":\n    enabled: false\n" +
+				"  \"" + hexutil.Encode(pubKey1[:])[2:] + "\":\n    enabled: true\n",
+			wantErr: "duplicate proposer config",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSettingsFile(writeSettingsFile(t, tt.content))
+			assert.ErrorContains(t, tt.wantErr, err)
+		})
+	}
+}
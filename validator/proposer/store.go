@@ -0,0 +1,204 @@
+package proposer
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/asyncutil"
+	"github.com/prysmaticlabs/prysm/shared/fileutil"
+	"github.com/prysmaticlabs/prysm/shared/params"
+	"gopkg.in/yaml.v2"
+)
+
+// reloadDebounceInterval debounces the file system events of a settings file being written,
+// as editors typically fire several events when saving a file.
+const reloadDebounceInterval = time.Second
+
+// ErrNoSettingsFile is returned when changing the settings of a store without a settings file.
+var ErrNoSettingsFile = errors.New("no proposer settings file configured")
+
+// Store holds the proposer settings of the validator client. The settings are reloaded when
+// their file changes, and changes made through the store are written back to the file.
+type Store struct {
+	path     string
+	lock     sync.RWMutex
+	settings *SettingsFile
+}
+
+// NewStore loads the proposer settings file at the given path, starting with empty settings
+// if the file does not exist yet. A store with an empty path holds empty settings which
+// can't be changed.
+func NewStore(path string) (*Store, error) {
+	s := &Store{
+		path:     path,
+		settings: &SettingsFile{ProposerConfig: make(map[string]*Option)},
+	}
+	if path == "" || !fileutil.FileExists(path) {
+		return s, nil
+	}
+	settings, err := ParseSettingsFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not parse proposer settings file %s", path)
+	}
+	s.settings = settings
+	return s, nil
+}
+
+// Settings returns the resolved proposer settings of a validator.
+func (s *Store) Settings(pubKey [48]byte) *Settings {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.settings.Settings(pubKey)
+}
+
+// SettingsFile returns a copy of the proposer settings.
+func (s *Store) SettingsFile() *SettingsFile {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.settings.Copy()
+}
+
+// SetDefaultOption replaces the default proposer settings.
+func (s *Store) SetDefaultOption(o *Option) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+	return s.update(func(settings *SettingsFile) bool {
+		settings.DefaultConfig = o.Copy()
+		return true
+	})
+}
+
+// SetOption replaces the proposer settings of a validator.
+func (s *Store) SetOption(pubKey [48]byte, o *Option) error {
+	if err := o.Validate(); err != nil {
+		return err
+	}
+	return s.update(func(settings *SettingsFile) bool {
+		settings.ProposerConfig[publicKeyToString(pubKey)] = o.Copy()
+		return true
+	})
+}
+
+// DeleteOption deletes the proposer settings of a validator, which then uses the
+// default settings. It returns whether the validator had settings.
+func (s *Store) DeleteOption(pubKey [48]byte) (bool, error) {
+	found := false
+	err := s.update(func(settings *SettingsFile) bool {
+		key := publicKeyToString(pubKey)
+		_, found = settings.ProposerConfig[key]
+		delete(settings.ProposerConfig, key)
+		return found
+	})
+	return found, err
+}
+
+// Watch reloads the proposer settings whenever their file changes, until the context is canceled.
+// The directory of the file is watched, so that files replaced by a rename are picked up as well.
+func (s *Store) Watch(ctx context.Context) {
+	if s.path == "" {
+		return
+	}
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		log.WithError(err).Error("Could not initialize file watcher")
+		return
+	}
+	defer func() {
+		if err := watcher.Close(); err != nil {
+			log.WithError(err).Error("Could not close file watcher")
+		}
+	}()
+	dir := filepath.Dir(s.path)
+	if err := watcher.Add(dir); err != nil {
+		log.WithError(err).Errorf("Could not add directory %s to file watcher", dir)
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	// The channel is not closed: the debouncer stops when the context is canceled on return, and
+	// would otherwise run a reload for the zero value received from the closed channel.
+	fileChangesChan := make(chan interface{}, 100)
+
+	go asyncutil.Debounce(ctx, reloadDebounceInterval, fileChangesChan, func(interface{}) {
+		if err := s.reload(); err != nil {
+			log.WithError(err).Error("Could not reload proposer settings, keeping the previous settings")
+		}
+	})
+	for {
+		select {
+		case event := <-watcher.Events:
+			if filepath.Clean(event.Name) == filepath.Clean(s.path) {
+				fileChangesChan <- event
+			}
+		case err := <-watcher.Errors:
+			log.WithError(err).Errorf("Could not watch for file changes for: %s", s.path)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// reload replaces the proposer settings with the content of their file.
+func (s *Store) reload() error {
+	if !fileutil.FileExists(s.path) {
+		return nil
+	}
+	settings, err := ParseSettingsFile(s.path)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	s.settings = settings
+	s.lock.Unlock()
+	log.WithField("path", s.path).Info("Reloaded proposer settings")
+	return nil
+}
+
+// update applies a change to a copy of the settings, and writes the changed settings to the
+// file before they take effect. The change reports whether it modified the settings.
+func (s *Store) update(change func(settings *SettingsFile) bool) error {
+	if s.path == "" {
+		return ErrNoSettingsFile
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	settings := s.settings.Copy()
+	if !change(settings) {
+		return nil
+	}
+	enc, err := yaml.Marshal(settings)
+	if err != nil {
+		return errors.Wrap(err, "could not marshal proposer settings")
+	}
+	// The file is written by a rename, so that the watcher never reads a partially written file.
+	tmp, err := ioutil.TempFile(filepath.Dir(s.path), filepath.Base(s.path)+".tmp")
+	if err != nil {
+		return errors.Wrap(err, "could not create proposer settings file")
+	}
+	defer func() {
+		if err := os.Remove(tmp.Name()); err != nil && !os.IsNotExist(err) {
+			log.WithError(err).Error("Could not remove temporary proposer settings file")
+		}
+	}()
+	if _, err := tmp.Write(enc); err != nil {
+		return errors.Wrap(err, "could not write proposer settings file")
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Wrap(err, "could not write proposer settings file")
+	}
+	if err := os.Chmod(tmp.Name(), params.BeaconIoConfig().ReadWritePermissions); err != nil {
+		return errors.Wrap(err, "could not set proposer settings file permissions")
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return errors.Wrap(err, "could not replace proposer settings file")
+	}
+	s.settings = settings
+	return nil
+}
@@ -0,0 +1,67 @@
+package proposer
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+)
+
+func TestStore_NoFile(t *testing.T) {
+	s, err := NewStore("")
+	require.NoError(t, err)
+	assert.Equal(t, true, s.Settings(pubKey1).Enabled)
+	assert.Equal(t, ErrNoSettingsFile, s.SetDefaultOption(&Option{Graffiti: "default"}))
+}
+
+func TestStore_Update(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "proposer-settings.yaml")
+	s, err := NewStore(path)
+	require.NoError(t, err)
+
+	require.NoError(t, s.SetDefaultOption(&Option{Graffiti: "default"}))
+	disabled := false
+	require.NoError(t, s.SetOption(pubKey1, &Option{Enabled: &disabled}))
+	assert.ErrorContains(t, "is not a valid address", s.SetOption(pubKey2, &Option{FeeRecipient: "0x12"}))
+	assert.Equal(t, false, s.Settings(pubKey1).Enabled)
+	assert.Equal(t, "default", string(s.Settings(pubKey1).Graffiti))
+
+	// The changes are written to the settings file.
+	persisted, err := ParseSettingsFile(path)
+	require.NoError(t, err)
+	assert.DeepEqual(t, s.SettingsFile(), persisted)
+
+	found, err := s.DeleteOption(pubKey1)
+	require.NoError(t, err)
+	assert.Equal(t, true, found)
+	found, err = s.DeleteOption(pubKey1)
+	require.NoError(t, err)
+	assert.Equal(t, false, found)
+	assert.Equal(t, true, s.Settings(pubKey1).Enabled)
+}
+
+func TestStore_Watch(t *testing.T) {
+	path := writeSettingsFile(t, "default_config:\n  graffiti: \"before\"\n")
+	s, err := NewStore(path)
+	require.NoError(t, err)
+	assert.Equal(t, "before", string(s.Settings(pubKey1).Graffiti))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go s.Watch(ctx)
+	// Give the watcher time to start before changing the file.
+	time.Sleep(100 * time.Millisecond)
+
+	// Invalid settings are ignored.
+	require.NoError(t, ioutil.WriteFile(path, []byte("default_config:\n  unknown: 1\n"), 0600))
+	time.Sleep(reloadDebounceInterval + 500*time.Millisecond)
+	assert.Equal(t, "before", string(s.Settings(pubKey1).Graffiti))
+
+	require.NoError(t, ioutil.WriteFile(path, []byte("default_config:\n  graffiti: \"after\"\n"), 0600))
+	time.Sleep(reloadDebounceInterval + 500*time.Millisecond)
+	assert.Equal(t, "after", string(s.Settings(pubKey1).Graffiti))
+}
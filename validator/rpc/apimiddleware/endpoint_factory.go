@@ -0,0 +1,46 @@
+package apimiddleware
+
+import (
+	"github.com/pkg/errors"
+	"github.com/prysmaticlabs/prysm/shared/gateway"
+)
+
+// ValidatorEndpointFactory creates endpoints used for running the standard keymanager API calls through the API Middleware.
+type ValidatorEndpointFactory struct {
+}
+
+func (f *ValidatorEndpointFactory) IsNil() bool {
+	return f == nil
+}
+
+// Paths is a collection of all valid keymanager API paths.
+func (*ValidatorEndpointFactory) Paths() []string {
+	return []string{
+		"/eth/v1/keystores",
+		"/eth/v1/remotekeys",
+	}
+}
+
+// Create returns a new endpoint for the provided API path.
+func (*ValidatorEndpointFactory) Create(path string) (*gateway.Endpoint, error) {
+	endpoint := gateway.DefaultEndpoint()
+	switch path {
+	case "/eth/v1/keystores":
+		endpoint.GetResponse = &listKeystoresResponseJson{}
+		endpoint.PostRequest = &importKeystoresRequestJson{}
+		endpoint.PostResponse = &importKeystoresResponseJson{}
+		endpoint.DeleteRequest = &deleteKeystoresRequestJson{}
+		endpoint.DeleteResponse = &deleteKeystoresResponseJson{}
+	case "/eth/v1/remotekeys":
+		endpoint.GetResponse = &listRemoteKeysResponseJson{}
+		endpoint.PostRequest = &importRemoteKeysRequestJson{}
+		endpoint.PostResponse = &importRemoteKeysResponseJson{}
+		endpoint.DeleteRequest = &deleteRemoteKeysRequestJson{}
+		endpoint.DeleteResponse = &deleteRemoteKeysResponseJson{}
+	default:
+		return nil, errors.New("invalid path")
+	}
+
+	endpoint.Path = path
+	return &endpoint, nil
+}
@@ -0,0 +1,80 @@
+package apimiddleware
+
+// listKeystoresResponseJson is used in the GET /eth/v1/keystores API endpoint.
+type listKeystoresResponseJson struct {
+	Keystores []*keystoreJson `json:"data"`
+}
+
+// keystoreJson is used in the GET /eth/v1/keystores API endpoint.
+type keystoreJson struct {
+	ValidatingPubkey string `json:"validating_pubkey" hex:"true"`
+	DerivationPath   string `json:"derivation_path"`
+	Readonly         bool   `json:"readonly"`
+}
+
+// importKeystoresRequestJson is used in the POST /eth/v1/keystores API endpoint.
+type importKeystoresRequestJson struct {
+	Keystores          []string `json:"keystores"`
+	Passwords          []string `json:"passwords"`
+	SlashingProtection string   `json:"slashing_protection"`
+}
+
+// importKeystoresResponseJson is used in the POST /eth/v1/keystores API endpoint.
+type importKeystoresResponseJson struct {
+	Statuses []*statusJson `json:"data"`
+}
+
+// deleteKeystoresRequestJson is used in the DELETE /eth/v1/keystores API endpoint.
+type deleteKeystoresRequestJson struct {
+	PublicKeys []string `json:"pubkeys" hex:"true"`
+}
+
+// deleteKeystoresResponseJson is used in the DELETE /eth/v1/keystores API endpoint.
+type deleteKeystoresResponseJson struct {
+	Statuses           []*statusJson `json:"data"`
+	SlashingProtection string        `json:"slashing_protection"`
+}
+
+// listRemoteKeysResponseJson is used in the GET /eth/v1/remotekeys API endpoint.
+type listRemoteKeysResponseJson struct {
+	Keystores []*remoteKeysListJson `json:"data"`
+}
+
+// remoteKeysListJson is used in the GET /eth/v1/remotekeys API endpoint.
+type remoteKeysListJson struct {
+	Pubkey   string `json:"pubkey" hex:"true"`
+	Url      string `json:"url"`
+	Readonly bool   `json:"readonly"`
+}
+
+// importRemoteKeysRequestJson is used in the POST /eth/v1/remotekeys API endpoint.
+type importRemoteKeysRequestJson struct {
+	Keystores []*remoteKeyJson `json:"remote_keys"`
+}
+
+// remoteKeyJson is used in the POST /eth/v1/remotekeys API endpoint.
+type remoteKeyJson struct {
+	Pubkey string `json:"pubkey" hex:"true"`
+	Url    string `json:"url"`
+}
+
+// importRemoteKeysResponseJson is used in the POST /eth/v1/remotekeys API endpoint.
+type importRemoteKeysResponseJson struct {
+	Statuses []*statusJson `json:"data"`
+}
+
+// deleteRemoteKeysRequestJson is used in the DELETE /eth/v1/remotekeys API endpoint.
+type deleteRemoteKeysRequestJson struct {
+	PublicKeys []string `json:"pubkeys" hex:"true"`
+}
+
+// deleteRemoteKeysResponseJson is used in the DELETE /eth/v1/remotekeys API endpoint.
+type deleteRemoteKeysResponseJson struct {
+	Statuses []*statusJson `json:"data"`
+}
+
+// statusJson is the per-key status returned by the keymanager API endpoints.
+type statusJson struct {
+	Status  string `json:"status" enum:"true"`
+	Message string `json:"message"`
+}
@@ -2,7 +2,10 @@ package rpc
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/golang-jwt/jwt"
@@ -25,8 +28,10 @@ var (
 
 const (
 	// HashedRPCPassword for the validator RPC access.
-	HashedRPCPassword       = "rpc-password-hash"
-	checkUserSignupInterval = time.Second * 30
+	HashedRPCPassword = "rpc-password-hash"
+	// KeymanagerAPITokenFileName for the standard keymanager API access.
+	KeymanagerAPITokenFileName = "auth-token"
+	checkUserSignupInterval    = time.Second * 30
 )
 
 // Signup to authenticate access to the validator RPC API using bcrypt and
@@ -202,3 +207,31 @@ func (s *Server) createTokenString() (string, uint64, error) {
 	}
 	return tokenString, uint64(expirationTime.Unix()), nil
 }
+
+// loadOrCreateAuthToken reads the keymanager API token from a file,
+// writing a new random token to the file if it does not exist.
+func loadOrCreateAuthToken(tokenPath string) (string, error) {
+	if fileutil.FileExists(tokenPath) {
+		enc, err := fileutil.ReadFileAsBytes(tokenPath)
+		if err != nil {
+			return "", errors.Wrap(err, "could not read token file")
+		}
+		token := strings.TrimSpace(string(enc))
+		if token == "" {
+			return "", fmt.Errorf("token file %s is empty", tokenPath)
+		}
+		return token, nil
+	}
+	secret, err := createRandomJWTKey()
+	if err != nil {
+		return "", errors.Wrap(err, "could not generate token")
+	}
+	token := hex.EncodeToString(secret)
+	if err := fileutil.MkdirAll(filepath.Dir(tokenPath)); err != nil {
+		return "", errors.Wrap(err, "could not create token directory")
+	}
+	if err := fileutil.WriteFile(tokenPath, []byte(token)); err != nil {
+		return "", errors.Wrap(err, "could not write token file")
+	}
+	return token, nil
+}
@@ -8,13 +8,16 @@ import (
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/shared/version"
+	"github.com/prysmaticlabs/prysm/validator/client"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 // GetBeaconNodeConnection retrieves the current beacon node connection
-// information, as well as its sync status.
+// information, as well as its sync status and the health of each of the
+// configured beacon nodes.
 func (s *Server) GetBeaconNodeConnection(ctx context.Context, _ *emptypb.Empty) (*validatorpb.NodeConnectionResponse, error) {
 	syncStatus, err := s.syncChecker.Syncing(ctx)
 	if err != nil || s.validatorService.Status() != nil {
@@ -23,6 +26,7 @@ func (s *Server) GetBeaconNodeConnection(ctx context.Context, _ *emptypb.Empty)
 			BeaconNodeEndpoint: s.nodeGatewayEndpoint,
 			Connected:          false,
 			Syncing:            false,
+			BeaconNodes:        beaconNodesHealth(s.validatorService.BeaconNodeHealth()),
 		}, nil
 	}
 	genesis, err := s.genesisFetcher.GenesisInfo(ctx)
@@ -35,9 +39,38 @@ func (s *Server) GetBeaconNodeConnection(ctx context.Context, _ *emptypb.Empty)
 		BeaconNodeEndpoint:     s.nodeGatewayEndpoint,
 		Connected:              true,
 		Syncing:                syncStatus,
+		BeaconNodes:            beaconNodesHealth(s.validatorService.BeaconNodeHealth()),
 	}, nil
 }
 
+// beaconNodesHealth converts the health of the beacon nodes of the validator client.
+func beaconNodesHealth(health []*client.BeaconNodeHealth) []*validatorpb.BeaconNodeHealth {
+	if len(health) == 0 {
+		return nil
+	}
+	nodes := make([]*validatorpb.BeaconNodeHealth, len(health))
+	for i, h := range health {
+		nodes[i] = &validatorpb.BeaconNodeHealth{
+			Endpoint:    h.Endpoint,
+			Active:      h.Active,
+			Healthy:     h.Healthy,
+			Score:       h.Score,
+			Syncing:     h.Syncing,
+			HeadSlot:    uint64(h.HeadSlot),
+			SlotsBehind: h.SlotsBehind,
+			Peers:       h.Peers,
+			ErrorRate:   h.ErrorRate,
+		}
+		if h.Err != nil {
+			nodes[i].Error = h.Err.Error()
+		}
+		if !h.LastChecked.IsZero() {
+			nodes[i].LastChecked = timestamppb.New(h.LastChecked)
+		}
+	}
+	return nodes
+}
+
 // GetLogsEndpoints for the beacon and validator client.
 func (s *Server) GetLogsEndpoints(ctx context.Context, _ *emptypb.Empty) (*validatorpb.LogsEndpointResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "unimplemented")
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
 	"github.com/prysmaticlabs/prysm/shared/testutil/require"
@@ -52,3 +53,17 @@ func TestServer_GetBeaconNodeConnection(t *testing.T) {
 	}
 	require.DeepEqual(t, want, got)
 }
+
+func TestBeaconNodesHealth(t *testing.T) {
+	lastChecked := time.Unix(1000, 0)
+	got := beaconNodesHealth([]*client.BeaconNodeHealth{
+		{Endpoint: "localhost:4000", Active: true, Healthy: true, Score: 0.9, HeadSlot: 10, Peers: 30, LastChecked: lastChecked},
+		{Endpoint: "localhost:4001", Syncing: true, Err: errors.New("beacon node is syncing")},
+	})
+	want := []*pb.BeaconNodeHealth{
+		{Endpoint: "localhost:4000", Active: true, Healthy: true, Score: 0.9, HeadSlot: 10, Peers: 30, LastChecked: timestamppb.New(lastChecked)},
+		{Endpoint: "localhost:4001", Syncing: true, Error: "beacon node is syncing"},
+	}
+	require.DeepEqual(t, want, got)
+	require.Equal(t, 0, len(beaconNodesHealth(nil)))
+}
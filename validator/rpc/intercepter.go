@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"strings"
 	"sync"
@@ -34,6 +35,10 @@ var (
 	authLock sync.RWMutex
 )
 
+// keymanagerAPIMethodPrefix is the prefix of the standard keymanager API methods,
+// which are authorized with the keymanager API token rather than a web UI JWT.
+const keymanagerAPIMethodPrefix = "/ethereum.eth.service.KeyManagement/"
+
 // JWTInterceptor is a gRPC unary interceptor to authorize incoming requests
 // for methods that are NOT in the noAuthPaths configuration map.
 func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
@@ -48,7 +53,11 @@ func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
 		shouldAuthenticate := !noAuthPaths[info.FullMethod]
 		authLock.RUnlock()
 		if shouldAuthenticate {
-			if err := s.authorize(ctx); err != nil {
+			authorize := s.authorize
+			if strings.HasPrefix(info.FullMethod, keymanagerAPIMethodPrefix) {
+				authorize = s.authorizeKeymanagerAPI
+			}
+			if err := authorize(ctx); err != nil {
 				return nil, err
 			}
 		}
@@ -61,24 +70,43 @@ func (s *Server) JWTInterceptor() grpc.UnaryServerInterceptor {
 
 // Authorize the token received is valid.
 func (s *Server) authorize(ctx context.Context) error {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = jwt.Parse(token, s.validateJWT)
+	if err != nil {
+		return status.Errorf(codes.Unauthenticated, "Could not parse JWT token: %v", err)
+	}
+	return nil
+}
+
+// Authorize the token received matches the keymanager API token.
+func (s *Server) authorizeKeymanagerAPI(ctx context.Context) error {
+	token, err := bearerToken(ctx)
+	if err != nil {
+		return err
+	}
+	if s.keymanagerAPIToken == "" || subtle.ConstantTimeCompare([]byte(token), []byte(s.keymanagerAPIToken)) != 1 {
+		return status.Error(codes.Unauthenticated, "Invalid keymanager API token")
+	}
+	return nil
+}
+
+func bearerToken(ctx context.Context) (string, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return status.Errorf(codes.InvalidArgument, "Retrieving metadata failed")
+		return "", status.Errorf(codes.InvalidArgument, "Retrieving metadata failed")
 	}
 
 	authHeader, ok := md["authorization"]
 	if !ok {
-		return status.Errorf(codes.Unauthenticated, "Authorization token could not be found")
+		return "", status.Errorf(codes.Unauthenticated, "Authorization token could not be found")
 	}
 	if len(authHeader) < 1 || !strings.Contains(authHeader[0], "Bearer ") {
-		return status.Error(codes.Unauthenticated, "Invalid auth header, needs Bearer {token}")
+		return "", status.Error(codes.Unauthenticated, "Invalid auth header, needs Bearer {token}")
 	}
-	token := strings.Split(authHeader[0], "Bearer ")[1]
-	_, err := jwt.Parse(token, s.validateJWT)
-	if err != nil {
-		return status.Errorf(codes.Unauthenticated, "Could not parse JWT token: %v", err)
-	}
-	return nil
+	return strings.Split(authHeader[0], "Bearer ")[1], nil
 }
 
 func (s *Server) validateJWT(token *jwt.Token) (interface{}, error) {
@@ -71,3 +71,32 @@ func TestServer_JWTInterceptor_InvalidSigningType(t *testing.T) {
 	_, err := ss.validateJWT(token)
 	require.ErrorContains(t, "unexpected JWT signing method", err)
 }
+
+func TestServer_JWTInterceptor_KeymanagerAPIToken(t *testing.T) {
+	s := Server{
+		jwtKey:             []byte("testKey"),
+		keymanagerAPIToken: "apiToken",
+	}
+	interceptor := s.JWTInterceptor()
+
+	unaryInfo := &grpc.UnaryServerInfo{
+		FullMethod: keymanagerAPIMethodPrefix + "ListKeystores",
+	}
+	unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return nil, nil
+	}
+	ctx := metadata.NewIncomingContext(context.Background(), map[string][]string{
+		"authorization": {"Bearer apiToken"},
+	})
+	_, err := interceptor(ctx, "xyz", unaryInfo, unaryHandler)
+	require.NoError(t, err)
+
+	// A web UI JWT does not authorize keymanager API requests.
+	token, _, err := s.createTokenString()
+	require.NoError(t, err)
+	ctx = metadata.NewIncomingContext(context.Background(), map[string][]string{
+		"authorization": {"Bearer " + token},
+	})
+	_, err = interceptor(ctx, "xyz", unaryInfo, unaryHandler)
+	require.ErrorContains(t, "Invalid keymanager API token", err)
+}
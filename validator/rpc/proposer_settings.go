@@ -0,0 +1,124 @@
+package rpc
+
+import (
+	"context"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListProposerSettings returns the default proposer settings and the proposer settings
+// of each validator which overrides them.
+func (s *Server) ListProposerSettings(_ context.Context, _ *empty.Empty) (*pb.ProposerSettingsResponse, error) {
+	if s.proposerSettings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Proposer settings are not available")
+	}
+	settings := s.proposerSettings.SettingsFile()
+	keys := make([]string, 0, len(settings.ProposerConfig))
+	for k := range settings.ProposerConfig {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	entries := make([]*pb.ProposerSettingsResponse_Entry, 0, len(keys))
+	for _, k := range keys {
+		pubKey, err := hexutil.Decode(k)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not decode public key %s: %v", k, err)
+		}
+		entries = append(entries, &pb.ProposerSettingsResponse_Entry{
+			PublicKey: pubKey,
+			Option:    optionToProto(settings.ProposerConfig[k]),
+		})
+	}
+	return &pb.ProposerSettingsResponse{
+		DefaultConfig:  optionToProto(settings.DefaultConfig),
+		ProposerConfig: entries,
+	}, nil
+}
+
+// SetProposerSettings replaces the proposer settings of a validator, or the default
+// proposer settings if no public key is specified. The change is written to the
+// proposer settings file.
+func (s *Server) SetProposerSettings(_ context.Context, req *pb.SetProposerSettingsRequest) (*empty.Empty, error) {
+	if s.proposerSettings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Proposer settings are not available")
+	}
+	if req.Option == nil {
+		return nil, status.Error(codes.InvalidArgument, "No proposer settings specified")
+	}
+	option := optionFromProto(req.Option)
+	if err := option.Validate(); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid proposer settings: %v", err)
+	}
+	var err error
+	if len(req.PublicKey) == 0 {
+		err = s.proposerSettings.SetDefaultOption(option)
+	} else {
+		if len(req.PublicKey) != 48 {
+			return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.PublicKey))
+		}
+		err = s.proposerSettings.SetOption(bytesutil.ToBytes48(req.PublicKey), option)
+	}
+	if err != nil {
+		return nil, proposerSettingsError(err)
+	}
+	return &empty.Empty{}, nil
+}
+
+// DeleteProposerSettings deletes the proposer settings of a validator, which then
+// proposes blocks with the default proposer settings.
+func (s *Server) DeleteProposerSettings(_ context.Context, req *pb.DeleteProposerSettingsRequest) (*empty.Empty, error) {
+	if s.proposerSettings == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Proposer settings are not available")
+	}
+	if len(req.PublicKey) != 48 {
+		return nil, status.Errorf(codes.InvalidArgument, "Invalid public key length %d", len(req.PublicKey))
+	}
+	found, err := s.proposerSettings.DeleteOption(bytesutil.ToBytes48(req.PublicKey))
+	if err != nil {
+		return nil, proposerSettingsError(err)
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "No proposer settings found for public key %#x", req.PublicKey)
+	}
+	return &empty.Empty{}, nil
+}
+
+func proposerSettingsError(err error) error {
+	if errors.Is(err, proposer.ErrNoSettingsFile) {
+		return status.Error(codes.FailedPrecondition, "No proposer settings file configured, start the validator with --proposer-settings-file")
+	}
+	return status.Errorf(codes.Internal, "Could not update proposer settings: %v", err)
+}
+
+func optionToProto(o *proposer.Option) *pb.ProposerOption {
+	if o == nil {
+		return nil
+	}
+	return &pb.ProposerOption{
+		FeeRecipient: o.FeeRecipient,
+		Graffiti:     o.Graffiti,
+		GasLimit:     o.GasLimit,
+		Enabled:      o.Enabled,
+	}
+}
+
+func optionFromProto(o *pb.ProposerOption) *proposer.Option {
+	option := &proposer.Option{
+		FeeRecipient: o.FeeRecipient,
+		Graffiti:     o.Graffiti,
+		GasLimit:     o.GasLimit,
+	}
+	if o.Enabled != nil {
+		enabled := *o.Enabled
+		option.Enabled = &enabled
+	}
+	return option
+}
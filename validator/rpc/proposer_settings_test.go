@@ -0,0 +1,93 @@
+package rpc
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
+)
+
+func TestServer_ProposerSettings(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "proposer-settings.yaml")
+	store, err := proposer.NewStore(path)
+	require.NoError(t, err)
+	s := &Server{proposerSettings: store}
+
+	pubKey := bytesutil.PadTo([]byte{1}, 48)
+	disabled := false
+	_, err = s.SetProposerSettings(ctx, &pb.SetProposerSettingsRequest{
+		Option: &pb.ProposerOption{Graffiti: "default"},
+	})
+	require.NoError(t, err)
+	_, err = s.SetProposerSettings(ctx, &pb.SetProposerSettingsRequest{
+		PublicKey: pubKey,
+		Option: &pb.ProposerOption{
+			FeeRecipient: "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9",
+			Enabled:      &disabled,
+		},
+	})
+	require.NoError(t, err)
+
+	resp, err := s.ListProposerSettings(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, "default", resp.DefaultConfig.Graffiti)
+	require.Equal(t, 1, len(resp.ProposerConfig))
+	assert.DeepEqual(t, pubKey, resp.ProposerConfig[0].PublicKey)
+	assert.Equal(t, "0x046Fb65722E7b2455012BFEBf6177F1D2e9738D9", resp.ProposerConfig[0].Option.FeeRecipient)
+	require.NotNil(t, resp.ProposerConfig[0].Option.Enabled)
+	assert.Equal(t, false, *resp.ProposerConfig[0].Option.Enabled)
+
+	// The changes are written to the settings file.
+	settings, err := proposer.ParseSettingsFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, 1, len(settings.ProposerConfig))
+
+	_, err = s.DeleteProposerSettings(ctx, &pb.DeleteProposerSettingsRequest{PublicKey: pubKey})
+	require.NoError(t, err)
+	_, err = s.DeleteProposerSettings(ctx, &pb.DeleteProposerSettingsRequest{PublicKey: pubKey})
+	assert.ErrorContains(t, "No proposer settings found", err)
+	resp, err = s.ListProposerSettings(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(resp.ProposerConfig))
+}
+
+func TestServer_SetProposerSettings_Invalid(t *testing.T) {
+	ctx := context.Background()
+	store, err := proposer.NewStore(filepath.Join(t.TempDir(), "proposer-settings.yaml"))
+	require.NoError(t, err)
+	s := &Server{proposerSettings: store}
+
+	_, err = s.SetProposerSettings(ctx, &pb.SetProposerSettingsRequest{})
+	assert.ErrorContains(t, "No proposer settings specified", err)
+	_, err = s.SetProposerSettings(ctx, &pb.SetProposerSettingsRequest{
+		Option: &pb.ProposerOption{FeeRecipient: "0x1234"},
+	})
+	assert.ErrorContains(t, "Invalid proposer settings", err)
+	_, err = s.SetProposerSettings(ctx, &pb.SetProposerSettingsRequest{
+		PublicKey: []byte{1, 2, 3},
+		Option:    &pb.ProposerOption{Graffiti: "graffiti"},
+	})
+	assert.ErrorContains(t, "Invalid public key length", err)
+}
+
+func TestServer_SetProposerSettings_NoSettingsFile(t *testing.T) {
+	ctx := context.Background()
+	s := &Server{}
+	_, err := s.ListProposerSettings(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "Proposer settings are not available", err)
+
+	store, err := proposer.NewStore("")
+	require.NoError(t, err)
+	s.proposerSettings = store
+	_, err = s.SetProposerSettings(ctx, &pb.SetProposerSettingsRequest{
+		Option: &pb.ProposerOption{Graffiti: "graffiti"},
+	})
+	assert.ErrorContains(t, "No proposer settings file configured", err)
+}
@@ -11,6 +11,7 @@ import (
 	recovery "github.com/grpc-ecosystem/go-grpc-middleware/recovery"
 	grpc_opentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	grpc_prometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
 	ethpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	pb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1"
 	validatorpb "github.com/prysmaticlabs/prysm/proto/prysm/v1alpha1/validator-client"
@@ -22,6 +23,7 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/client"
 	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/proposer"
 	"github.com/sirupsen/logrus"
 	"go.opencensus.io/plugin/ocgrpc"
 	"google.golang.org/grpc"
@@ -54,6 +56,8 @@ type Config struct {
 	NodeGatewayEndpoint      string
 	Wallet                   *wallet.Wallet
 	Keymanager               keymanager.IKeymanager
+	KeymanagerAPITokenPath   string
+	ProposerSettings         *proposer.Store
 }
 
 // Server defining a gRPC server for the remote signer API.
@@ -94,6 +98,9 @@ type Server struct {
 	validatorMonitoringPort   int
 	validatorGatewayHost      string
 	validatorGatewayPort      int
+	keymanagerAPITokenPath    string
+	keymanagerAPIToken        string
+	proposerSettings          *proposer.Store
 }
 
 // NewServer instantiates a new gRPC server.
@@ -128,6 +135,8 @@ func NewServer(ctx context.Context, cfg *Config) *Server {
 		validatorMonitoringPort:  cfg.ValidatorMonitoringPort,
 		validatorGatewayHost:     cfg.ValidatorGatewayHost,
 		validatorGatewayPort:     cfg.ValidatorGatewayPort,
+		keymanagerAPITokenPath:   cfg.KeymanagerAPITokenPath,
+		proposerSettings:         cfg.ProposerSettings,
 	}
 }
 
@@ -181,6 +190,17 @@ func (s *Server) Start() {
 	}
 	s.jwtKey = jwtKey
 
+	// The standard keymanager API is authorized with a static bearer token,
+	// which is shared with the tooling managing the validator's keys.
+	if s.keymanagerAPITokenPath != "" {
+		token, err := loadOrCreateAuthToken(s.keymanagerAPITokenPath)
+		if err != nil {
+			log.WithError(err).Fatal("Could not initialize keymanager API token")
+		}
+		s.keymanagerAPIToken = token
+		log.WithField("path", s.keymanagerAPITokenPath).Info("Loaded keymanager API token")
+	}
+
 	// Register services available for the gRPC server.
 	reflection.Register(s.grpcServer)
 	validatorpb.RegisterAuthServer(s.grpcServer, s)
@@ -189,6 +209,8 @@ func (s *Server) Start() {
 	validatorpb.RegisterBeaconServer(s.grpcServer, s)
 	validatorpb.RegisterAccountsServer(s.grpcServer, s)
 	validatorpb.RegisterSlashingProtectionServer(s.grpcServer, s)
+	validatorpb.RegisterProposerSettingsServer(s.grpcServer, s)
+	ethpbservice.RegisterKeyManagementServer(s.grpcServer, &keymanagerAPIServer{server: s})
 
 	go func() {
 		if s.listener != nil {
@@ -0,0 +1,396 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/pkg/errors"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/derived"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/imported"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
+	slashing "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+	"go.opencensus.io/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// keymanagerAPIServer implements the standard keymanager API on top of the validator RPC server.
+// It is a separate type because some of its method names collide with the web UI's API.
+type keymanagerAPIServer struct {
+	server *Server
+}
+
+// ListKeystores lists the validating keys held by the validator client in keystores.
+// Keys held by a remote signer are listed by ListRemoteKeys instead.
+func (s *keymanagerAPIServer) ListKeystores(ctx context.Context, _ *empty.Empty) (*ethpbservice.ListKeystoresResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "keymanagerAPI.ListKeystores")
+	defer span.End()
+
+	if !s.server.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	kind := s.server.wallet.KeymanagerKind()
+	if kind != keymanager.Imported && kind != keymanager.Derived {
+		return &ethpbservice.ListKeystoresResponse{Data: []*ethpbservice.ListKeystoresResponse_Keystore{}}, nil
+	}
+	pubKeys, err := s.server.keymanager.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not list keystores: %v", err)
+	}
+	keystores := make([]*ethpbservice.ListKeystoresResponse_Keystore, len(pubKeys))
+	for i := range pubKeys {
+		keystores[i] = &ethpbservice.ListKeystoresResponse_Keystore{
+			ValidatingPubkey: pubKeys[i][:],
+		}
+		if kind == keymanager.Derived {
+			keystores[i].DerivationPath = fmt.Sprintf(derived.ValidatingKeyDerivationPathTemplate, i)
+		}
+	}
+	return &ethpbservice.ListKeystoresResponse{Data: keystores}, nil
+}
+
+// ImportKeystores imports EIP-2335 keystores, each decrypted with the password at the same index,
+// along with an optional EIP-3076 slashing protection interchange. The interchange is imported
+// first so that the imported keys never sign without their history.
+func (s *keymanagerAPIServer) ImportKeystores(
+	ctx context.Context, req *ethpbservice.ImportKeystoresRequest,
+) (*ethpbservice.ImportKeystoresResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "keymanagerAPI.ImportKeystores")
+	defer span.End()
+
+	km, err := s.server.importedKeymanager()
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Keystores) != len(req.Passwords) {
+		return nil, status.Errorf(
+			codes.InvalidArgument,
+			"Number of passwords %d does not match number of keystores %d",
+			len(req.Passwords),
+			len(req.Keystores),
+		)
+	}
+	if req.SlashingProtection != "" {
+		if s.server.valDB == nil {
+			return nil, status.Error(codes.FailedPrecondition, "Validator database not found")
+		}
+		buf := bytes.NewBufferString(req.SlashingProtection)
+		if err := slashing.ImportStandardProtectionJSON(ctx, s.server.valDB, buf); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "Could not import slashing protection: %v", err)
+		}
+	}
+
+	existingKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch existing keys: %v", err)
+	}
+	existing := make(map[[48]byte]bool, len(existingKeys))
+	for _, pubKey := range existingKeys {
+		existing[pubKey] = true
+	}
+	statuses := make([]*ethpbservice.ImportedKeystoreStatus, len(req.Keystores))
+	privKeys := make([][]byte, 0, len(req.Keystores))
+	pubKeys := make([][]byte, 0, len(req.Keystores))
+	for i, encoded := range req.Keystores {
+		privKey, pubKey, err := decryptKeystore(encoded, req.Passwords[i])
+		if err != nil {
+			statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+				Status:  ethpbservice.ImportedKeystoreStatus_ERROR,
+				Message: err.Error(),
+			}
+			continue
+		}
+		if existing[bytesutil.ToBytes48(pubKey)] {
+			statuses[i] = &ethpbservice.ImportedKeystoreStatus{
+				Status:  ethpbservice.ImportedKeystoreStatus_DUPLICATE,
+				Message: fmt.Sprintf("Duplicate key %#x", pubKey),
+			}
+			continue
+		}
+		existing[bytesutil.ToBytes48(pubKey)] = true
+		privKeys = append(privKeys, privKey)
+		pubKeys = append(pubKeys, pubKey)
+		statuses[i] = &ethpbservice.ImportedKeystoreStatus{Status: ethpbservice.ImportedKeystoreStatus_IMPORTED}
+	}
+	if len(privKeys) > 0 {
+		if err := km.ImportKeypairs(ctx, privKeys, pubKeys); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not import keystores: %v", err)
+		}
+		log.WithField("numKeys", len(pubKeys)).Info("Imported keystores via the keymanager API")
+	}
+	return &ethpbservice.ImportKeystoresResponse{Data: statuses}, nil
+}
+
+// DeleteKeystores deletes keys from the validator client and returns the EIP-3076 slashing protection
+// interchange of the deleted keys, so that they can be safely moved to another client. Keys which are
+// not held by the validator client but have slashing protection history are reported as not active,
+// and their history is returned as well.
+func (s *keymanagerAPIServer) DeleteKeystores(
+	ctx context.Context, req *ethpbservice.DeleteKeystoresRequest,
+) (*ethpbservice.DeleteKeystoresResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "keymanagerAPI.DeleteKeystores")
+	defer span.End()
+
+	km, err := s.server.importedKeymanager()
+	if err != nil {
+		return nil, err
+	}
+	if s.server.valDB == nil {
+		return nil, status.Error(codes.FailedPrecondition, "Validator database not found")
+	}
+	existingKeys, err := km.FetchValidatingPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch existing keys: %v", err)
+	}
+	existing := make(map[[48]byte]bool, len(existingKeys))
+	for _, pubKey := range existingKeys {
+		existing[pubKey] = true
+	}
+	withHistory, err := s.server.slashingProtectionPublicKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not fetch slashing protection history: %v", err)
+	}
+
+	statuses := make([]*ethpbservice.DeletedKeystoreStatus, len(req.Pubkeys))
+	toDelete := make([][]byte, 0, len(req.Pubkeys))
+	toExport := make(map[string]bool, len(req.Pubkeys))
+	for i, pubKey := range req.Pubkeys {
+		if len(pubKey) != 48 {
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{
+				Status:  ethpbservice.DeletedKeystoreStatus_ERROR,
+				Message: fmt.Sprintf("Invalid public key length %d", len(pubKey)),
+			}
+			continue
+		}
+		pubKey48 := bytesutil.ToBytes48(pubKey)
+		switch {
+		case existing[pubKey48]:
+			// Repeated keys are deleted once, and are not active afterwards.
+			delete(existing, pubKey48)
+			toDelete = append(toDelete, pubKey)
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{Status: ethpbservice.DeletedKeystoreStatus_DELETED}
+		case withHistory[pubKey48] || toExport[fmt.Sprintf("%#x", pubKey)]:
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{Status: ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE}
+		default:
+			statuses[i] = &ethpbservice.DeletedKeystoreStatus{Status: ethpbservice.DeletedKeystoreStatus_NOT_FOUND}
+			continue
+		}
+		toExport[fmt.Sprintf("%#x", pubKey)] = true
+	}
+	if len(toDelete) > 0 {
+		if err := km.DeleteAccounts(ctx, toDelete); err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not delete keys: %v", err)
+		}
+		log.WithField("numKeys", len(toDelete)).Info("Deleted keystores via the keymanager API")
+	}
+
+	// The slashing protection history is exported after the keys are deleted,
+	// so that it includes everything they signed.
+	interchange, err := slashing.ExportStandardProtectionJSON(ctx, s.server.valDB)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not export slashing protection history: %v", err)
+	}
+	data := make([]*format.ProtectionData, 0, len(toExport))
+	for _, d := range interchange.Data {
+		if toExport[d.Pubkey] {
+			data = append(data, d)
+		}
+	}
+	interchange.Data = data
+	encoded, err := json.Marshal(interchange)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not JSON marshal slashing protection history: %v", err)
+	}
+	return &ethpbservice.DeleteKeystoresResponse{
+		Data:               statuses,
+		SlashingProtection: string(encoded),
+	}, nil
+}
+
+// ListRemoteKeys lists the validating keys held by the remote signer.
+func (s *keymanagerAPIServer) ListRemoteKeys(ctx context.Context, _ *empty.Empty) (*ethpbservice.ListRemoteKeysResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "keymanagerAPI.ListRemoteKeys")
+	defer span.End()
+
+	km, err := s.server.web3SignerKeymanager()
+	if err != nil {
+		return nil, err
+	}
+	keys, err := km.ListRemoteKeys(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not list remote keys: %v", err)
+	}
+	data := make([]*ethpbservice.ListRemoteKeysResponse_Keystore, 0, len(keys))
+	for _, k := range keys {
+		pubKey, err := hex.DecodeString(strings.TrimPrefix(k.PublicKey, "0x"))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "Could not decode remote key %s: %v", k.PublicKey, err)
+		}
+		data = append(data, &ethpbservice.ListRemoteKeysResponse_Keystore{
+			Pubkey:   pubKey,
+			Url:      k.URL,
+			Readonly: k.Readonly,
+		})
+	}
+	return &ethpbservice.ListRemoteKeysResponse{Data: data}, nil
+}
+
+// ImportRemoteKeys adds keys held by a Web3Signer server to the validator client. Keys without
+// a URL are held by the server the validator client is configured with.
+func (s *keymanagerAPIServer) ImportRemoteKeys(
+	ctx context.Context, req *ethpbservice.ImportRemoteKeysRequest,
+) (*ethpbservice.ImportRemoteKeysResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "keymanagerAPI.ImportRemoteKeys")
+	defer span.End()
+
+	km, err := s.server.web3SignerKeymanager()
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]*web3signer.RemoteKey, len(req.RemoteKeys))
+	for i, k := range req.RemoteKeys {
+		keys[i] = &web3signer.RemoteKey{
+			PublicKey: fmt.Sprintf("%#x", k.Pubkey),
+			URL:       k.Url,
+		}
+	}
+	errs, err := km.AddRemoteKeys(ctx, keys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not import remote keys: %v", err)
+	}
+	statuses := make([]*ethpbservice.ImportedRemoteKeysStatus, len(errs))
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{Status: ethpbservice.ImportedRemoteKeysStatus_IMPORTED}
+		case errors.Is(err, web3signer.ErrRemoteKeyExists):
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_DUPLICATE,
+				Message: err.Error(),
+			}
+		default:
+			statuses[i] = &ethpbservice.ImportedRemoteKeysStatus{
+				Status:  ethpbservice.ImportedRemoteKeysStatus_ERROR,
+				Message: err.Error(),
+			}
+		}
+	}
+	return &ethpbservice.ImportRemoteKeysResponse{Data: statuses}, nil
+}
+
+// DeleteRemoteKeys removes keys added by ImportRemoteKeys from the validator client.
+// Keys listed by the configured Web3Signer server itself can't be deleted.
+func (s *keymanagerAPIServer) DeleteRemoteKeys(
+	ctx context.Context, req *ethpbservice.DeleteRemoteKeysRequest,
+) (*ethpbservice.DeleteRemoteKeysResponse, error) {
+	ctx, span := trace.StartSpan(ctx, "keymanagerAPI.DeleteRemoteKeys")
+	defer span.End()
+
+	km, err := s.server.web3SignerKeymanager()
+	if err != nil {
+		return nil, err
+	}
+	errs, err := km.DeleteRemoteKeys(ctx, req.Pubkeys)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "Could not delete remote keys: %v", err)
+	}
+	statuses := make([]*ethpbservice.DeletedRemoteKeysStatus, len(errs))
+	for i, err := range errs {
+		switch {
+		case err == nil:
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{Status: ethpbservice.DeletedRemoteKeysStatus_DELETED}
+		case errors.Is(err, web3signer.ErrRemoteKeyNotFound):
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{Status: ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND}
+		default:
+			statuses[i] = &ethpbservice.DeletedRemoteKeysStatus{
+				Status:  ethpbservice.DeletedRemoteKeysStatus_ERROR,
+				Message: err.Error(),
+			}
+		}
+	}
+	return &ethpbservice.DeleteRemoteKeysResponse{Data: statuses}, nil
+}
+
+func (s *Server) importedKeymanager() (*imported.Keymanager, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	km, ok := s.keymanager.(*imported.Keymanager)
+	if !ok {
+		return nil, status.Errorf(
+			codes.FailedPrecondition, "Keystores can only be managed with an imported wallet, not %s", s.wallet.KeymanagerKind(),
+		)
+	}
+	return km, nil
+}
+
+func (s *Server) web3SignerKeymanager() (*web3signer.Keymanager, error) {
+	if !s.walletInitialized {
+		return nil, status.Error(codes.FailedPrecondition, "Wallet not yet initialized")
+	}
+	km, ok := s.keymanager.(*web3signer.Keymanager)
+	if !ok {
+		return nil, status.Errorf(
+			codes.FailedPrecondition, "Remote keys can only be managed with a web3signer wallet, not %s", s.wallet.KeymanagerKind(),
+		)
+	}
+	return km, nil
+}
+
+// slashingProtectionPublicKeys returns the keys which have signed blocks or attestations in the validator database.
+func (s *Server) slashingProtectionPublicKeys(ctx context.Context) (map[[48]byte]bool, error) {
+	proposed, err := s.valDB.ProposedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	attested, err := s.valDB.AttestedPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keys := make(map[[48]byte]bool, len(proposed)+len(attested))
+	for _, pubKey := range proposed {
+		keys[pubKey] = true
+	}
+	for _, pubKey := range attested {
+		keys[pubKey] = true
+	}
+	return keys, nil
+}
+
+// decryptKeystore decrypts an EIP-2335 keystore, returning its private and public keys.
+func decryptKeystore(encoded, password string) ([]byte, []byte, error) {
+	keystore := &keymanager.Keystore{}
+	if err := json.Unmarshal([]byte(encoded), keystore); err != nil {
+		return nil, nil, errors.Wrap(err, "could not unmarshal keystore")
+	}
+	privKey, err := keystorev4.New().Decrypt(keystore.Crypto, password)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not decrypt keystore")
+	}
+	secretKey, err := bls.SecretKeyFromBytes(privKey)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "could not initialize private key from bytes")
+	}
+	pubKey := secretKey.PublicKey().Marshal()
+	if keystore.Pubkey != "" {
+		want, err := hex.DecodeString(strings.TrimPrefix(keystore.Pubkey, "0x"))
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "could not decode public key from keystore")
+		}
+		if !bytes.Equal(want, pubKey) {
+			return nil, nil, fmt.Errorf("keystore public key %#x does not match its private key", want)
+		}
+	}
+	return privKey, pubKey, nil
+}
@@ -0,0 +1,183 @@
+package rpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/google/uuid"
+	ethpbservice "github.com/prysmaticlabs/prysm/proto/eth/service"
+	"github.com/prysmaticlabs/prysm/shared/bls"
+	"github.com/prysmaticlabs/prysm/shared/bytesutil"
+	"github.com/prysmaticlabs/prysm/shared/testutil/assert"
+	"github.com/prysmaticlabs/prysm/shared/testutil/require"
+	"github.com/prysmaticlabs/prysm/validator/accounts"
+	"github.com/prysmaticlabs/prysm/validator/accounts/iface"
+	"github.com/prysmaticlabs/prysm/validator/accounts/wallet"
+	dbtest "github.com/prysmaticlabs/prysm/validator/db/testing"
+	"github.com/prysmaticlabs/prysm/validator/keymanager"
+	"github.com/prysmaticlabs/prysm/validator/keymanager/web3signer"
+	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
+	keystorev4 "github.com/wealdtech/go-eth2-wallet-encryptor-keystorev4"
+)
+
+func createEncodedKeystore(t *testing.T, password string) (string, []byte) {
+	encryptor := keystorev4.New()
+	id, err := uuid.NewRandom()
+	require.NoError(t, err)
+	validatingKey, err := bls.RandKey()
+	require.NoError(t, err)
+	pubKey := validatingKey.PublicKey().Marshal()
+	cryptoFields, err := encryptor.Encrypt(validatingKey.Marshal(), password)
+	require.NoError(t, err)
+	encoded, err := json.Marshal(&keymanager.Keystore{
+		Crypto:  cryptoFields,
+		Pubkey:  fmt.Sprintf("%x", pubKey),
+		ID:      id.String(),
+		Version: encryptor.Version(),
+		Name:    encryptor.Name(),
+	})
+	require.NoError(t, err)
+	return string(encoded), pubKey
+}
+
+func TestKeymanagerAPI_ImportListDeleteKeystores(t *testing.T) {
+	ctx := context.Background()
+	defaultWalletPath = setupWalletDir(t)
+	w, err := accounts.CreateWalletWithKeymanager(ctx, &accounts.CreateWalletConfig{
+		WalletCfg: &wallet.Config{
+			WalletDir:      defaultWalletPath,
+			KeymanagerKind: keymanager.Imported,
+			WalletPassword: strongPass,
+		},
+		SkipMnemonicConfirm: true,
+	})
+	require.NoError(t, err)
+	km, err := w.InitializeKeymanager(ctx, iface.InitKeymanagerConfig{ListenForChanges: false})
+	require.NoError(t, err)
+	valDB := dbtest.SetupDB(t, [][48]byte{})
+	require.NoError(t, valDB.SaveGenesisValidatorsRoot(ctx, bytesutil.PadTo([]byte("genesis"), 32)))
+	s := &keymanagerAPIServer{server: &Server{
+		keymanager:        km,
+		walletInitialized: true,
+		wallet:            w,
+		valDB:             valDB,
+	}}
+
+	keystore, pubKey := createEncodedKeystore(t, "password")
+	badKeystore, _ := createEncodedKeystore(t, "password")
+	_, err = s.ImportKeystores(ctx, &ethpbservice.ImportKeystoresRequest{Keystores: []string{keystore}})
+	assert.ErrorContains(t, "Number of passwords 0 does not match number of keystores 1", err)
+	importResp, err := s.ImportKeystores(ctx, &ethpbservice.ImportKeystoresRequest{
+		Keystores: []string{keystore, keystore, badKeystore},
+		Passwords: []string{"password", "password", "wrong"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(importResp.Data))
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, importResp.Data[0].Status)
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_DUPLICATE, importResp.Data[1].Status)
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_ERROR, importResp.Data[2].Status)
+	assert.Equal(t, true, strings.Contains(importResp.Data[2].Message, "could not decrypt keystore"))
+
+	listResp, err := s.ListKeystores(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(listResp.Data))
+	assert.DeepEqual(t, pubKey, listResp.Data[0].ValidatingPubkey)
+
+	// A key which is not held by the validator client, but has signed before, is not active.
+	inactiveKey, err := bls.RandKey()
+	require.NoError(t, err)
+	inactivePubKey := inactiveKey.PublicKey().Marshal()
+	signingRoot := make([]byte, 32)
+	require.NoError(t, valDB.SaveProposalHistoryForSlot(ctx, bytesutil.ToBytes48(pubKey), 1, signingRoot))
+	require.NoError(t, valDB.SaveProposalHistoryForSlot(ctx, bytesutil.ToBytes48(inactivePubKey), 2, signingRoot))
+
+	deleteResp, err := s.DeleteKeystores(ctx, &ethpbservice.DeleteKeystoresRequest{
+		Pubkeys: [][]byte{pubKey, pubKey, inactivePubKey, make([]byte, 48)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, len(deleteResp.Data))
+	assert.Equal(t, ethpbservice.DeletedKeystoreStatus_DELETED, deleteResp.Data[0].Status)
+	assert.Equal(t, ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE, deleteResp.Data[1].Status)
+	assert.Equal(t, ethpbservice.DeletedKeystoreStatus_NOT_ACTIVE, deleteResp.Data[2].Status)
+	assert.Equal(t, ethpbservice.DeletedKeystoreStatus_NOT_FOUND, deleteResp.Data[3].Status)
+	interchange := &format.EIPSlashingProtectionFormat{}
+	require.NoError(t, json.Unmarshal([]byte(deleteResp.SlashingProtection), interchange))
+	require.Equal(t, 2, len(interchange.Data))
+
+	listResp, err = s.ListKeystores(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(listResp.Data))
+
+	// Re-importing the deleted key along with its slashing protection history.
+	importResp, err = s.ImportKeystores(ctx, &ethpbservice.ImportKeystoresRequest{
+		Keystores:          []string{keystore},
+		Passwords:          []string{"password"},
+		SlashingProtection: deleteResp.SlashingProtection,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, ethpbservice.ImportedKeystoreStatus_IMPORTED, importResp.Data[0].Status)
+}
+
+func TestKeymanagerAPI_RemoteKeys(t *testing.T) {
+	ctx := context.Background()
+	baseKey, err := bls.RandKey()
+	require.NoError(t, err)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewEncoder(w).Encode([]string{hexutil.Encode(baseKey.PublicKey().Marshal())}))
+	}))
+	defer srv.Close()
+	km, err := web3signer.NewKeymanager(ctx, &web3signer.SetupConfig{Opts: &web3signer.KeymanagerOpts{
+		BaseEndpoint:          srv.URL,
+		GenesisValidatorsRoot: hexutil.Encode(make([]byte, 32)),
+	}})
+	require.NoError(t, err)
+	s := &keymanagerAPIServer{server: &Server{
+		keymanager:        km,
+		walletInitialized: true,
+	}}
+
+	addedKey, err := bls.RandKey()
+	require.NoError(t, err)
+	importResp, err := s.ImportRemoteKeys(ctx, &ethpbservice.ImportRemoteKeysRequest{
+		RemoteKeys: []*ethpbservice.RemoteKey{
+			{Pubkey: addedKey.PublicKey().Marshal(), Url: "http://localhost:9000"},
+			{Pubkey: baseKey.PublicKey().Marshal()},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(importResp.Data))
+	assert.Equal(t, ethpbservice.ImportedRemoteKeysStatus_IMPORTED, importResp.Data[0].Status)
+	assert.Equal(t, ethpbservice.ImportedRemoteKeysStatus_DUPLICATE, importResp.Data[1].Status)
+
+	listResp, err := s.ListRemoteKeys(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(listResp.Data))
+	assert.Equal(t, true, listResp.Data[0].Readonly)
+	assert.DeepEqual(t, addedKey.PublicKey().Marshal(), listResp.Data[1].Pubkey)
+	assert.Equal(t, "http://localhost:9000", listResp.Data[1].Url)
+
+	deleteResp, err := s.DeleteRemoteKeys(ctx, &ethpbservice.DeleteRemoteKeysRequest{
+		Pubkeys: [][]byte{addedKey.PublicKey().Marshal(), baseKey.PublicKey().Marshal(), make([]byte, 48)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(deleteResp.Data))
+	assert.Equal(t, ethpbservice.DeletedRemoteKeysStatus_DELETED, deleteResp.Data[0].Status)
+	assert.Equal(t, ethpbservice.DeletedRemoteKeysStatus_ERROR, deleteResp.Data[1].Status)
+	assert.Equal(t, ethpbservice.DeletedRemoteKeysStatus_NOT_FOUND, deleteResp.Data[2].Status)
+}
+
+func TestKeymanagerAPI_WrongKeymanager(t *testing.T) {
+	ctx := context.Background()
+	s := &keymanagerAPIServer{server: &Server{}}
+	_, err := s.ImportKeystores(ctx, &ethpbservice.ImportKeystoresRequest{})
+	assert.ErrorContains(t, "Wallet not yet initialized", err)
+	_, err = s.ListRemoteKeys(ctx, &empty.Empty{})
+	assert.ErrorContains(t, "Wallet not yet initialized", err)
+}
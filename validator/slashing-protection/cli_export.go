@@ -10,6 +10,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	export "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
 	"github.com/urfave/cli/v2"
@@ -24,7 +25,8 @@ const (
 // file via a CLI entrypoint to make it easy to migrate machines or Ethereum consensus clients.
 //
 // Steps:
-// 1. Parse a path to the validator's datadir from the CLI context.
+// 1. Parse a path to the validator's datadir from the CLI context, unless the
+// database is the PostgreSQL database of --slashing-protection-postgres-url.
 // 2. Open the validator database.
 // 3. Call the function which actually exports the data from
 // from the validator's db into an EIP standard slashing protection format
@@ -36,27 +38,29 @@ func ExportSlashingProtectionJSONCli(cliCtx *cli.Context) error {
 	)
 	var err error
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
-	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
-		dataDir, err = prompt.InputDirectory(cliCtx, prompt.DataDirDirPromptText, cmd.DataDirFlag)
+	if !db.UsesPostgres(cliCtx) {
+		if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
+			dataDir, err = prompt.InputDirectory(cliCtx, prompt.DataDirDirPromptText, cmd.DataDirFlag)
+			if err != nil {
+				return errors.Wrapf(err, "could not read directory value from input")
+			}
+		}
+		// ensure that the validator.db is found under the specified dir or its subdirectories
+		found, _, err := fileutil.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
 		if err != nil {
-			return errors.Wrapf(err, "could not read directory value from input")
+			return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+		}
+		if !found {
+			return fmt.Errorf(
+				"validator.db file (validator database) was not found at path %s, so nothing to export",
+				dataDir,
+			)
 		}
-	}
-	// ensure that the validator.db is found under the specified dir or its subdirectories
-	found, _, err := fileutil.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
-	if err != nil {
-		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
-	}
-	if !found {
-		return fmt.Errorf(
-			"validator.db file (validator database) was not found at path %s, so nothing to export",
-			dataDir,
-		)
 	}
 
-	validatorDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	validatorDB, err := db.Open(cliCtx, dataDir)
 	if err != nil {
-		return errors.Wrapf(err, "could not access validator database at path %s", dataDir)
+		return errors.Wrap(err, "could not access validator database")
 	}
 	defer func() {
 		if err := validatorDB.Close(); err != nil {
@@ -9,6 +9,7 @@ import (
 	"github.com/prysmaticlabs/prysm/shared/cmd"
 	"github.com/prysmaticlabs/prysm/shared/fileutil"
 	"github.com/prysmaticlabs/prysm/validator/accounts/prompt"
+	"github.com/prysmaticlabs/prysm/validator/db"
 	"github.com/prysmaticlabs/prysm/validator/db/kv"
 	slashingProtectionFormat "github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format"
 	"github.com/urfave/cli/v2"
@@ -18,7 +19,8 @@ import (
 // standard JSON file and attempts to insert its data into our validator DB.
 //
 // Steps:
-// 1. Parse a path to the validator's datadir from the CLI context.
+// 1. Parse a path to the validator's datadir from the CLI context, unless the
+// database is the PostgreSQL database of --slashing-protection-postgres-url.
 // 2. Open the validator database.
 // 3. Read the JSON file from user input.
 // 4. Call the function which actually imports the data from
@@ -26,28 +28,30 @@ import (
 func ImportSlashingProtectionCLI(cliCtx *cli.Context) error {
 	var err error
 	dataDir := cliCtx.String(cmd.DataDirFlag.Name)
-	if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
-		dataDir, err = prompt.InputDirectory(cliCtx, prompt.DataDirDirPromptText, cmd.DataDirFlag)
+	if !db.UsesPostgres(cliCtx) {
+		if !cliCtx.IsSet(cmd.DataDirFlag.Name) {
+			dataDir, err = prompt.InputDirectory(cliCtx, prompt.DataDirDirPromptText, cmd.DataDirFlag)
+			if err != nil {
+				return errors.Wrapf(err, "could not read directory value from input")
+			}
+		}
+		// ensure that the validator.db is found under the specified dir or its subdirectories
+		found, _, err := fileutil.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
 		if err != nil {
-			return errors.Wrapf(err, "could not read directory value from input")
+			return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
+		}
+		if !found {
+			log.Infof(
+				"Did not find existing validator.db inside of %s, creating a new one",
+				dataDir,
+			)
+		} else {
+			log.Infof("Found existing validator.db inside of %s", dataDir)
 		}
 	}
-	// ensure that the validator.db is found under the specified dir or its subdirectories
-	found, _, err := fileutil.RecursiveFileFind(kv.ProtectionDbFileName, dataDir)
-	if err != nil {
-		return errors.Wrapf(err, "error finding validator database at path %s", dataDir)
-	}
-	if !found {
-		log.Infof(
-			"Did not find existing validator.db inside of %s, creating a new one",
-			dataDir,
-		)
-	} else {
-		log.Infof("Found existing validator.db inside of %s", dataDir)
-	}
-	valDB, err := kv.NewKVStore(cliCtx.Context, dataDir, &kv.Config{})
+	valDB, err := db.Open(cliCtx, dataDir)
 	if err != nil {
-		return errors.Wrapf(err, "could not access validator database at path: %s", dataDir)
+		return errors.Wrap(err, "could not access validator database")
 	}
 	defer func() {
 		if err := valDB.Close(); err != nil {
@@ -30,6 +30,7 @@ func setupCliCtx(
 	set.String(cmd.DataDirFlag.Name, dbPath, "")
 	set.String(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath, "")
 	set.String(flags.SlashingProtectionExportDirFlag.Name, outputDir, "")
+	set.String(flags.SlashingProtectionPostgresURLFlag.Name, "", "")
 	require.NoError(tb, set.Set(flags.SlashingProtectionJSONFileFlag.Name, protectionFilePath))
 	assert.NoError(tb, set.Set(cmd.DataDirFlag.Name, dbPath))
 	assert.NoError(tb, set.Set(flags.SlashingProtectionExportDirFlag.Name, outputDir))
@@ -177,3 +178,17 @@ func TestImportExportSlashingProtectionCli_EmptyData(t *testing.T) {
 		require.DeepEqual(t, make([]*format.SignedAttestation, 0), item.SignedAttestations)
 	}
 }
+
+func TestImportExportSlashingProtectionCli_PostgresURL(t *testing.T) {
+	dataDir := t.TempDir()
+	cliCtx := setupCliCtx(t, dataDir, filepath.Join(dataDir, "slashing_protection.json"), dataDir)
+	// Nothing listens on port 1, so connecting to the database fails right away.
+	require.NoError(t, cliCtx.Set(flags.SlashingProtectionPostgresURLFlag.Name, "postgres://user@127.0.0.1:1/validator?sslmode=disable"))
+
+	// Both commands use the PostgreSQL database rather than the validator.db file of the data directory.
+	err := ImportSlashingProtectionCLI(cliCtx)
+	require.ErrorContains(t, "could not connect to database", err)
+	err = ExportSlashingProtectionJSONCli(cliCtx)
+	require.ErrorContains(t, "could not connect to database", err)
+	assert.Equal(t, false, fileutil.FileExists(filepath.Join(dataDir, kv.ProtectionDbFileName)))
+}
@@ -18,6 +18,17 @@ import (
 	"github.com/prysmaticlabs/prysm/validator/slashing-protection/local/standard-protection-format/format"
 )
 
+// minimalProtectionDB is implemented by the databases which can keep only the watermarks of the
+// signing history of each public key, into which the imported histories are collapsed.
+type minimalProtectionDB interface {
+	MinimalSlashingProtection() bool
+	SaveSigningWatermarks(
+		ctx context.Context,
+		attestingHistoryByPubKey map[[48]byte][]*kv.AttestationRecord,
+		proposalHistoryByPubKey map[[48]byte]kv.ProposalHistoryForPubkey,
+	) error
+}
+
 // ImportStandardProtectionJSON takes in EIP-3076 compliant JSON file used for slashing protection
 // by Ethereum validators and imports its data into Prysm's internal representation of slashing
 // protection in the validator client's database. For more information, see the EIP document here:
@@ -98,6 +109,15 @@ func ImportStandardProtectionJSON(ctx context.Context, validatorDB db.Database,
 		return errors.Wrap(err, "could not save slashable public keys to database")
 	}
 
+	// When only the watermarks of the signing histories are kept, the histories are collapsed
+	// into their watermarks and saved all at once, rather than replaying every record.
+	if minimalDB, ok := validatorDB.(minimalProtectionDB); ok && minimalDB.MinimalSlashingProtection() {
+		if err := minimalDB.SaveSigningWatermarks(ctx, attestingHistoryByPubKey, proposalHistoryByPubKey); err != nil {
+			return errors.Wrap(err, "could not save signing watermarks from imported JSON to database")
+		}
+		return nil
+	}
+
 	// We save the histories to disk as atomic operations, ensuring that this only occurs
 	// until after we successfully parse all data from the JSON file. If there is any error
 	// in parsing the JSON proposal and attesting histories, we will not reach this point.
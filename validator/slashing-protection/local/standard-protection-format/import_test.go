@@ -167,6 +167,46 @@ func TestStore_ImportInterchangeData_OK(t *testing.T) {
 	}
 }
 
+func TestStore_ImportInterchangeData_MinimalSlashingProtection(t *testing.T) {
+	ctx := context.Background()
+	numValidators := 10
+	publicKeys, err := valtest.CreateRandomPubKeys(numValidators)
+	require.NoError(t, err)
+	validatorDB, err := kv.NewKVStore(ctx, t.TempDir(), &kv.Config{
+		PubKeys:                   publicKeys,
+		MinimalSlashingProtection: true,
+	})
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, validatorDB.Close())
+	}()
+
+	attestingHistory, proposalHistory := valtest.MockAttestingAndProposalHistories(publicKeys)
+	standardProtectionFormat, err := valtest.MockSlashingProtectionJSON(publicKeys, attestingHistory, proposalHistory)
+	require.NoError(t, err)
+	blob, err := json.Marshal(standardProtectionFormat)
+	require.NoError(t, err)
+	require.NoError(t, ImportStandardProtectionJSON(ctx, validatorDB, bytes.NewBuffer(blob)))
+
+	// The histories are collapsed into their latest attestation and proposal, which are their watermarks.
+	for i, pubKey := range publicKeys {
+		receivedAttestingHistory, err := validatorDB.AttestationHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		if atts := attestingHistory[i]; len(atts) > 0 {
+			require.Equal(t, 1, len(receivedAttestingHistory))
+			assert.DeepEqual(t, atts[len(atts)-1], receivedAttestingHistory[0])
+		} else {
+			assert.Equal(t, 0, len(receivedAttestingHistory))
+		}
+
+		proposals := proposalHistory[i].Proposals
+		receivedProposalHistory, err := validatorDB.ProposalHistoryForPubKey(ctx, pubKey)
+		require.NoError(t, err)
+		require.Equal(t, 1, len(receivedProposalHistory))
+		assert.DeepEqual(t, &proposals[len(proposals)-1], receivedProposalHistory[0])
+	}
+}
+
 func Test_validateMetadata(t *testing.T) {
 	goodRoot := [32]byte{1}
 	goodStr := make([]byte, hex.EncodedLen(len(goodRoot)))